@@ -3,8 +3,8 @@ package client
 import (
 	"context"
 	"io"
+	"time"
 
-	"github.com/filecoin-project/go-address"
 	cid "github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/deals"
 	pb "github.com/textileio/powergate/deals/pb"
@@ -25,11 +25,12 @@ type WatchEvent struct {
 }
 
 // Store creates a proposal deal for data using wallet addr to all miners indicated
-// by dealConfigs for duration epochs
-func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConfigs []deals.StorageDealConfig, duration uint64) ([]cid.Cid, []deals.StorageDealConfig, error) {
+// by dealConfigs for duration epochs. It returns the data Cid, and the result of each
+// deal config.
+func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConfigs []deals.StorageDealConfig, duration uint64) (cid.Cid, []deals.StoreResult, error) {
 	stream, err := d.client.Store(ctx)
 	if err != nil {
-		return nil, nil, err
+		return cid.Undef, nil, err
 	}
 
 	reqDealConfigs := make([]*pb.DealConfig, len(dealConfigs))
@@ -37,6 +38,8 @@ func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConf
 		reqDealConfigs[i] = &pb.DealConfig{
 			Miner:      dealConfig.Miner,
 			EpochPrice: dealConfig.EpochPrice,
+			Offline:    dealConfig.Offline,
+			ExportPath: dealConfig.ExportPath,
 		}
 	}
 	storeParams := &pb.StoreParams{
@@ -47,14 +50,14 @@ func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConf
 	innerReq := &pb.StoreRequest_StoreParams{StoreParams: storeParams}
 
 	if err = stream.Send(&pb.StoreRequest{Payload: innerReq}); err != nil {
-		return nil, nil, err
+		return cid.Undef, nil, err
 	}
 
 	buffer := make([]byte, 1024*32) // 32KB
 	for {
 		bytesRead, err := data.Read(buffer)
 		if err != nil && err != io.EOF {
-			return nil, nil, err
+			return cid.Undef, nil, err
 		}
 		sendErr := stream.Send(&pb.StoreRequest{Payload: &pb.StoreRequest_Chunk{Chunk: buffer[:bytesRead]}})
 		if sendErr != nil {
@@ -66,41 +69,60 @@ func (d *Deals) Store(ctx context.Context, addr string, data io.Reader, dealConf
 	}
 	reply, err := stream.CloseAndRecv()
 	if err != nil {
-		return nil, nil, err
+		return cid.Undef, nil, err
 	}
 
-	cids := make([]cid.Cid, len(reply.GetProposalCids()))
-	for i, replyCid := range reply.GetProposalCids() {
-		id, err := cid.Decode(replyCid)
-		if err != nil {
-			return nil, nil, err
-		}
-		cids[i] = id
+	dataCid, err := cid.Decode(reply.GetDataCid())
+	if err != nil {
+		return cid.Undef, nil, err
 	}
-
-	failedDeals := make([]deals.StorageDealConfig, len(reply.GetFailedDeals()))
-	for i, dealConfig := range reply.GetFailedDeals() {
-		addr, err := address.NewFromString(dealConfig.GetMiner())
-		if err != nil {
-			return nil, nil, err
+	res := make([]deals.StoreResult, len(reply.GetResults()))
+	for i, r := range reply.GetResults() {
+		res[i] = deals.StoreResult{
+			Config: deals.StorageDealConfig{
+				Miner:      r.GetConfig().GetMiner(),
+				EpochPrice: r.GetConfig().GetEpochPrice(),
+				Offline:    r.GetConfig().GetOffline(),
+				ExportPath: r.GetConfig().GetExportPath(),
+			},
+			Success:   r.GetSuccess(),
+			Message:   r.GetMessage(),
+			CarPath:   r.GetCarPath(),
+			PieceSize: r.GetPieceSize(),
 		}
-		failedDeals[i] = deals.StorageDealConfig{
-			Miner:      addr.String(),
-			EpochPrice: dealConfig.GetEpochPrice(),
+		if r.GetProposalCid() != "" {
+			res[i].ProposalCid, err = cid.Decode(r.GetProposalCid())
+			if err != nil {
+				return cid.Undef, nil, err
+			}
+		}
+		if r.GetPieceCid() != "" {
+			res[i].PieceCid, err = cid.Decode(r.GetPieceCid())
+			if err != nil {
+				return cid.Undef, nil, err
+			}
 		}
 	}
-
-	return cids, failedDeals, nil
+	return dataCid, res, nil
 }
 
-// Watch returnas a channel with state changes of indicated proposals
-func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid) (<-chan WatchEvent, error) {
+// Watch returnas a channel with state changes of indicated proposals. Recorded
+// state changes are replayed first, followed by new ones as they happen.
+func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid, opts ...deals.WatchOption) (<-chan WatchEvent, error) {
+	var cfg deals.WatchConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
 	channel := make(chan WatchEvent)
 	proposalStrings := make([]string, len(proposals))
 	for i, proposal := range proposals {
 		proposalStrings[i] = proposal.String()
 	}
-	stream, err := d.client.Watch(ctx, &pb.WatchRequest{Proposals: proposalStrings})
+	req := &pb.WatchRequest{Proposals: proposalStrings}
+	if !cfg.ReplaySince.IsZero() {
+		req.ReplaySince = cfg.ReplaySince.UnixNano()
+	}
+	stream, err := d.client.Watch(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -120,20 +142,25 @@ func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid) (<-chan WatchEve
 				channel <- WatchEvent{Err: err}
 				break
 			}
-			cid, err := cid.Cast(event.GetDealInfo().GetPieceCID())
-			if err != nil {
-				channel <- WatchEvent{Err: err}
-				break
+			pieceCid := cid.Undef
+			if len(event.GetDealInfo().GetPieceCID()) > 0 {
+				pieceCid, err = cid.Cast(event.GetDealInfo().GetPieceCID())
+				if err != nil {
+					channel <- WatchEvent{Err: err}
+					break
+				}
 			}
 			deal := deals.DealInfo{
-				ProposalCid:   proposalCid,
-				StateID:       event.GetDealInfo().GetStateID(),
-				StateName:     event.GetDealInfo().GetStateName(),
-				Miner:         event.GetDealInfo().GetMiner(),
-				PieceCID:      cid,
-				Size:          event.GetDealInfo().GetSize(),
-				PricePerEpoch: event.GetDealInfo().GetPricePerEpoch(),
-				Duration:      event.GetDealInfo().GetDuration(),
+				ProposalCid:     proposalCid,
+				StateID:         event.GetDealInfo().GetStateID(),
+				StateName:       event.GetDealInfo().GetStateName(),
+				Miner:           event.GetDealInfo().GetMiner(),
+				PieceCID:        pieceCid,
+				Size:            event.GetDealInfo().GetSize(),
+				PricePerEpoch:   event.GetDealInfo().GetPricePerEpoch(),
+				Duration:        event.GetDealInfo().GetDuration(),
+				DealID:          event.GetDealInfo().GetDealID(),
+				ActivationEpoch: event.GetDealInfo().GetActivationEpoch(),
 			}
 			channel <- WatchEvent{Deal: deal}
 		}
@@ -141,15 +168,29 @@ func (d *Deals) Watch(ctx context.Context, proposals []cid.Cid) (<-chan WatchEve
 	return channel, nil
 }
 
-// Retrieve is used to fetch data from filecoin
-func (d *Deals) Retrieve(ctx context.Context, waddr string, cid cid.Cid) (io.Reader, error) {
+// Retrieve is used to fetch data from filecoin. It returns which provider
+// served the data and the price paid for it, once the retrieval finished.
+func (d *Deals) Retrieve(ctx context.Context, waddr string, cid cid.Cid, opts ...deals.RetrieveOption) (io.Reader, deals.RetrievalInfo, error) {
+	var cfg deals.RetrieveConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
 	req := &pb.RetrieveRequest{
-		Address: waddr,
-		Cid:     cid.String(),
+		Address:  waddr,
+		Cid:      cid.String(),
+		MaxPrice: cfg.MaxPrice,
 	}
 	stream, err := d.client.Retrieve(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, deals.RetrievalInfo{}, err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, deals.RetrievalInfo{}, err
+	}
+	info := deals.RetrievalInfo{
+		Miner: first.GetInfo().GetMiner(),
+		Price: first.GetInfo().GetPrice(),
 	}
 
 	reader, writer := io.Pipe()
@@ -172,5 +213,84 @@ func (d *Deals) Retrieve(ctx context.Context, waddr string, cid cid.Cid) (io.Rea
 		}
 	}()
 
-	return reader, nil
+	return reader, info, nil
+}
+
+// ListDealRecords returns the records of deal proposals made with Powergate
+// selected by a filter, ordered by time.
+func (d *Deals) ListDealRecords(ctx context.Context, f deals.DealRecordFilter) ([]deals.DealRecord, error) {
+	req := &pb.ListDealRecordsRequest{
+		Address:     f.Addr,
+		Miner:       f.Miner,
+		OnlyPending: f.OnlyPending,
+	}
+	if f.DataCid.Defined() {
+		req.DataCid = f.DataCid.String()
+	}
+	reply, err := d.client.ListDealRecords(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]deals.DealRecord, len(reply.GetRecords()))
+	for i, r := range reply.GetRecords() {
+		res[i], err = fromRPCDealRecord(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// GetDealRecord returns the record of a deal proposal made with Powergate.
+func (d *Deals) GetDealRecord(ctx context.Context, proposalCid cid.Cid) (deals.DealRecord, error) {
+	reply, err := d.client.GetDealRecord(ctx, &pb.GetDealRecordRequest{ProposalCid: proposalCid.String()})
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	return fromRPCDealRecord(reply.GetRecord())
+}
+
+func fromRPCDealRecord(r *pb.DealRecord) (deals.DealRecord, error) {
+	var pcid cid.Cid
+	if r.GetProposalCid() != "" {
+		var err error
+		pcid, err = cid.Decode(r.GetProposalCid())
+		if err != nil {
+			return deals.DealRecord{}, err
+		}
+	}
+	dataCid, err := cid.Decode(r.GetDataCid())
+	if err != nil {
+		return deals.DealRecord{}, err
+	}
+	res := deals.DealRecord{
+		ProposalCid:     pcid,
+		Addr:            r.GetAddress(),
+		Miner:           r.GetMiner(),
+		EpochPrice:      r.GetEpochPrice(),
+		Duration:        r.GetDuration(),
+		DataCid:         dataCid,
+		Offline:         r.GetOffline(),
+		Time:            time.Unix(0, r.GetTime()),
+		History:         make([]deals.DealStateChange, len(r.GetHistory())),
+		Final:           r.GetFinal(),
+		DealID:          r.GetDealID(),
+		Size:            r.GetSize(),
+		ActivationEpoch: r.GetActivationEpoch(),
+		Error:           r.GetError(),
+	}
+	if r.GetPieceCid() != "" {
+		res.PieceCid, err = cid.Decode(r.GetPieceCid())
+		if err != nil {
+			return deals.DealRecord{}, err
+		}
+	}
+	for i, h := range r.GetHistory() {
+		res.History[i] = deals.DealStateChange{
+			StateID:   h.GetStateID(),
+			StateName: h.GetStateName(),
+			Time:      time.Unix(0, h.GetTime()),
+		}
+	}
+	return res, nil
 }
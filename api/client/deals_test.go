@@ -39,9 +39,11 @@ func TestRetrieve(t *testing.T) {
 
 	cid, _ := cid.Parse("QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPA")
 
-	_, err := d.Retrieve(ctx, "an address", cid)
-	if err != nil {
-		t.Fatalf("failed to call Retrieve: %v", err)
+	// The retrieval fails before sending its info, since
+	// the wallet address is invalid.
+	_, _, err := d.Retrieve(ctx, "an address", cid)
+	if err == nil {
+		t.Fatalf("retrieve with an invalid address should fail")
 	}
 }
 
@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"io"
+	"math/big"
+	"time"
 
 	cid "github.com/ipfs/go-cid"
 	ff "github.com/textileio/powergate/ffs"
@@ -28,6 +30,7 @@ type PushConfig struct {
 	HasConfig         bool
 	OverrideConfig    bool
 	HasOverrideConfig bool
+	Priority          int
 }
 
 // WithCidConfig overrides the Api default Cid configuration.
@@ -47,6 +50,14 @@ func WithOverride(override bool) PushConfigOption {
 	}
 }
 
+// WithPriority sets the priority of the created Job relative to other
+// queued Jobs of the instance. Jobs with higher priority are executed first.
+func WithPriority(priority int) PushConfigOption {
+	return func(o *PushConfig) {
+		o.Priority = priority
+	}
+}
+
 func (f *ffs) Create(ctx context.Context) (string, string, error) {
 	r, err := f.client.Create(ctx, &rpc.CreateRequest{})
 	if err != nil {
@@ -71,6 +82,69 @@ func (f *ffs) WalletAddr(ctx context.Context) (string, error) {
 	return resp.Addr, nil
 }
 
+// NewAddressOption is a function that changes a NewAddrRequest.
+type NewAddressOption func(r *rpc.NewAddrRequest)
+
+// WithAddressType specifies the type of the new address, bls
+// or secp256k1.
+func WithAddressType(addrType string) NewAddressOption {
+	return func(r *rpc.NewAddrRequest) {
+		r.AddressType = addrType
+	}
+}
+
+// WithMakeDefault indicates that the new address should become
+// the default address of the instance.
+func WithMakeDefault(makeDefault bool) NewAddressOption {
+	return func(r *rpc.NewAddrRequest) {
+		r.MakeDefault = makeDefault
+	}
+}
+
+// Addrs returns the wallet addresses of the instance, and the default one.
+func (f *ffs) Addrs(ctx context.Context) ([]*rpc.AddrInfo, string, error) {
+	resp, err := f.client.Addrs(ctx, &rpc.AddrsRequest{})
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Addrs, resp.DefaultAddr, nil
+}
+
+// NewAddr creates a new wallet address of the instance labeled with name.
+func (f *ffs) NewAddr(ctx context.Context, name string, opts ...NewAddressOption) (string, error) {
+	req := &rpc.NewAddrRequest{Name: name}
+	for _, o := range opts {
+		o(req)
+	}
+	resp, err := f.client.NewAddr(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return resp.Addr, nil
+}
+
+// SetDefaultAddr sets the default wallet address of the instance.
+func (f *ffs) SetDefaultAddr(ctx context.Context, addr string) error {
+	_, err := f.client.SetDefaultAddr(ctx, &rpc.SetDefaultAddrRequest{Addr: addr})
+	return err
+}
+
+// SendFil sends amount attoFIL from an address of the instance to another
+// address, returning the message Cid.
+func (f *ffs) SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error) {
+	resp, err := f.client.SendFil(ctx, &rpc.SendFilRequest{From: from, To: to, Amount: amount.String()})
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.Decode(resp.MessageCid)
+}
+
+// WaitMessage waits for a message to land on chain.
+func (f *ffs) WaitMessage(ctx context.Context, mcid cid.Cid) error {
+	_, err := f.client.WaitMessage(ctx, &rpc.WaitMessageRequest{MessageCid: mcid.String()})
+	return err
+}
+
 func (f *ffs) GetDefaultCidConfig(ctx context.Context, c cid.Cid) (*rpc.GetDefaultCidConfigReply, error) {
 	return f.client.GetDefaultCidConfig(ctx, &rpc.GetDefaultCidConfigRequest{Cid: c.String()})
 }
@@ -96,12 +170,23 @@ func (f *ffs) SetDefaultCidConfig(ctx context.Context, config ff.DefaultCidConfi
 					DealDuration:   int64(config.Cold.Filecoin.DealDuration),
 					ExcludedMiners: config.Cold.Filecoin.ExcludedMiners,
 					CountryCodes:   config.Cold.Filecoin.CountryCodes,
+					TrustedMiners:  config.Cold.Filecoin.TrustedMiners,
+					MaxPrice:       config.Cold.Filecoin.MaxPrice,
+					MinScore:       int64(config.Cold.Filecoin.MinScore),
+					Addr:           config.Cold.Filecoin.Addr,
 					Renew: &rpc.FilRenew{
-						Enabled:   config.Cold.Filecoin.Renew.Enabled,
-						Threshold: int64(config.Cold.Filecoin.Renew.Threshold),
+						Enabled:          config.Cold.Filecoin.Renew.Enabled,
+						Threshold:        int64(config.Cold.Filecoin.Renew.Threshold),
+						Strategy:         rpc.RenewStrategy(config.Cold.Filecoin.Renew.Strategy),
+						MaxPrice:         config.Cold.Filecoin.Renew.MaxPrice,
+						MaxPriceIncrease: int64(config.Cold.Filecoin.Renew.MaxPriceIncrease),
+					},
+					Repair: &rpc.FilRepair{
+						Enabled: config.Cold.Filecoin.Repair.Enabled,
 					},
 				},
 			},
+			Retry: toRPCRetryConfig(config.Retry),
 		},
 	}
 	_, err := f.client.SetDefaultCidConfig(ctx, req)
@@ -147,11 +232,11 @@ func (f *ffs) WatchJobs(ctx context.Context, jids ...ff.JobID) (<-chan JobEvent,
 				close(updates)
 				break
 			}
-			job := ff.Job{
-				ID:       ff.JobID(reply.Job.ID),
-				APIID:    ff.APIID(reply.Job.ApiID),
-				Status:   ff.JobStatus(reply.Job.Status),
-				ErrCause: reply.Job.ErrCause,
+			job, err := fromRPCJob(reply.Job)
+			if err != nil {
+				updates <- JobEvent{Err: err}
+				close(updates)
+				break
 			}
 			updates <- JobEvent{Job: job}
 		}
@@ -159,6 +244,86 @@ func (f *ffs) WatchJobs(ctx context.Context, jids ...ff.JobID) (<-chan JobEvent,
 	return updates, cancelFunc, nil
 }
 
+func (f *ffs) CancelJob(ctx context.Context, jid ff.JobID) error {
+	_, err := f.client.CancelJob(ctx, &rpc.CancelJobRequest{Jid: jid.String()})
+	return err
+}
+
+func (f *ffs) ListJobs(ctx context.Context, filter ff.JobFilter) ([]ff.Job, error) {
+	req := &rpc.ListJobsRequest{
+		Statuses: make([]rpc.JobStatus, len(filter.Statuses)),
+		Offset:   int64(filter.Offset),
+		Limit:    int64(filter.Limit),
+	}
+	if filter.Cid.Defined() {
+		req.Cid = filter.Cid.String()
+	}
+	for i, st := range filter.Statuses {
+		req.Statuses[i] = rpc.JobStatus(st)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		req.CreatedFrom = filter.CreatedFrom.UnixNano()
+	}
+	if !filter.CreatedTo.IsZero() {
+		req.CreatedTo = filter.CreatedTo.UnixNano()
+	}
+	reply, err := f.client.ListJobs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ff.Job, len(reply.Jobs))
+	for i, rj := range reply.Jobs {
+		j, err := fromRPCJob(rj)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = j
+	}
+	return res, nil
+}
+
+// Spend returns a report of the attoFIL committed to active deals, considering
+// the deals selected by the filter. The filter APIID is ignored.
+func (f *ffs) Spend(ctx context.Context, filter ff.SpendFilter) (*rpc.SpendReply, error) {
+	req := &rpc.SpendRequest{Miner: filter.Miner}
+	if filter.Cid.Defined() {
+		req.Cid = filter.Cid.String()
+	}
+	if !filter.From.IsZero() {
+		req.From = filter.From.UnixNano()
+	}
+	if !filter.To.IsZero() {
+		req.To = filter.To.UnixNano()
+	}
+	return f.client.Spend(ctx, req)
+}
+
+// SetQuotas sets the quotas of the instance with id iid. It's an admin
+// endpoint, so ctx must carry the server admin token.
+func (f *ffs) SetQuotas(ctx context.Context, iid ff.APIID, q *rpc.Quotas) error {
+	_, err := f.client.SetQuotas(ctx, &rpc.SetQuotasRequest{InstanceID: iid.String(), Quotas: q})
+	return err
+}
+
+// SetTopUpPolicy sets the automatic top-up policy of the instance with id
+// iid. It's an admin endpoint, so ctx must carry the server admin token.
+func (f *ffs) SetTopUpPolicy(ctx context.Context, iid ff.APIID, p *rpc.TopUpPolicy) error {
+	_, err := f.client.SetTopUpPolicy(ctx, &rpc.SetTopUpPolicyRequest{InstanceID: iid.String(), Policy: p})
+	return err
+}
+
+// Fund sends amount attoFIL from the master address to addr of the instance
+// with id iid, returning the message Cid. If addr is empty, the instance
+// default address is funded. It's an admin endpoint, so ctx must carry the
+// server admin token.
+func (f *ffs) Fund(ctx context.Context, iid ff.APIID, addr string, amount *big.Int) (cid.Cid, error) {
+	resp, err := f.client.Fund(ctx, &rpc.FundRequest{InstanceID: iid.String(), Addr: addr, Amount: amount.String()})
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.Decode(resp.MessageCid)
+}
+
 func (f *ffs) PushConfig(ctx context.Context, c cid.Cid, opts ...PushConfigOption) (ff.JobID, error) {
 	pushConfig := PushConfig{}
 	for _, opt := range opts {
@@ -169,29 +334,7 @@ func (f *ffs) PushConfig(ctx context.Context, c cid.Cid, opts ...PushConfigOptio
 
 	if pushConfig.HasConfig {
 		req.HasConfig = true
-		req.Config = &rpc.CidConfig{
-			Cid: pushConfig.Config.Cid.String(),
-			Hot: &rpc.HotConfig{
-				Enabled:       pushConfig.Config.Hot.Enabled,
-				AllowUnfreeze: pushConfig.Config.Hot.AllowUnfreeze,
-				Ipfs: &rpc.IpfsConfig{
-					AddTimeout: int64(pushConfig.Config.Hot.Ipfs.AddTimeout),
-				},
-			},
-			Cold: &rpc.ColdConfig{
-				Enabled: pushConfig.Config.Cold.Enabled,
-				Filecoin: &rpc.FilConfig{
-					RepFactor:      int64(pushConfig.Config.Cold.Filecoin.RepFactor),
-					DealDuration:   pushConfig.Config.Cold.Filecoin.DealDuration,
-					ExcludedMiners: pushConfig.Config.Cold.Filecoin.ExcludedMiners,
-					CountryCodes:   pushConfig.Config.Cold.Filecoin.CountryCodes,
-					Renew: &rpc.FilRenew{
-						Enabled:   pushConfig.Config.Cold.Filecoin.Renew.Enabled,
-						Threshold: int64(pushConfig.Config.Cold.Filecoin.Renew.Threshold),
-					},
-				},
-			},
-		}
+		req.Config = toRPCCidConfig(pushConfig.Config)
 	}
 
 	if pushConfig.HasOverrideConfig {
@@ -199,6 +342,8 @@ func (f *ffs) PushConfig(ctx context.Context, c cid.Cid, opts ...PushConfigOptio
 		req.OverrideConfig = pushConfig.OverrideConfig
 	}
 
+	req.Priority = int64(pushConfig.Priority)
+
 	resp, err := f.client.PushConfig(ctx, req)
 	if err != nil {
 		return ff.EmptyJobID, err
@@ -207,6 +352,27 @@ func (f *ffs) PushConfig(ctx context.Context, c cid.Cid, opts ...PushConfigOptio
 	return ff.JobID(resp.JobID), nil
 }
 
+func (f *ffs) PlanConfig(ctx context.Context, c cid.Cid, opts ...PushConfigOption) (*rpc.PlanConfigReply, error) {
+	pushConfig := PushConfig{}
+	for _, opt := range opts {
+		opt(&pushConfig)
+	}
+
+	req := &rpc.PlanConfigRequest{Cid: c.String()}
+
+	if pushConfig.HasConfig {
+		req.HasConfig = true
+		req.Config = toRPCCidConfig(pushConfig.Config)
+	}
+
+	if pushConfig.HasOverrideConfig {
+		req.HasOverrideConfig = true
+		req.OverrideConfig = pushConfig.OverrideConfig
+	}
+
+	return f.client.PlanConfig(ctx, req)
+}
+
 func (f *ffs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	stream, err := f.client.Get(ctx, &rpc.GetRequest{
 		Cid: c.String(),
@@ -236,6 +402,35 @@ func (f *ffs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	return reader, nil
 }
 
+func (f *ffs) GetCold(ctx context.Context, c cid.Cid) (io.Reader, error) {
+	stream, err := f.client.GetCold(ctx, &rpc.GetColdRequest{
+		Cid: c.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				break
+			} else if err != nil {
+				_ = writer.CloseWithError(err)
+				break
+			}
+			_, err = writer.Write(reply.GetChunk())
+			if err != nil {
+				_ = writer.CloseWithError(err)
+				break
+			}
+		}
+	}()
+
+	return reader, nil
+}
+
 func (f *ffs) Close(ctx context.Context) error {
 	_, err := f.client.Close(ctx, &rpc.CloseRequest{})
 	return err
@@ -276,3 +471,84 @@ func (f *ffs) AddToHot(ctx context.Context, data io.Reader) (*cid.Cid, error) {
 	}
 	return &cid, nil
 }
+
+func toRPCRetryConfig(rc ff.RetryConfig) *rpc.RetryConfig {
+	res := &rpc.RetryConfig{
+		MaxAttempts:    int64(rc.MaxAttempts),
+		Backoff:        int64(rc.Backoff),
+		MaxBackoff:     int64(rc.MaxBackoff),
+		FailureClasses: make([]rpc.FailureClass, len(rc.FailureClasses)),
+	}
+	for i, fc := range rc.FailureClasses {
+		res.FailureClasses[i] = rpc.FailureClass(fc)
+	}
+	return res
+}
+
+func fromRPCJob(rj *rpc.Job) (ff.Job, error) {
+	j := ff.Job{
+		ID:       ff.JobID(rj.ID),
+		APIID:    ff.APIID(rj.ApiID),
+		Status:   ff.JobStatus(rj.Status),
+		ErrCause: rj.ErrCause,
+		Attempts: int(rj.Attempts),
+		Priority: int(rj.Priority),
+	}
+	if rj.Cid != "" {
+		c, err := cid.Decode(rj.Cid)
+		if err != nil {
+			return ff.Job{}, err
+		}
+		j.Cid = c
+	}
+	if rj.NextRetry != 0 {
+		j.NextRetry = time.Unix(0, rj.NextRetry)
+	}
+	if rj.Created != 0 {
+		j.Created = time.Unix(0, rj.Created)
+	}
+	if rj.Started != 0 {
+		j.Started = time.Unix(0, rj.Started)
+	}
+	if rj.Finished != 0 {
+		j.Finished = time.Unix(0, rj.Finished)
+	}
+	return j, nil
+}
+
+func toRPCCidConfig(cfg ff.CidConfig) *rpc.CidConfig {
+	return &rpc.CidConfig{
+		Cid: cfg.Cid.String(),
+		Hot: &rpc.HotConfig{
+			Enabled:       cfg.Hot.Enabled,
+			AllowUnfreeze: cfg.Hot.AllowUnfreeze,
+			Ipfs: &rpc.IpfsConfig{
+				AddTimeout: int64(cfg.Hot.Ipfs.AddTimeout),
+			},
+		},
+		Cold: &rpc.ColdConfig{
+			Enabled: cfg.Cold.Enabled,
+			Filecoin: &rpc.FilConfig{
+				RepFactor:      int64(cfg.Cold.Filecoin.RepFactor),
+				DealDuration:   cfg.Cold.Filecoin.DealDuration,
+				ExcludedMiners: cfg.Cold.Filecoin.ExcludedMiners,
+				CountryCodes:   cfg.Cold.Filecoin.CountryCodes,
+				TrustedMiners:  cfg.Cold.Filecoin.TrustedMiners,
+				MaxPrice:       cfg.Cold.Filecoin.MaxPrice,
+				MinScore:       int64(cfg.Cold.Filecoin.MinScore),
+				Addr:           cfg.Cold.Filecoin.Addr,
+				Renew: &rpc.FilRenew{
+					Enabled:          cfg.Cold.Filecoin.Renew.Enabled,
+					Threshold:        int64(cfg.Cold.Filecoin.Renew.Threshold),
+					Strategy:         rpc.RenewStrategy(cfg.Cold.Filecoin.Renew.Strategy),
+					MaxPrice:         cfg.Cold.Filecoin.Renew.MaxPrice,
+					MaxPriceIncrease: int64(cfg.Cold.Filecoin.Renew.MaxPriceIncrease),
+				},
+				Repair: &rpc.FilRepair{
+					Enabled: cfg.Cold.Filecoin.Repair.Enabled,
+				},
+			},
+		},
+		Retry: toRPCRetryConfig(cfg.Retry),
+	}
+}
@@ -46,6 +46,8 @@ func setupServer(t *testing.T) func() {
 		GrpcWebProxyAddress: grpcWebProxyAddress,
 		RepoPath:            repoPath,
 		GatewayHostAddr:     gatewayHostAddr,
+		SchedMaxParallel:    10,
+		ColdMaxReplacements: 3,
 	}
 	server, err := server.NewServer(conf)
 	checkErr(t, err)
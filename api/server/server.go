@@ -35,6 +35,7 @@ import (
 	"github.com/textileio/powergate/ffs/scheduler/astore"
 	"github.com/textileio/powergate/ffs/scheduler/cistore"
 	"github.com/textileio/powergate/ffs/scheduler/jstore"
+	"github.com/textileio/powergate/ffs/scheduler/sstore"
 	"github.com/textileio/powergate/gateway"
 	"github.com/textileio/powergate/health"
 	healthRpc "github.com/textileio/powergate/health/rpc"
@@ -98,18 +99,26 @@ type Server struct {
 
 // Config specifies server settings.
 type Config struct {
-	WalletInitialFunds  big.Int
-	IpfsAPIAddr         ma.Multiaddr
-	LotusAddress        ma.Multiaddr
-	LotusAuthToken      string
-	LotusMasterAddr     string
-	Embedded            bool
-	GrpcHostNetwork     string
-	GrpcHostAddress     string
-	GrpcServerOpts      []grpc.ServerOption
-	GrpcWebProxyAddress string
-	RepoPath            string
-	GatewayHostAddr     string
+	WalletInitialFunds    big.Int
+	IpfsAPIAddr           ma.Multiaddr
+	LotusAddress          ma.Multiaddr
+	LotusAuthToken        string
+	LotusMasterAddr       string
+	Embedded              bool
+	GrpcHostNetwork       string
+	GrpcHostAddress       string
+	GrpcServerOpts        []grpc.ServerOption
+	GrpcWebProxyAddress   string
+	RepoPath              string
+	GatewayHostAddr       string
+	SchedMaxParallel      int
+	SchedJobRetention     time.Duration
+	ColdMaxReplacements   int
+	DealsMinFreeSpace     uint64
+	DealsExportRoot       string
+	DealsGCInterval       time.Duration
+	DealsRetrievalTimeout time.Duration
+	FFSAdminToken         string
 }
 
 // NewServer starts and returns a new server with the given configuration.
@@ -164,7 +173,12 @@ func NewServer(conf Config) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating slashing index: %s", err)
 	}
-	dm, err := deals.New(c, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")))
+	rm := reputation.New(txndstr.Wrap(ds, "reputation"), mi, si, ai)
+	exportRoot := conf.DealsExportRoot
+	if exportRoot == "" {
+		exportRoot = filepath.Join(conf.RepoPath, "exports")
+	}
+	dm, err := deals.New(c, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")), deals.WithExportRoot(exportRoot), deals.WithMinFreeSpace(conf.DealsMinFreeSpace), deals.WithGCInterval(conf.DealsGCInterval), deals.WithRetrievalTimeout(conf.DealsRetrievalTimeout), deals.WithReputation(rm), deals.WithDatastore(txndstr.Wrap(ds, "deals")))
 	if err != nil {
 		return nil, fmt.Errorf("creating deal module: %s", err)
 	}
@@ -172,7 +186,6 @@ func NewServer(conf Config) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating wallet module: %s", err)
 	}
-	rm := reputation.New(txndstr.Wrap(ds, "reputation"), mi, si, ai)
 	nm := pgnetlotus.New(c, ip2l)
 	hm := health.New(nm)
 
@@ -190,17 +203,25 @@ func NewServer(conf Config) (*Server, error) {
 	}
 
 	l := cidlogger.New(txndstr.Wrap(ds, "ffs/scheduler/logger"))
-	cs := filcold.New(ms, dm, ipfs.Dag(), lchain, l)
+	cs, err := filcold.New(ms, dm, ipfs.Dag(), lchain, l, filcold.WithMaxReplacements(conf.ColdMaxReplacements))
+	if err != nil {
+		return nil, fmt.Errorf("creating filecoin cold storage: %s", err)
+	}
 	hs := coreipfs.New(ipfs, l)
 	js := jstore.New(txndstr.Wrap(ds, "ffs/scheduler/jstore"))
 	as := astore.New(txndstr.Wrap(ds, "ffs/scheduler/astore"))
 	cis := cistore.New(txndstr.Wrap(ds, "ffs/scheduler/cistore"))
-	sched := scheduler.New(js, as, cis, l, hs, cs)
+	ss := sstore.New(txndstr.Wrap(ds, "ffs/scheduler/sstore"))
+	sched, err := scheduler.New(js, as, cis, ss, l, hs, cs, scheduler.WithMaxParallel(conf.SchedMaxParallel), scheduler.WithJobRetention(conf.SchedJobRetention))
+	if err != nil {
+		return nil, fmt.Errorf("creating scheduler: %s", err)
+	}
 
 	ffsManager, err := manager.New(txndstr.Wrap(ds, "ffs/manager"), wm, sched)
 	if err != nil {
 		return nil, fmt.Errorf("creating ffs instance: %s", err)
 	}
+	sched.SetFundsKeeper(ffsManager)
 
 	grpcServer, grpcWebProxy := createGRPCServer(conf.GrpcServerOpts, conf.GrpcWebProxyAddress)
 
@@ -235,7 +256,7 @@ func NewServer(conf Config) (*Server, error) {
 		closeLotus:   cls,
 	}
 
-	if err := startGRPCServices(grpcServer, grpcWebProxy, s, conf.GrpcHostNetwork, conf.GrpcHostAddress); err != nil {
+	if err := startGRPCServices(grpcServer, grpcWebProxy, s, conf.GrpcHostNetwork, conf.GrpcHostAddress, conf.FFSAdminToken); err != nil {
 		return nil, fmt.Errorf("starting GRPC services: %s", err)
 	}
 
@@ -271,7 +292,7 @@ func createGRPCServer(opts []grpc.ServerOption, webProxyAddr string) (*grpc.Serv
 	return grpcServer, grpcWebProxy
 }
 
-func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork, hostAddress string) error {
+func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork, hostAddress, ffsAdminToken string) error {
 	netService := pgnetRpc.NewService(s.nm)
 	healthService := healthRpc.NewService(s.hm)
 	dealsService := deals.NewService(s.dm)
@@ -280,7 +301,7 @@ func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, ho
 	askService := ask.NewService(s.ai)
 	minerService := miner.NewService(s.mi)
 	slashingService := slashing.NewService(s.si)
-	ffsService := ffsGrpc.NewService(s.ffsManager, s.hs)
+	ffsService := ffsGrpc.NewService(s.ffsManager, s.hs, ffsAdminToken)
 
 	listener, err := net.Listen(hostNetwork, hostAddress)
 	if err != nil {
@@ -385,6 +406,9 @@ func (s *Server) Close() {
 	if err := s.sched.Close(); err != nil {
 		log.Errorf("closing ffs scheduler: %s", err)
 	}
+	if err := s.dm.Close(); err != nil {
+		log.Errorf("closing deals module: %s", err)
+	}
 	if err := s.js.Close(); err != nil {
 		log.Errorf("closing scheduler jobstore: %s", err)
 	}
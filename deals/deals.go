@@ -8,20 +8,29 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/filecoin-project/lotus/chain/types"
 	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/lotus-client/api"
 	"github.com/textileio/lotus-client/api/apistruct"
 )
 
 const (
-	chanWriteTimeout = time.Second
+	importFilePrefix    = "import-"
+	retrieveDirPrefix   = "retrieve-"
+	defaultGCStaleAge   = 24 * time.Hour
+	defaultMinFreeSpace = 1 << 30
+	// freeSpaceCheckInterval is how many bytes of incoming data
+	// are written between free disk space checks.
+	freeSpaceCheckInterval = 64 << 20
 )
 
 var (
@@ -32,19 +41,36 @@ var (
 	// in Lotus this indicates that it may never existed on-chain, or it existed but it already expired
 	// (currEpoch > StartEpoch+Duration).
 	ErrDealNotFound = errors.New("deal not found on-chain")
+	// ErrDealRecordNotFound indicates there isn't a record of a deal
+	// proposal made with the Module.
+	ErrDealRecordNotFound = errors.New("deal record not found")
 
 	log = logging.Logger("deals")
 )
 
 // Module exposes storage and monitoring from the market.
 type Module struct {
-	api *apistruct.FullNodeStruct
-	cfg *Config
+	api     *apistruct.FullNodeStruct
+	cfg     *Config
+	records *recordStore
+	tracker *tracker
+
+	lock      sync.Mutex
+	importing map[string]struct{}
+
+	warnFreeSpace sync.Once
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+	clsLock  sync.Mutex
+	closed   bool
 }
 
-// New creates a new deal module
+// New creates a new deal module. If a GC interval is configured, stale
+// temporary files are periodically removed from the import path.
 func New(api *apistruct.FullNodeStruct, opts ...Option) (*Module, error) {
-	var cfg Config
+	cfg := Config{GCStaleAge: defaultGCStaleAge, MinFreeSpace: defaultMinFreeSpace}
 	for _, o := range opts {
 		if err := o(&cfg); err != nil {
 			return nil, err
@@ -53,29 +79,70 @@ func New(api *apistruct.FullNodeStruct, opts ...Option) (*Module, error) {
 	if cfg.ImportPath == "" {
 		return nil, fmt.Errorf("import path can't be empty")
 	}
-	return &Module{
-		api: api,
-		cfg: &cfg,
-	}, nil
+	if cfg.Datastore == nil {
+		cfg.Datastore = datastore.NewMapDatastore()
+	}
+	records := newRecordStore(cfg.Datastore)
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Module{
+		api:       api,
+		cfg:       &cfg,
+		records:   records,
+		importing: make(map[string]struct{}),
+		ctx:       ctx,
+		cancel:    cancel,
+		finished:  make(chan struct{}),
+	}
+	t, err := newTracker(api, records, m.releaseImportFile)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("creating deals tracker: %s", err)
+	}
+	m.tracker = t
+	if cfg.GCInterval > 0 {
+		go m.runGC()
+	} else {
+		close(m.finished)
+	}
+	return m, nil
+}
+
+// Close closes the deal module.
+func (m *Module) Close() error {
+	m.clsLock.Lock()
+	defer m.clsLock.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.cancel()
+	<-m.finished
+	m.tracker.close()
+	m.closed = true
+	return nil
 }
 
 // Store creates a proposal deal for data using wallet addr to all miners indicated
-// by dealConfigs for duration epochs
+// by dealConfigs for duration epochs. For offline deal configs, the data is exported
+// as a CAR file in the config ExportPath, relative to the export root, and the deal is
+// flagged for manual transfer.
+// The data is staged in a temporary file in the import path, which is kept until all
+// proposed deals reach a final state, and removed if no deal is proposed. See stageImport.
 func (m *Module) Store(ctx context.Context, waddr string, data io.Reader, dcfgs []StorageDealConfig, dur uint64, isCAR bool) (cid.Cid, []StoreResult, error) {
-	f, err := ioutil.TempFile(m.cfg.ImportPath, "import-*")
+	fname, done, err := m.stageImport(data)
 	if err != nil {
-		return cid.Undef, nil, fmt.Errorf("error when creating tmpfile: %s", err)
+		return cid.Undef, nil, err
 	}
+	proposed := false
 	defer func() {
-		if err := f.Close(); err != nil {
-			log.Errorf("closing storing file: %s", err)
+		done()
+		if !proposed {
+			if err := os.Remove(fname); err != nil {
+				log.Errorf("removing storing file: %s", err)
+			}
 		}
 	}()
-	if _, err := io.Copy(f, data); err != nil {
-		return cid.Undef, nil, fmt.Errorf("error when copying data to tmpfile: %s", err)
-	}
 	ref := api.FileRef{
-		Path:  f.Name(),
+		Path:  fname,
 		IsCAR: isCAR,
 	}
 	dataCid, err := m.api.ClientImport(ctx, ref)
@@ -87,20 +154,44 @@ func (m *Module) Store(ctx context.Context, waddr string, data io.Reader, dcfgs
 		return cid.Undef, nil, err
 	}
 
+	offline := make(map[string]offlineData)
 	res := make([]StoreResult, len(dcfgs))
 	for i, c := range dcfgs {
 		maddr, err := address.NewFromString(c.Miner)
 		if err != nil {
 			log.Errorf("invalid miner address %v: %s", c, err)
 			res[i] = StoreResult{
-				Config: c,
+				Config:  c,
+				Message: fmt.Sprintf("invalid miner address: %s", err),
 			}
+			m.recordFailure(waddr, c, dur, dataCid, res[i].Message)
 			continue
 		}
+		dataRef := &storagemarket.DataRef{
+			Root: dataCid,
+		}
+		var od offlineData
+		if c.Offline {
+			var ok bool
+			if od, ok = offline[c.ExportPath]; !ok {
+				od, err = m.exportOfflineData(ctx, ref, dataCid, c.ExportPath)
+				if err != nil {
+					log.Errorf("exporting offline data for %v: %s", c, err)
+					res[i] = StoreResult{
+						Config:  c,
+						Message: fmt.Sprintf("exporting offline data: %s", err),
+					}
+					m.recordFailure(waddr, c, dur, dataCid, res[i].Message)
+					continue
+				}
+				offline[c.ExportPath] = od
+			}
+			dataRef.TransferType = storagemarket.TTManual
+			dataRef.PieceCid = &od.commP.Root
+			dataRef.PieceSize = od.commP.Size
+		}
 		params := &api.StartDealParams{
-			Data: &storagemarket.DataRef{
-				Root: dataCid,
-			},
+			Data:           dataRef,
 			BlocksDuration: dur,
 			EpochPrice:     types.NewInt(c.EpochPrice),
 			Miner:          maddr,
@@ -110,8 +201,10 @@ func (m *Module) Store(ctx context.Context, waddr string, data io.Reader, dcfgs
 		if err != nil {
 			log.Errorf("starting deal with %v: %s", c, err)
 			res[i] = StoreResult{
-				Config: c,
+				Config:  c,
+				Message: err.Error(),
 			}
+			m.recordFailure(waddr, c, dur, dataCid, res[i].Message)
 			continue
 		}
 		res[i] = StoreResult{
@@ -119,26 +212,212 @@ func (m *Module) Store(ctx context.Context, waddr string, data io.Reader, dcfgs
 			ProposalCid: *p,
 			Success:     true,
 		}
+		if c.Offline {
+			res[i].CarPath = od.carPath
+			res[i].PieceCid = od.commP.Root
+			res[i].PieceSize = uint64(od.commP.Size)
+		}
+		r := DealRecord{
+			ProposalCid: *p,
+			Addr:        waddr,
+			Miner:       c.Miner,
+			EpochPrice:  c.EpochPrice,
+			Duration:    dur,
+			DataCid:     dataCid,
+			PieceCid:    res[i].PieceCid,
+			Offline:     c.Offline,
+			ImportFile:  fname,
+			Time:        time.Now(),
+		}
+		if err := m.records.put(r); err != nil {
+			log.Errorf("saving deal record of %s: %s", *p, err)
+		}
+		proposed = true
+		m.tracker.track(*p)
 	}
 	return dataCid, res, nil
 }
 
-// Retrieve fetches the data stored in filecoin at a particular cid
-func (m *Module) Retrieve(ctx context.Context, waddr string, cid cid.Cid, exportCAR bool) (io.ReadCloser, error) {
-	rf, err := ioutil.TempDir(m.cfg.ImportPath, "retrieve-*")
+// recordFailure saves the record of a deal proposal that was rejected
+// or couldn't be made, with the reason of the failure.
+func (m *Module) recordFailure(waddr string, c StorageDealConfig, dur uint64, dataCid cid.Cid, reason string) {
+	r := DealRecord{
+		Addr:       waddr,
+		Miner:      c.Miner,
+		EpochPrice: c.EpochPrice,
+		Duration:   dur,
+		DataCid:    dataCid,
+		Offline:    c.Offline,
+		Time:       time.Now(),
+		Final:      true,
+		Error:      reason,
+	}
+	if err := m.records.put(r); err != nil {
+		log.Errorf("saving failed deal record with %s: %s", c.Miner, err)
+	}
+}
+
+type offlineData struct {
+	carPath string
+	commP   *api.CommPRet
+}
+
+// exportOfflineData exports the imported data as a CAR file in exportPath, relative to
+// the export root, and calculates its piece commitment to be used in offline deals.
+func (m *Module) exportOfflineData(ctx context.Context, ref api.FileRef, dataCid cid.Cid, exportPath string) (offlineData, error) {
+	exportPath, err := m.resolveExportPath(exportPath)
+	if err != nil {
+		return offlineData{}, err
+	}
+	if err := mkdirWithin(m.cfg.ExportRoot, exportPath); err != nil {
+		return offlineData{}, err
+	}
+	carPath := filepath.Join(exportPath, dataCid.String()+".car")
+	if ref.IsCAR {
+		if err := copyFile(ref.Path, carPath); err != nil {
+			return offlineData{}, fmt.Errorf("copying car file: %s", err)
+		}
+	} else if err := m.api.ClientGenCar(ctx, ref, carPath); err != nil {
+		return offlineData{}, fmt.Errorf("generating car file: %s", err)
+	}
+	commP, err := m.api.ClientCalcCommP(ctx, carPath)
+	if err != nil {
+		return offlineData{}, fmt.Errorf("calculating piece commitment: %s", err)
+	}
+	return offlineData{carPath: carPath, commP: commP}, nil
+}
+
+// resolveExportPath returns the directory of a relative export path under the
+// export root. Absolute paths, paths with .. elements and paths outside the
+// root are rejected. An empty export path is the export root.
+func (m *Module) resolveExportPath(exportPath string) (string, error) {
+	if m.cfg.ExportRoot == "" {
+		return "", fmt.Errorf("offline deals are disabled since there isn't an export root")
+	}
+	if filepath.IsAbs(exportPath) || strings.HasPrefix(exportPath, "/") {
+		return "", fmt.Errorf("export path should be relative to the export root")
+	}
+	for _, e := range strings.FieldsFunc(exportPath, func(r rune) bool { return r == '/' || r == filepath.Separator }) {
+		if e == ".." {
+			return "", fmt.Errorf("export path can't contain .. elements")
+		}
+	}
+	p := filepath.Join(m.cfg.ExportRoot, exportPath)
+	if !isWithin(m.cfg.ExportRoot, p) {
+		return "", fmt.Errorf("export path is outside the export root")
+	}
+	return p, nil
+}
+
+// mkdirWithin creates the directories of path, which is lexically inside root,
+// one element at a time. Existing directories could be symlinks pointing outside
+// the root, so each one is resolved and checked before creating anything in it.
+func mkdirWithin(root, path string) error {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return fmt.Errorf("creating export root: %s", err)
+	}
+	realRoot, err := filepath.EvalSymlinks(root)
 	if err != nil {
-		return nil, fmt.Errorf("creating temp dir for retrieval: %s", err)
+		return fmt.Errorf("resolving export root: %s", err)
 	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return fmt.Errorf("export path is outside the export root")
+	}
+	dir := realRoot
+	for _, e := range strings.Split(rel, string(filepath.Separator)) {
+		if e == "." {
+			continue
+		}
+		next := filepath.Join(dir, e)
+		if err := os.Mkdir(next, 0700); err != nil && !os.IsExist(err) {
+			return fmt.Errorf("creating export path: %s", err)
+		}
+		dir, err = filepath.EvalSymlinks(next)
+		if err != nil {
+			return fmt.Errorf("resolving export path: %s", err)
+		}
+		if !isWithin(realRoot, dir) {
+			return fmt.Errorf("export path is outside the export root")
+		}
+	}
+	return nil
+}
+
+// isWithin returns true if path is root or is inside root.
+func isWithin(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.Errorf("closing source file: %s", err)
+		}
+	}()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Retrieve fetches the data stored in filecoin at a particular cid. It also
+// returns which provider served the data, and the price paid for it. Offers are
+// tried by provider reputation and then by price, and the outcome of each retrieval
+// is reported to the configured Reputation. The data is staged in a temporary directory
+// in the import path, which is removed when the returned reader is closed or if
+// retrieving fails.
+func (m *Module) Retrieve(ctx context.Context, waddr string, cid cid.Cid, exportCAR bool, opts ...RetrieveOption) (io.ReadCloser, RetrievalInfo, error) {
+	var rcfg RetrieveConfig
+	for _, o := range opts {
+		o(&rcfg)
+	}
+	rf, err := ioutil.TempDir(m.cfg.ImportPath, retrieveDirPrefix+"*")
+	if err != nil {
+		return nil, RetrievalInfo{}, fmt.Errorf("creating temp dir for retrieval: %s", err)
+	}
+	r, info, err := m.retrieve(ctx, rf, waddr, cid, exportCAR, rcfg)
+	if err != nil {
+		if err := os.RemoveAll(rf); err != nil {
+			log.Errorf("removing retrieval temp dir: %s", err)
+		}
+		return nil, RetrievalInfo{}, err
+	}
+	return &tempFileReader{File: r, dir: rf}, info, nil
+}
+
+func (m *Module) retrieve(ctx context.Context, rf string, waddr string, cid cid.Cid, exportCAR bool, rcfg RetrieveConfig) (*os.File, RetrievalInfo, error) {
 	addr, err := address.NewFromString(waddr)
 	if err != nil {
-		return nil, err
+		return nil, RetrievalInfo{}, err
 	}
 	offers, err := m.api.ClientFindData(ctx, cid)
 	if err != nil {
-		return nil, err
+		return nil, RetrievalInfo{}, err
 	}
 	if len(offers) == 0 {
-		return nil, ErrRetrievalNoAvailableProviders
+		return nil, RetrievalInfo{}, ErrRetrievalNoAvailableProviders
+	}
+	offers, lastErr := validOffers(offers)
+	if len(offers) == 0 {
+		return nil, RetrievalInfo{}, fmt.Errorf("no retrieval offers available, last offer error: %s", lastErr)
+	}
+	offers = m.rankOffers(offers, rcfg.MaxPrice)
+	if len(offers) == 0 {
+		return nil, RetrievalInfo{}, fmt.Errorf("no retrieval offers with price below %d", rcfg.MaxPrice)
 	}
 	fpath := filepath.Join(rf, "ret")
 	for _, o := range offers {
@@ -147,17 +426,84 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, cid cid.Cid, export
 			Path:  fpath,
 			IsCAR: exportCAR,
 		}
-		if err = m.api.ClientRetrieve(ctx, o.Order(addr), ref); err != nil {
+		if err = m.retrieveFromProvider(ctx, o, addr, ref); err != nil {
+			if ctx.Err() != nil {
+				return nil, RetrievalInfo{}, fmt.Errorf("retrieval canceled: %s", ctx.Err())
+			}
 			log.Infof("retrieving cid %s from %s: %s", cid, o.Miner, err)
+			if m.cfg.Reputation != nil {
+				m.cfg.Reputation.AddRetrievalResult(o.Miner.String(), false)
+			}
 			continue
 		}
+		if m.cfg.Reputation != nil {
+			m.cfg.Reputation.AddRetrievalResult(o.Miner.String(), true)
+		}
 		f, err := os.Open(fpath)
 		if err != nil {
-			return nil, fmt.Errorf("opening retrieved file: %s", err)
+			return nil, RetrievalInfo{}, fmt.Errorf("opening retrieved file: %s", err)
+		}
+		info := RetrievalInfo{
+			Miner: o.Miner.String(),
+			Price: o.MinPrice.Uint64(),
 		}
-		return f, nil
+		return f, info, nil
+	}
+	return nil, RetrievalInfo{}, fmt.Errorf("couldn't retrieve data from any miners, last miner err: %s", err)
+}
+
+// retrieveFromProvider retrieves data from a single offer, considering
+// the configured retrieval timeout.
+func (m *Module) retrieveFromProvider(ctx context.Context, o api.QueryOffer, addr address.Address, ref api.FileRef) error {
+	if m.cfg.RetrievalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.cfg.RetrievalTimeout)
+		defer cancel()
 	}
-	return nil, fmt.Errorf("couldn't retrieve data from any miners, last miner err: %s", err)
+	return m.api.ClientRetrieve(ctx, o.Order(addr), ref)
+}
+
+// validOffers returns the offers without an error, and the error of the
+// last offer that had one.
+func validOffers(offers []api.QueryOffer) ([]api.QueryOffer, string) {
+	res := make([]api.QueryOffer, 0, len(offers))
+	var lastErr string
+	for _, o := range offers {
+		if o.Err != "" {
+			lastErr = o.Err
+			continue
+		}
+		res = append(res, o)
+	}
+	return res, lastErr
+}
+
+// rankOffers returns the offers with a price not above maxPrice, sorted by
+// provider reputation score and then by price. A zero maxPrice means no limit.
+func (m *Module) rankOffers(offers []api.QueryOffer, maxPrice uint64) []api.QueryOffer {
+	res := make([]api.QueryOffer, 0, len(offers))
+	scores := make(map[string]int, len(offers))
+	for _, o := range offers {
+		if o.Err != "" {
+			log.Infof("ignoring offer from %s with error: %s", o.Miner, o.Err)
+			continue
+		}
+		if maxPrice > 0 && o.MinPrice.Uint64() > maxPrice {
+			continue
+		}
+		if m.cfg.Reputation != nil {
+			scores[o.Miner.String()], _ = m.cfg.Reputation.GetScore(o.Miner.String())
+		}
+		res = append(res, o)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		si, sj := scores[res[i].Miner.String()], scores[res[j].Miner.String()]
+		if si != sj {
+			return si > sj
+		}
+		return res[i].MinPrice.Uint64() < res[j].MinPrice.Uint64()
+	})
+	return res
 }
 
 // GetDealStatus returns the current status of the deal, and a flag indicating if the miner of the deal was slashed.
@@ -178,72 +524,226 @@ func (m *Module) GetDealStatus(ctx context.Context, pcid cid.Cid) (storagemarket
 	return di.State, md.State.SlashEpoch != -1, nil
 }
 
-// Watch returns a channel with state changes of indicated proposals
-func (m *Module) Watch(ctx context.Context, proposals []cid.Cid) (<-chan DealInfo, error) {
+// GetDealRecord returns the record of a deal proposal made with the Module.
+func (m *Module) GetDealRecord(pcid cid.Cid) (DealRecord, error) {
+	return m.records.get(pcid)
+}
+
+// ListDealRecords returns the records of deal proposals made with the
+// Module selected by a filter, ordered by time.
+func (m *Module) ListDealRecords(f DealRecordFilter) ([]DealRecord, error) {
+	return m.records.list(f)
+}
+
+// Watch returns a channel with state changes of indicated proposals. Recorded
+// state changes are replayed first, followed by new ones as they happen. State
+// changes are tracked by a single shared tracker, so they aren't lost if the
+// receiver is slow.
+func (m *Module) Watch(ctx context.Context, proposals []cid.Cid, opts ...WatchOption) (<-chan DealInfo, error) {
 	if len(proposals) == 0 {
 		return nil, fmt.Errorf("proposals list can't be empty")
 	}
-	ch := make(chan DealInfo)
-	w, err := m.api.ChainNotify(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("listening to chain changes: %s", err)
-	}
-	go func() {
-		defer close(ch)
-		currentState := make(map[cid.Cid]*api.DealInfo)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case _, ok := <-w:
-				if !ok {
-					log.Errorf("chain notify channel was closed by lotus node")
-					return
-				}
-				if err := pushNewChanges(ctx, m.api, currentState, proposals, ch); err != nil {
-					log.Errorf("pushing new proposal states: %s", err)
-				}
-			}
+	var cfg WatchConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return m.tracker.subscribe(ctx, proposals, cfg.ReplaySince)
+}
+
+// tempFileReader is a retrieved file that removes its
+// temporary directory when closed.
+type tempFileReader struct {
+	*os.File
+	dir string
+}
+
+// Close closes the file and removes its temporary directory.
+func (r *tempFileReader) Close() error {
+	if err := r.File.Close(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(r.dir); err != nil {
+		return fmt.Errorf("removing retrieval temp dir: %s", err)
+	}
+	return nil
+}
+
+// releaseImportFile removes the temporary import file of a deal that reached
+// a final state, if no other pending deal or running store needs it.
+func (m *Module) releaseImportFile(pcid cid.Cid) {
+	r, err := m.records.get(pcid)
+	if err != nil {
+		log.Errorf("getting deal record of %s: %s", pcid, err)
+		return
+	}
+	if r.ImportFile == "" {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.importing[r.ImportFile]; ok {
+		return
+	}
+	pending, err := m.pendingImportFiles()
+	if err != nil {
+		log.Errorf("getting import files of pending deals: %s", err)
+		return
+	}
+	if _, ok := pending[r.ImportFile]; ok {
+		return
+	}
+	if err := os.Remove(r.ImportFile); err != nil && !os.IsNotExist(err) {
+		log.Errorf("removing storing file: %s", err)
+	}
+}
+
+// stageImport copies data to a new temporary file in the import path, and returns
+// its path. Staging can't be avoided since the Lotus node imports data from a file
+// path, and keeps reading the file to transfer the data to miners until the deals
+// are final. Staging is bounded by the configured minimum free disk space, which is
+// checked before creating the file and periodically while copying. If staging fails,
+// the file is removed. The file is skipped by the GC until the returned func is called.
+func (m *Module) stageImport(data io.Reader) (string, func(), error) {
+	if err := m.checkFreeSpace(); err != nil {
+		return "", nil, err
+	}
+	f, err := ioutil.TempFile(m.cfg.ImportPath, importFilePrefix+"*")
+	if err != nil {
+		return "", nil, fmt.Errorf("error when creating tmpfile: %s", err)
+	}
+	m.lock.Lock()
+	m.importing[f.Name()] = struct{}{}
+	m.lock.Unlock()
+	done := func() {
+		m.lock.Lock()
+		delete(m.importing, f.Name())
+		m.lock.Unlock()
+	}
+	_, err = io.Copy(&freeSpaceWriter{m: m, w: f}, data)
+	if cerr := f.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	if err != nil {
+		done()
+		if err := os.Remove(f.Name()); err != nil {
+			log.Errorf("removing storing file: %s", err)
 		}
-	}()
-	return ch, nil
+		return "", nil, fmt.Errorf("error when copying data to tmpfile: %s", err)
+	}
+	return f.Name(), done, nil
 }
 
-func pushNewChanges(ctx context.Context, client *apistruct.FullNodeStruct, currState map[cid.Cid]*api.DealInfo, proposals []cid.Cid, ch chan<- DealInfo) error {
-	for _, pcid := range proposals {
-		dinfo, err := client.ClientGetDealInfo(ctx, pcid)
-		if err != nil {
-			log.Errorf("getting deal proposal info %s: %s", pcid, err)
+// pendingImportFiles returns the temporary import files needed
+// by deals that didn't reach a final state.
+func (m *Module) pendingImportFiles() (map[string]struct{}, error) {
+	rs, err := m.records.list(DealRecordFilter{OnlyPending: true})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]struct{}, len(rs))
+	for _, r := range rs {
+		if r.ImportFile != "" {
+			res[r.ImportFile] = struct{}{}
+		}
+	}
+	return res, nil
+}
+
+// freeSpaceWriter writes incoming data checking periodically that
+// the import path keeps the configured minimum free disk space.
+type freeSpaceWriter struct {
+	m         *Module
+	w         io.Writer
+	unchecked int
+}
+
+func (w *freeSpaceWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if err != nil {
+		return n, err
+	}
+	w.unchecked += n
+	if w.unchecked >= freeSpaceCheckInterval {
+		w.unchecked = 0
+		if err := w.m.checkFreeSpace(); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// checkFreeSpace returns an error if the import path doesn't have
+// the configured minimum free disk space. If getting the free disk
+// space isn't supported in the platform, the check is skipped.
+func (m *Module) checkFreeSpace() error {
+	if m.cfg.MinFreeSpace == 0 {
+		return nil
+	}
+	free, err := freeSpace(m.cfg.ImportPath)
+	if err == errFreeSpaceUnsupported {
+		m.warnFreeSpace.Do(func() {
+			log.Warnf("skipping free disk space checks: %s", err)
+		})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting free disk space of import path: %s", err)
+	}
+	if free < m.cfg.MinFreeSpace {
+		return fmt.Errorf("not enough free disk space in import path, %d bytes available but %d required", free, m.cfg.MinFreeSpace)
+	}
+	return nil
+}
+
+// runGC is a long running job that removes stale temporary
+// files from the import path.
+func (m *Module) runGC() {
+	defer close(m.finished)
+	for {
+		if err := m.gc(time.Now()); err != nil {
+			log.Errorf("removing stale temporary files: %s", err)
+		}
+		select {
+		case <-m.ctx.Done():
+			log.Info("graceful shutdown of deals gc background job")
+			return
+		case <-time.After(m.cfg.GCInterval):
+		}
+	}
+}
+
+// gc removes temporary files and directories of stores and retrievals
+// from the import path which are older than the configured stale age.
+// Import files needed by deals that didn't reach a final state are kept.
+// Leftovers can exist if the process stopped before cleaning them up.
+func (m *Module) gc(now time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	pending, err := m.pendingImportFiles()
+	if err != nil {
+		return fmt.Errorf("getting import files of pending deals: %s", err)
+	}
+	fis, err := ioutil.ReadDir(m.cfg.ImportPath)
+	if err != nil {
+		return fmt.Errorf("reading import path: %s", err)
+	}
+	for _, fi := range fis {
+		if !strings.HasPrefix(fi.Name(), importFilePrefix) && !strings.HasPrefix(fi.Name(), retrieveDirPrefix) {
 			continue
 		}
-		if currState[pcid] == nil || (*currState[pcid]).State != dinfo.State {
-			currState[pcid] = dinfo
-			newState := DealInfo{
-				ProposalCid:   dinfo.ProposalCid,
-				StateID:       dinfo.State,
-				StateName:     storagemarket.DealStates[dinfo.State],
-				Miner:         dinfo.Provider.String(),
-				PieceCID:      dinfo.PieceCID,
-				Size:          dinfo.Size,
-				PricePerEpoch: dinfo.PricePerEpoch.Uint64(),
-				Duration:      dinfo.Duration,
-				DealID:        uint64(dinfo.DealID),
-			}
-			if dinfo.State == storagemarket.StorageDealActive {
-				ocd, err := client.StateMarketStorageDeal(ctx, dinfo.DealID, types.EmptyTSK)
-				if err != nil {
-					log.Errorf("getting on-chain deal info: %s", err)
-					continue
-				}
-				newState.ActivationEpoch = int64(ocd.State.SectorStartEpoch)
-			}
-			select {
-			case <-ctx.Done():
-				return nil
-			case ch <- newState:
-			case <-time.After(chanWriteTimeout):
-				log.Warnf("dropping new state since chan is blocked")
-			}
+		if now.Sub(fi.ModTime()) < m.cfg.GCStaleAge {
+			continue
+		}
+		p := filepath.Join(m.cfg.ImportPath, fi.Name())
+		if _, ok := pending[p]; ok {
+			continue
+		}
+		if _, ok := m.importing[p]; ok {
+			continue
+		}
+		log.Infof("removing stale temporary file %s", fi.Name())
+		if err := os.RemoveAll(p); err != nil {
+			return fmt.Errorf("removing %s: %s", fi.Name(), err)
 		}
 	}
 	return nil
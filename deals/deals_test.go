@@ -4,18 +4,23 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"testing/iotest"
 	"time"
 
+	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/filecoin-project/lotus/chain/types"
 	"github.com/ipfs/go-cid"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/stretchr/testify/require"
+	"github.com/textileio/lotus-client/api"
 	"github.com/textileio/lotus-client/api/apistruct"
 	"github.com/textileio/powergate/tests"
 )
@@ -46,6 +51,79 @@ func TestStore(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreOffline(t *testing.T) {
+	client, addr, _ := tests.CreateLocalDevnet(t, 1)
+	exportRoot := filepath.Join(tmpDir, "exports")
+	m, err := New(client, WithImportPath(filepath.Join(tmpDir, "imports")), WithExportRoot(exportRoot))
+	checkErr(t, err)
+	ctx := context.Background()
+	miners, err := client.StateListMiners(ctx, types.EmptyTSK)
+	checkErr(t, err)
+
+	cfgs := []StorageDealConfig{{
+		Miner:      miners[0].String(),
+		EpochPrice: 1000000,
+		Offline:    true,
+		ExportPath: "offline",
+	}}
+	dcid, srs, err := m.Store(ctx, addr.String(), bytes.NewReader(randomBytes(600)), cfgs, 1000, false)
+	checkErr(t, err)
+	require.True(t, dcid.Defined())
+	require.Len(t, srs, 1)
+	require.True(t, srs[0].Success, srs[0].Message)
+	require.True(t, srs[0].ProposalCid.Defined())
+	require.True(t, srs[0].PieceCid.Defined())
+	require.Greater(t, srs[0].PieceSize, uint64(0))
+	require.Equal(t, filepath.Join(exportRoot, "offline", dcid.String()+".car"), srs[0].CarPath)
+	_, err = os.Stat(srs[0].CarPath)
+	require.NoError(t, err)
+}
+
+func TestResolveExportPath(t *testing.T) {
+	exportRoot, err := ioutil.TempDir(tmpDir, "exports-")
+	checkErr(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(exportRoot)) }()
+	m, err := New(nil, WithImportPath(filepath.Join(tmpDir, "imports")), WithExportRoot(exportRoot))
+	checkErr(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	valid := map[string]string{
+		"":        exportRoot,
+		"a":       filepath.Join(exportRoot, "a"),
+		"a/b/":    filepath.Join(exportRoot, "a", "b"),
+		"./a/./b": filepath.Join(exportRoot, "a", "b"),
+	}
+	for p, want := range valid {
+		got, err := m.resolveExportPath(p)
+		require.NoError(t, err, p)
+		require.Equal(t, want, got)
+	}
+	for _, p := range []string{"/tmp", exportRoot, "..", "../other", "a/../../other", "a/.."} {
+		_, err := m.resolveExportPath(p)
+		require.Error(t, err, p)
+	}
+
+	// A symlink inside the root can't be used to export outside of it.
+	outside, err := ioutil.TempDir(tmpDir, "outside-")
+	checkErr(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(outside)) }()
+	checkErr(t, os.Symlink(outside, filepath.Join(exportRoot, "link")))
+	_, err = m.exportOfflineData(context.Background(), api.FileRef{}, cid.Undef, "link")
+	require.Error(t, err)
+	// Nothing is created outside the root before rejecting the path.
+	_, err = m.exportOfflineData(context.Background(), api.FileRef{}, cid.Undef, "link/a/b")
+	require.Error(t, err)
+	_, err = os.Stat(filepath.Join(outside, "a"))
+	require.True(t, os.IsNotExist(err))
+
+	m2, err := New(nil, WithImportPath(filepath.Join(tmpDir, "imports")))
+	checkErr(t, err)
+	defer func() { require.NoError(t, m2.Close()) }()
+	_, err = m2.resolveExportPath("a")
+	require.Error(t, err)
+}
+
 func TestRetrieve(t *testing.T) {
 	numMiners := []int{1} // go-fil-markets: doesn't support remembering more than 1 miner
 	data := randomBytes(600)
@@ -60,11 +138,12 @@ func TestRetrieve(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
 			defer cancel()
 
-			r, err := m.Retrieve(ctx, addr.String(), dcid, false)
+			r, info, err := m.Retrieve(ctx, addr.String(), dcid, false)
 			checkErr(t, err)
 			defer func() {
 				require.NoError(t, r.Close())
 			}()
+			require.NotEmpty(t, info.Miner)
 			rdata, err := ioutil.ReadAll(r)
 			checkErr(t, err)
 			if !bytes.Equal(data, rdata) {
@@ -74,6 +153,156 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestGC(t *testing.T) {
+	importPath, err := ioutil.TempDir(tmpDir, "gc-")
+	checkErr(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(importPath)) }()
+	m, err := New(nil, WithImportPath(importPath), WithGCStaleAge(time.Hour))
+	checkErr(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	now := time.Now()
+	old := now.Add(-2 * time.Hour)
+	create := func(name string, dir bool, modTime time.Time) string {
+		p := filepath.Join(importPath, name)
+		if dir {
+			checkErr(t, os.Mkdir(p, 0700))
+		} else {
+			checkErr(t, ioutil.WriteFile(p, []byte("data"), 0600))
+		}
+		checkErr(t, os.Chtimes(p, modTime, modTime))
+		return p
+	}
+	staleImport := create("import-1", false, old)
+	staleRetrieve := create("retrieve-1", true, old)
+	freshImport := create("import-2", false, now)
+	pendingImport := create("import-3", false, old)
+	other := create("other", false, old)
+	checkErr(t, m.records.put(DealRecord{ProposalCid: randomCid(t), ImportFile: pendingImport, Time: old}))
+
+	checkErr(t, m.gc(now))
+	for _, p := range []string{staleImport, staleRetrieve} {
+		_, err := os.Stat(p)
+		require.True(t, os.IsNotExist(err))
+	}
+	for _, p := range []string{freshImport, pendingImport, other} {
+		_, err := os.Stat(p)
+		require.NoError(t, err)
+	}
+}
+
+func TestStageImport(t *testing.T) {
+	importPath, err := ioutil.TempDir(tmpDir, "stage-")
+	checkErr(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(importPath)) }()
+	m, err := New(nil, WithImportPath(importPath))
+	checkErr(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	data := randomBytes(600)
+	fname, done, err := m.stageImport(bytes.NewReader(data))
+	checkErr(t, err)
+	staged, err := ioutil.ReadFile(fname)
+	checkErr(t, err)
+	require.Equal(t, data, staged)
+	require.Contains(t, m.importing, fname)
+	done()
+	require.NotContains(t, m.importing, fname)
+
+	// Failing to copy the data removes the staged file.
+	_, _, err = m.stageImport(io.MultiReader(bytes.NewReader(data), iotest.TimeoutReader(bytes.NewReader(data))))
+	require.Error(t, err)
+	fis, err := ioutil.ReadDir(importPath)
+	checkErr(t, err)
+	require.Len(t, fis, 1)
+	require.Empty(t, m.importing)
+
+	// Staging is bounded by the minimum free disk space.
+	m2, err := New(nil, WithImportPath(importPath), WithMinFreeSpace(math.MaxUint64))
+	checkErr(t, err)
+	defer func() { require.NoError(t, m2.Close()) }()
+	_, _, err = m2.stageImport(bytes.NewReader(data))
+	require.Error(t, err)
+	fis, err = ioutil.ReadDir(importPath)
+	checkErr(t, err)
+	require.Len(t, fis, 1)
+}
+
+func TestReleaseImportFile(t *testing.T) {
+	importPath, err := ioutil.TempDir(tmpDir, "release-")
+	checkErr(t, err)
+	defer func() { require.NoError(t, os.RemoveAll(importPath)) }()
+	m, err := New(nil, WithImportPath(importPath))
+	checkErr(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+
+	f := filepath.Join(importPath, "import-1")
+	checkErr(t, ioutil.WriteFile(f, []byte("data"), 0600))
+	r1 := DealRecord{ProposalCid: randomCid(t), ImportFile: f, Final: true}
+	r2 := DealRecord{ProposalCid: randomCid(t), ImportFile: f}
+	checkErr(t, m.records.put(r1))
+	checkErr(t, m.records.put(r2))
+
+	// The file is still needed by the pending deal.
+	m.releaseImportFile(r1.ProposalCid)
+	_, err = os.Stat(f)
+	require.NoError(t, err)
+
+	r2.Final = true
+	checkErr(t, m.records.put(r2))
+	m.releaseImportFile(r2.ProposalCid)
+	_, err = os.Stat(f)
+	require.True(t, os.IsNotExist(err))
+}
+
+type fakeReputation map[string]int
+
+func (r fakeReputation) GetScore(addr string) (int, bool) {
+	s, ok := r[addr]
+	return s, ok
+}
+
+func (r fakeReputation) AddRetrievalResult(addr string, success bool) {}
+
+func TestRankOffers(t *testing.T) {
+	offer := func(id uint64, price uint64) api.QueryOffer {
+		maddr, err := address.NewIDAddress(id)
+		checkErr(t, err)
+		return api.QueryOffer{Miner: maddr, MinPrice: types.NewInt(price)}
+	}
+	offers := []api.QueryOffer{
+		offer(1000, 300),
+		offer(1001, 100),
+		offer(1002, 200),
+		offer(1003, 900),
+		{Miner: offer(1004, 1).Miner, MinPrice: types.NewInt(1), Err: "unavailable"},
+	}
+	rep := fakeReputation{"t01000": 80, "t01002": 80, "t01003": 90}
+	m, err := New(nil, WithImportPath(filepath.Join(tmpDir, "imports")), WithReputation(rep))
+	checkErr(t, err)
+
+	ranked := m.rankOffers(offers, 0)
+	var miners []string
+	for _, o := range ranked {
+		miners = append(miners, o.Miner.String())
+	}
+	require.Equal(t, []string{"t01003", "t01002", "t01000", "t01001"}, miners)
+
+	ranked = m.rankOffers(offers, 250)
+	miners = nil
+	for _, o := range ranked {
+		miners = append(miners, o.Miner.String())
+	}
+	require.Equal(t, []string{"t01002", "t01001"}, miners)
+
+	valid, lastErr := validOffers(offers)
+	require.Len(t, valid, 4)
+	require.Equal(t, "unavailable", lastErr)
+	valid, lastErr = validOffers(offers[4:])
+	require.Empty(t, valid)
+	require.Equal(t, "unavailable", lastErr)
+}
+
 func storeMultiMiner(m *Module, client *apistruct.FullNodeStruct, numMiners int, data []byte) (cid.Cid, error) {
 	ctx := context.Background()
 	miners, err := client.StateListMiners(ctx, types.EmptyTSK)
@@ -0,0 +1,7 @@
+package deals
+
+import "errors"
+
+// errFreeSpaceUnsupported indicates that getting the free disk
+// space isn't supported in the platform.
+var errFreeSpaceUnsupported = errors.New("getting free disk space isn't supported in this platform")
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package deals
+
+// freeSpace isn't supported in this platform, so it always
+// returns errFreeSpaceUnsupported.
+func freeSpace(path string) (uint64, error) {
+	return 0, errFreeSpaceUnsupported
+}
@@ -27,6 +27,8 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type DealConfig struct {
 	Miner                string   `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
 	EpochPrice           uint64   `protobuf:"varint,2,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
+	Offline              bool     `protobuf:"varint,3,opt,name=offline,proto3" json:"offline,omitempty"`
+	ExportPath           string   `protobuf:"bytes,4,opt,name=exportPath,proto3" json:"exportPath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -71,6 +73,107 @@ func (m *DealConfig) GetEpochPrice() uint64 {
 	return 0
 }
 
+func (m *DealConfig) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *DealConfig) GetExportPath() string {
+	if m != nil {
+		return m.ExportPath
+	}
+	return ""
+}
+
+type StoreResult struct {
+	ProposalCid          string      `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	Config               *DealConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Success              bool        `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string      `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CarPath              string      `protobuf:"bytes,5,opt,name=carPath,proto3" json:"carPath,omitempty"`
+	PieceCid             string      `protobuf:"bytes,6,opt,name=pieceCid,proto3" json:"pieceCid,omitempty"`
+	PieceSize            uint64      `protobuf:"varint,7,opt,name=pieceSize,proto3" json:"pieceSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StoreResult) Reset()         { *m = StoreResult{} }
+func (m *StoreResult) String() string { return proto.CompactTextString(m) }
+func (*StoreResult) ProtoMessage()    {}
+func (*StoreResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{1}
+}
+
+func (m *StoreResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreResult.Unmarshal(m, b)
+}
+func (m *StoreResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreResult.Marshal(b, m, deterministic)
+}
+func (m *StoreResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreResult.Merge(m, src)
+}
+func (m *StoreResult) XXX_Size() int {
+	return xxx_messageInfo_StoreResult.Size(m)
+}
+func (m *StoreResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreResult proto.InternalMessageInfo
+
+func (m *StoreResult) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+func (m *StoreResult) GetConfig() *DealConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *StoreResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *StoreResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *StoreResult) GetCarPath() string {
+	if m != nil {
+		return m.CarPath
+	}
+	return ""
+}
+
+func (m *StoreResult) GetPieceCid() string {
+	if m != nil {
+		return m.PieceCid
+	}
+	return ""
+}
+
+func (m *StoreResult) GetPieceSize() uint64 {
+	if m != nil {
+		return m.PieceSize
+	}
+	return 0
+}
+
 type DealInfo struct {
 	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
 	StateID              uint64   `protobuf:"varint,2,opt,name=stateID,proto3" json:"stateID,omitempty"`
@@ -80,6 +183,8 @@ type DealInfo struct {
 	Size                 uint64   `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
 	PricePerEpoch        uint64   `protobuf:"varint,7,opt,name=pricePerEpoch,proto3" json:"pricePerEpoch,omitempty"`
 	Duration             uint64   `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	DealID               uint64   `protobuf:"varint,9,opt,name=dealID,proto3" json:"dealID,omitempty"`
+	ActivationEpoch      int64    `protobuf:"varint,10,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -89,7 +194,7 @@ func (m *DealInfo) Reset()         { *m = DealInfo{} }
 func (m *DealInfo) String() string { return proto.CompactTextString(m) }
 func (*DealInfo) ProtoMessage()    {}
 func (*DealInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{1}
+	return fileDescriptor_71783c876a92172d, []int{2}
 }
 
 func (m *DealInfo) XXX_Unmarshal(b []byte) error {
@@ -166,6 +271,20 @@ func (m *DealInfo) GetDuration() uint64 {
 	return 0
 }
 
+func (m *DealInfo) GetDealID() uint64 {
+	if m != nil {
+		return m.DealID
+	}
+	return 0
+}
+
+func (m *DealInfo) GetActivationEpoch() int64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
 type StoreParams struct {
 	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	DealConfigs          []*DealConfig `protobuf:"bytes,2,rep,name=dealConfigs,proto3" json:"dealConfigs,omitempty"`
@@ -179,7 +298,7 @@ func (m *StoreParams) Reset()         { *m = StoreParams{} }
 func (m *StoreParams) String() string { return proto.CompactTextString(m) }
 func (*StoreParams) ProtoMessage()    {}
 func (*StoreParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{2}
+	return fileDescriptor_71783c876a92172d, []int{3}
 }
 
 func (m *StoreParams) XXX_Unmarshal(b []byte) error {
@@ -235,7 +354,7 @@ func (m *StoreRequest) Reset()         { *m = StoreRequest{} }
 func (m *StoreRequest) String() string { return proto.CompactTextString(m) }
 func (*StoreRequest) ProtoMessage()    {}
 func (*StoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{3}
+	return fileDescriptor_71783c876a92172d, []int{4}
 }
 
 func (m *StoreRequest) XXX_Unmarshal(b []byte) error {
@@ -302,19 +421,20 @@ func (*StoreRequest) XXX_OneofWrappers() []interface{} {
 }
 
 type StoreReply struct {
-	DataCid              string        `protobuf:"bytes,1,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
-	ProposalCids         []string      `protobuf:"bytes,2,rep,name=proposalCids,proto3" json:"proposalCids,omitempty"`
-	FailedDeals          []*DealConfig `protobuf:"bytes,3,rep,name=failedDeals,proto3" json:"failedDeals,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	DataCid              string         `protobuf:"bytes,1,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
+	ProposalCids         []string       `protobuf:"bytes,2,rep,name=proposalCids,proto3" json:"proposalCids,omitempty"`
+	FailedDeals          []*DealConfig  `protobuf:"bytes,3,rep,name=failedDeals,proto3" json:"failedDeals,omitempty"`
+	Results              []*StoreResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *StoreReply) Reset()         { *m = StoreReply{} }
 func (m *StoreReply) String() string { return proto.CompactTextString(m) }
 func (*StoreReply) ProtoMessage()    {}
 func (*StoreReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{4}
+	return fileDescriptor_71783c876a92172d, []int{5}
 }
 
 func (m *StoreReply) XXX_Unmarshal(b []byte) error {
@@ -356,8 +476,16 @@ func (m *StoreReply) GetFailedDeals() []*DealConfig {
 	return nil
 }
 
+func (m *StoreReply) GetResults() []*StoreResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type WatchRequest struct {
 	Proposals            []string `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	ReplaySince          int64    `protobuf:"varint,2,opt,name=replaySince,proto3" json:"replaySince,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -367,7 +495,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{5}
+	return fileDescriptor_71783c876a92172d, []int{6}
 }
 
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
@@ -395,6 +523,13 @@ func (m *WatchRequest) GetProposals() []string {
 	return nil
 }
 
+func (m *WatchRequest) GetReplaySince() int64 {
+	if m != nil {
+		return m.ReplaySince
+	}
+	return 0
+}
+
 type WatchReply struct {
 	DealInfo             *DealInfo `protobuf:"bytes,1,opt,name=dealInfo,proto3" json:"dealInfo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -406,7 +541,7 @@ func (m *WatchReply) Reset()         { *m = WatchReply{} }
 func (m *WatchReply) String() string { return proto.CompactTextString(m) }
 func (*WatchReply) ProtoMessage()    {}
 func (*WatchReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{6}
+	return fileDescriptor_71783c876a92172d, []int{7}
 }
 
 func (m *WatchReply) XXX_Unmarshal(b []byte) error {
@@ -437,6 +572,7 @@ func (m *WatchReply) GetDealInfo() *DealInfo {
 type RetrieveRequest struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	MaxPrice             uint64   `protobuf:"varint,3,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -446,7 +582,7 @@ func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{7}
+	return fileDescriptor_71783c876a92172d, []int{8}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -481,18 +617,73 @@ func (m *RetrieveRequest) GetCid() string {
 	return ""
 }
 
-type RetrieveReply struct {
-	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+func (m *RetrieveRequest) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+type RetrievalInfo struct {
+	Miner                string   `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Price                uint64   `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *RetrievalInfo) Reset()         { *m = RetrievalInfo{} }
+func (m *RetrievalInfo) String() string { return proto.CompactTextString(m) }
+func (*RetrievalInfo) ProtoMessage()    {}
+func (*RetrievalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{9}
+}
+
+func (m *RetrievalInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrievalInfo.Unmarshal(m, b)
+}
+func (m *RetrievalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrievalInfo.Marshal(b, m, deterministic)
+}
+func (m *RetrievalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrievalInfo.Merge(m, src)
+}
+func (m *RetrievalInfo) XXX_Size() int {
+	return xxx_messageInfo_RetrievalInfo.Size(m)
+}
+func (m *RetrievalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrievalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrievalInfo proto.InternalMessageInfo
+
+func (m *RetrievalInfo) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *RetrievalInfo) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type RetrieveReply struct {
+	Chunk                []byte         `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Info                 *RetrievalInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
 func (m *RetrieveReply) Reset()         { *m = RetrieveReply{} }
 func (m *RetrieveReply) String() string { return proto.CompactTextString(m) }
 func (*RetrieveReply) ProtoMessage()    {}
 func (*RetrieveReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71783c876a92172d, []int{8}
+	return fileDescriptor_71783c876a92172d, []int{10}
 }
 
 func (m *RetrieveReply) XXX_Unmarshal(b []byte) error {
@@ -520,8 +711,402 @@ func (m *RetrieveReply) GetChunk() []byte {
 	return nil
 }
 
+func (m *RetrieveReply) GetInfo() *RetrievalInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type DealStateChange struct {
+	StateID              uint64   `protobuf:"varint,1,opt,name=stateID,proto3" json:"stateID,omitempty"`
+	StateName            string   `protobuf:"bytes,2,opt,name=stateName,proto3" json:"stateName,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DealStateChange) Reset()         { *m = DealStateChange{} }
+func (m *DealStateChange) String() string { return proto.CompactTextString(m) }
+func (*DealStateChange) ProtoMessage()    {}
+func (*DealStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{11}
+}
+
+func (m *DealStateChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DealStateChange.Unmarshal(m, b)
+}
+func (m *DealStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DealStateChange.Marshal(b, m, deterministic)
+}
+func (m *DealStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealStateChange.Merge(m, src)
+}
+func (m *DealStateChange) XXX_Size() int {
+	return xxx_messageInfo_DealStateChange.Size(m)
+}
+func (m *DealStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealStateChange proto.InternalMessageInfo
+
+func (m *DealStateChange) GetStateID() uint64 {
+	if m != nil {
+		return m.StateID
+	}
+	return 0
+}
+
+func (m *DealStateChange) GetStateName() string {
+	if m != nil {
+		return m.StateName
+	}
+	return ""
+}
+
+func (m *DealStateChange) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type DealRecord struct {
+	ProposalCid          string             `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	Address              string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Miner                string             `protobuf:"bytes,3,opt,name=miner,proto3" json:"miner,omitempty"`
+	EpochPrice           uint64             `protobuf:"varint,4,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
+	Duration             uint64             `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	DataCid              string             `protobuf:"bytes,6,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
+	PieceCid             string             `protobuf:"bytes,7,opt,name=pieceCid,proto3" json:"pieceCid,omitempty"`
+	Offline              bool               `protobuf:"varint,8,opt,name=offline,proto3" json:"offline,omitempty"`
+	Time                 int64              `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+	History              []*DealStateChange `protobuf:"bytes,10,rep,name=history,proto3" json:"history,omitempty"`
+	Final                bool               `protobuf:"varint,11,opt,name=final,proto3" json:"final,omitempty"`
+	DealID               uint64             `protobuf:"varint,12,opt,name=dealID,proto3" json:"dealID,omitempty"`
+	Size                 uint64             `protobuf:"varint,13,opt,name=size,proto3" json:"size,omitempty"`
+	ActivationEpoch      int64              `protobuf:"varint,14,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
+	Error                string             `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DealRecord) Reset()         { *m = DealRecord{} }
+func (m *DealRecord) String() string { return proto.CompactTextString(m) }
+func (*DealRecord) ProtoMessage()    {}
+func (*DealRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{12}
+}
+
+func (m *DealRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DealRecord.Unmarshal(m, b)
+}
+func (m *DealRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DealRecord.Marshal(b, m, deterministic)
+}
+func (m *DealRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealRecord.Merge(m, src)
+}
+func (m *DealRecord) XXX_Size() int {
+	return xxx_messageInfo_DealRecord.Size(m)
+}
+func (m *DealRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DealRecord proto.InternalMessageInfo
+
+func (m *DealRecord) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+func (m *DealRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DealRecord) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *DealRecord) GetEpochPrice() uint64 {
+	if m != nil {
+		return m.EpochPrice
+	}
+	return 0
+}
+
+func (m *DealRecord) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *DealRecord) GetDataCid() string {
+	if m != nil {
+		return m.DataCid
+	}
+	return ""
+}
+
+func (m *DealRecord) GetPieceCid() string {
+	if m != nil {
+		return m.PieceCid
+	}
+	return ""
+}
+
+func (m *DealRecord) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *DealRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *DealRecord) GetHistory() []*DealStateChange {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *DealRecord) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *DealRecord) GetDealID() uint64 {
+	if m != nil {
+		return m.DealID
+	}
+	return 0
+}
+
+func (m *DealRecord) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *DealRecord) GetActivationEpoch() int64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *DealRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListDealRecordsRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Miner                string   `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	DataCid              string   `protobuf:"bytes,3,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
+	OnlyPending          bool     `protobuf:"varint,4,opt,name=onlyPending,proto3" json:"onlyPending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDealRecordsRequest) Reset()         { *m = ListDealRecordsRequest{} }
+func (m *ListDealRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDealRecordsRequest) ProtoMessage()    {}
+func (*ListDealRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{13}
+}
+
+func (m *ListDealRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDealRecordsRequest.Unmarshal(m, b)
+}
+func (m *ListDealRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDealRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDealRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDealRecordsRequest.Merge(m, src)
+}
+func (m *ListDealRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDealRecordsRequest.Size(m)
+}
+func (m *ListDealRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDealRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDealRecordsRequest proto.InternalMessageInfo
+
+func (m *ListDealRecordsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ListDealRecordsRequest) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *ListDealRecordsRequest) GetDataCid() string {
+	if m != nil {
+		return m.DataCid
+	}
+	return ""
+}
+
+func (m *ListDealRecordsRequest) GetOnlyPending() bool {
+	if m != nil {
+		return m.OnlyPending
+	}
+	return false
+}
+
+type ListDealRecordsReply struct {
+	Records              []*DealRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListDealRecordsReply) Reset()         { *m = ListDealRecordsReply{} }
+func (m *ListDealRecordsReply) String() string { return proto.CompactTextString(m) }
+func (*ListDealRecordsReply) ProtoMessage()    {}
+func (*ListDealRecordsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{14}
+}
+
+func (m *ListDealRecordsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDealRecordsReply.Unmarshal(m, b)
+}
+func (m *ListDealRecordsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDealRecordsReply.Marshal(b, m, deterministic)
+}
+func (m *ListDealRecordsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDealRecordsReply.Merge(m, src)
+}
+func (m *ListDealRecordsReply) XXX_Size() int {
+	return xxx_messageInfo_ListDealRecordsReply.Size(m)
+}
+func (m *ListDealRecordsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDealRecordsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDealRecordsReply proto.InternalMessageInfo
+
+func (m *ListDealRecordsReply) GetRecords() []*DealRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type GetDealRecordRequest struct {
+	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDealRecordRequest) Reset()         { *m = GetDealRecordRequest{} }
+func (m *GetDealRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDealRecordRequest) ProtoMessage()    {}
+func (*GetDealRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{15}
+}
+
+func (m *GetDealRecordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDealRecordRequest.Unmarshal(m, b)
+}
+func (m *GetDealRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDealRecordRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDealRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDealRecordRequest.Merge(m, src)
+}
+func (m *GetDealRecordRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDealRecordRequest.Size(m)
+}
+func (m *GetDealRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDealRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDealRecordRequest proto.InternalMessageInfo
+
+func (m *GetDealRecordRequest) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+type GetDealRecordReply struct {
+	Record               *DealRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetDealRecordReply) Reset()         { *m = GetDealRecordReply{} }
+func (m *GetDealRecordReply) String() string { return proto.CompactTextString(m) }
+func (*GetDealRecordReply) ProtoMessage()    {}
+func (*GetDealRecordReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71783c876a92172d, []int{16}
+}
+
+func (m *GetDealRecordReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDealRecordReply.Unmarshal(m, b)
+}
+func (m *GetDealRecordReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDealRecordReply.Marshal(b, m, deterministic)
+}
+func (m *GetDealRecordReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDealRecordReply.Merge(m, src)
+}
+func (m *GetDealRecordReply) XXX_Size() int {
+	return xxx_messageInfo_GetDealRecordReply.Size(m)
+}
+func (m *GetDealRecordReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDealRecordReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDealRecordReply proto.InternalMessageInfo
+
+func (m *GetDealRecordReply) GetRecord() *DealRecord {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DealConfig)(nil), "filecoin.deals.pb.DealConfig")
+	proto.RegisterType((*StoreResult)(nil), "filecoin.deals.pb.StoreResult")
 	proto.RegisterType((*DealInfo)(nil), "filecoin.deals.pb.DealInfo")
 	proto.RegisterType((*StoreParams)(nil), "filecoin.deals.pb.StoreParams")
 	proto.RegisterType((*StoreRequest)(nil), "filecoin.deals.pb.StoreRequest")
@@ -529,7 +1114,14 @@ func init() {
 	proto.RegisterType((*WatchRequest)(nil), "filecoin.deals.pb.WatchRequest")
 	proto.RegisterType((*WatchReply)(nil), "filecoin.deals.pb.WatchReply")
 	proto.RegisterType((*RetrieveRequest)(nil), "filecoin.deals.pb.RetrieveRequest")
+	proto.RegisterType((*RetrievalInfo)(nil), "filecoin.deals.pb.RetrievalInfo")
 	proto.RegisterType((*RetrieveReply)(nil), "filecoin.deals.pb.RetrieveReply")
+	proto.RegisterType((*DealStateChange)(nil), "filecoin.deals.pb.DealStateChange")
+	proto.RegisterType((*DealRecord)(nil), "filecoin.deals.pb.DealRecord")
+	proto.RegisterType((*ListDealRecordsRequest)(nil), "filecoin.deals.pb.ListDealRecordsRequest")
+	proto.RegisterType((*ListDealRecordsReply)(nil), "filecoin.deals.pb.ListDealRecordsReply")
+	proto.RegisterType((*GetDealRecordRequest)(nil), "filecoin.deals.pb.GetDealRecordRequest")
+	proto.RegisterType((*GetDealRecordReply)(nil), "filecoin.deals.pb.GetDealRecordReply")
 }
 
 func init() {
@@ -537,43 +1129,72 @@ func init() {
 }
 
 var fileDescriptor_71783c876a92172d = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x4d, 0xbb, 0x6d, 0x5e, 0x5a, 0xd4, 0x61, 0x91, 0x50, 0xbb, 0x6b, 0x18, 0x14,
-	0x7a, 0x90, 0x22, 0x15, 0xf1, 0x24, 0x62, 0x7f, 0xc8, 0x16, 0x41, 0x4a, 0x2c, 0x78, 0x9e, 0x4d,
-	0xa6, 0x76, 0x30, 0xcd, 0xc4, 0x64, 0x2a, 0xd6, 0xb3, 0x37, 0xff, 0x0b, 0xff, 0x3e, 0xaf, 0xde,
-	0x65, 0x26, 0x93, 0x26, 0xdd, 0x6d, 0x65, 0x6f, 0xf3, 0x5e, 0xde, 0x8f, 0xef, 0xfb, 0xbc, 0x47,
-	0xc0, 0x09, 0x19, 0x8d, 0xb2, 0x61, 0x92, 0x0a, 0x29, 0xf0, 0x83, 0x15, 0x8f, 0x58, 0x20, 0x78,
-	0x3c, 0x34, 0xde, 0x6b, 0x32, 0x06, 0x98, 0x32, 0x1a, 0x4d, 0x44, 0xbc, 0xe2, 0x9f, 0xf1, 0x39,
-	0x34, 0x37, 0x3c, 0x66, 0xa9, 0x8b, 0x3c, 0x34, 0xb0, 0xfd, 0xdc, 0xc0, 0x97, 0x00, 0x2c, 0x11,
-	0xc1, 0x7a, 0x91, 0xf2, 0x80, 0xb9, 0x75, 0x0f, 0x0d, 0x1a, 0x7e, 0xc5, 0x43, 0xfe, 0x20, 0x68,
-	0xab, 0x22, 0xf3, 0x78, 0x25, 0xb0, 0x07, 0x4e, 0x92, 0x8a, 0x44, 0x64, 0x34, 0x9a, 0xf0, 0xd0,
-	0x14, 0xaa, 0xba, 0xb0, 0x0b, 0xad, 0x4c, 0x52, 0xc9, 0xe6, 0x53, 0x53, 0xab, 0x30, 0x71, 0x1f,
-	0x6c, 0xfd, 0xfc, 0x40, 0x37, 0xcc, 0xb5, 0x74, 0x66, 0xe9, 0x28, 0xc5, 0x35, 0xaa, 0xe2, 0x7a,
-	0xd0, 0x4e, 0x38, 0x0b, 0xd8, 0x64, 0x3e, 0x75, 0x9b, 0x1e, 0x1a, 0x74, 0xfc, 0xbd, 0x8d, 0x31,
-	0x34, 0x32, 0xfe, 0x83, 0xb9, 0x67, 0xba, 0x8d, 0x7e, 0xe3, 0x27, 0xd0, 0x4d, 0x94, 0xea, 0x05,
-	0x4b, 0x67, 0x6a, 0x04, 0xb7, 0xa5, 0x3f, 0x1e, 0x3a, 0x55, 0xd5, 0x70, 0x9b, 0x52, 0xc9, 0x45,
-	0xec, 0xb6, 0x75, 0xc0, 0xde, 0x26, 0x3f, 0x11, 0x38, 0x1f, 0xa5, 0x48, 0xd9, 0x82, 0xa6, 0x74,
-	0x93, 0xa9, 0x79, 0x68, 0x18, 0xa6, 0x2c, 0xcb, 0xcc, 0xb4, 0x85, 0x89, 0xdf, 0xe4, 0xf8, 0x73,
-	0xb8, 0x99, 0x5b, 0xf7, 0xac, 0x81, 0x33, 0xba, 0x18, 0xde, 0xda, 0xc2, 0xb0, 0x5c, 0x81, 0x5f,
-	0xcd, 0x38, 0x90, 0x61, 0xdd, 0x90, 0xb1, 0x85, 0x8e, 0x56, 0xe1, 0xb3, 0xaf, 0x5b, 0x96, 0x49,
-	0x3c, 0x06, 0x27, 0x2b, 0x55, 0x69, 0x29, 0xce, 0xe8, 0xf2, 0x48, 0xb3, 0x8a, 0xf6, 0xab, 0x9a,
-	0x5f, 0x4d, 0xc2, 0x0f, 0xa1, 0x19, 0xac, 0xb7, 0xf1, 0x17, 0xbd, 0x98, 0xce, 0x55, 0xcd, 0xcf,
-	0xcd, 0xb1, 0x0d, 0xad, 0x84, 0xee, 0x22, 0x41, 0x43, 0xf2, 0x0b, 0x01, 0x98, 0xbe, 0x49, 0xb4,
-	0x53, 0xc3, 0x87, 0x54, 0xd2, 0x72, 0xd5, 0x85, 0x89, 0x09, 0x74, 0x2a, 0x5b, 0xcf, 0xa7, 0xb7,
-	0xfd, 0x03, 0x9f, 0x02, 0xb4, 0xa2, 0x3c, 0x62, 0xa1, 0x02, 0x90, 0xb9, 0xd6, 0x9d, 0x00, 0x55,
-	0x32, 0xc8, 0x33, 0xe8, 0x7c, 0xa2, 0x32, 0x58, 0x17, 0x10, 0xfa, 0x60, 0x17, 0x0d, 0x14, 0x02,
-	0xd5, 0xb1, 0x74, 0x90, 0x19, 0x80, 0x89, 0x56, 0xd2, 0x5f, 0x41, 0x3b, 0x34, 0x57, 0x6b, 0x68,
-	0x3d, 0x3a, 0xd1, 0x59, 0x85, 0xf8, 0xfb, 0x60, 0xf2, 0x1a, 0xee, 0xf9, 0x4c, 0xa6, 0x9c, 0x7d,
-	0xdb, 0xc3, 0x3f, 0x7d, 0x03, 0xf7, 0xc1, 0x0a, 0x78, 0xa8, 0x81, 0xda, 0xbe, 0x7a, 0x92, 0xa7,
-	0xd0, 0x2d, 0xd3, 0x95, 0x90, 0xf3, 0x82, 0x3a, 0xd2, 0xf7, 0x9b, 0x1b, 0xa3, 0xbf, 0x08, 0xac,
-	0xb7, 0x8b, 0x39, 0x7e, 0x0f, 0x4d, 0xcd, 0x1b, 0x3f, 0x3e, 0xb5, 0x4b, 0x23, 0xa2, 0x77, 0x71,
-	0x3a, 0x20, 0x89, 0x76, 0xa4, 0x36, 0x40, 0xaa, 0x98, 0x26, 0x70, 0xb4, 0x58, 0x95, 0xe4, 0xd1,
-	0x62, 0x25, 0x3c, 0x52, 0x7b, 0x8e, 0xf0, 0x12, 0xda, 0xc5, 0x20, 0x98, 0x1c, 0x09, 0xbf, 0x01,
-	0xa9, 0xe7, 0xfd, 0x37, 0xc6, 0x54, 0x1d, 0xbf, 0x84, 0x3e, 0x17, 0x43, 0xc9, 0xbe, 0x4b, 0x1e,
-	0xb1, 0xdb, 0x19, 0xe3, 0xee, 0x3b, 0xe3, 0xd2, 0x17, 0xb0, 0x40, 0xbf, 0xeb, 0xd6, 0x72, 0x39,
-	0xbb, 0x3e, 0xd3, 0xbf, 0xb8, 0x17, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x20, 0x45, 0xd6, 0x41,
-	0xf1, 0x04, 0x00, 0x00,
+	// 1037 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdb, 0x8e, 0xe3, 0x44,
+	0x13, 0x1e, 0xc7, 0x39, 0x56, 0x32, 0x7f, 0x7e, 0x5a, 0xd1, 0xc8, 0x0a, 0x3b, 0x8b, 0xd5, 0x02,
+	0x11, 0x6e, 0x22, 0x34, 0x30, 0x62, 0x25, 0x90, 0x10, 0x73, 0x80, 0x8d, 0x16, 0x2d, 0x51, 0xcf,
+	0x48, 0x08, 0x21, 0x2e, 0x7a, 0xed, 0xce, 0xa4, 0x85, 0x63, 0x1b, 0xdb, 0x59, 0x4d, 0x10, 0x97,
+	0x3c, 0x09, 0x0f, 0xc0, 0x23, 0x20, 0x71, 0xc1, 0xeb, 0xf0, 0x0c, 0xa8, 0x4f, 0x4e, 0x3b, 0xe3,
+	0x4c, 0xe6, 0xce, 0x55, 0x5d, 0x55, 0xfd, 0xd5, 0xe9, 0x6b, 0x43, 0x3f, 0x64, 0x34, 0xca, 0xa7,
+	0x69, 0x96, 0x14, 0x09, 0x7a, 0x67, 0xc1, 0x23, 0x16, 0x24, 0x3c, 0x9e, 0x6a, 0xed, 0x1b, 0xfc,
+	0x1b, 0xc0, 0x15, 0xa3, 0xd1, 0x65, 0x12, 0x2f, 0xf8, 0x1d, 0x1a, 0x41, 0x6b, 0xc5, 0x63, 0x96,
+	0x79, 0x8e, 0xef, 0x4c, 0x7a, 0x44, 0x09, 0xe8, 0x39, 0x00, 0x4b, 0x93, 0x60, 0x39, 0xcf, 0x78,
+	0xc0, 0xbc, 0x86, 0xef, 0x4c, 0x9a, 0xc4, 0xd2, 0x20, 0x0f, 0x3a, 0xc9, 0x62, 0x11, 0xf1, 0x98,
+	0x79, 0xae, 0xef, 0x4c, 0xba, 0xc4, 0x88, 0xd2, 0xf3, 0x3e, 0x4d, 0xb2, 0x62, 0x4e, 0x8b, 0xa5,
+	0xd7, 0x94, 0x41, 0x2d, 0x0d, 0xfe, 0xd7, 0x81, 0xfe, 0x4d, 0x91, 0x64, 0x8c, 0xb0, 0x7c, 0x1d,
+	0x15, 0xc8, 0x87, 0x7e, 0x9a, 0x25, 0x69, 0x92, 0xd3, 0xe8, 0x92, 0x87, 0x1a, 0x85, 0xad, 0x42,
+	0xe7, 0xd0, 0x0e, 0x24, 0x56, 0x89, 0xa3, 0x7f, 0x76, 0x3a, 0x7d, 0x90, 0xd3, 0x74, 0x9b, 0x10,
+	0xd1, 0xc6, 0x02, 0x62, 0xbe, 0x0e, 0x02, 0x96, 0xe7, 0x06, 0xa2, 0x16, 0xc5, 0xc9, 0x8a, 0xe5,
+	0x39, 0xbd, 0x63, 0x1a, 0x9f, 0x11, 0xc5, 0x49, 0x40, 0x33, 0x89, 0xbc, 0xa5, 0x4e, 0xb4, 0x88,
+	0xc6, 0xd0, 0x4d, 0x39, 0x0b, 0x98, 0xc0, 0xd8, 0x96, 0x47, 0xa5, 0x8c, 0x9e, 0x41, 0x4f, 0x7e,
+	0xdf, 0xf0, 0x5f, 0x99, 0xd7, 0x91, 0xb5, 0xda, 0x2a, 0xf0, 0x9f, 0x0d, 0xe8, 0x0a, 0x78, 0xb3,
+	0x78, 0x91, 0x3c, 0x21, 0x5b, 0x01, 0xbb, 0xa0, 0x05, 0x9b, 0x5d, 0xe9, 0xb2, 0x1b, 0x51, 0x5c,
+	0x23, 0x3f, 0x5f, 0xd3, 0x95, 0xaa, 0x7a, 0x8f, 0x6c, 0x15, 0xdb, 0x3e, 0x36, 0xed, 0x3e, 0x96,
+	0xb0, 0x67, 0x57, 0x32, 0xa3, 0x01, 0x29, 0x65, 0x84, 0xa0, 0x99, 0x0b, 0xc4, 0x6d, 0x79, 0x8d,
+	0xfc, 0x46, 0xef, 0xc3, 0x71, 0x2a, 0x1a, 0x3c, 0x67, 0xd9, 0xb5, 0xe8, 0xb6, 0x4e, 0xa7, 0xaa,
+	0x14, 0x51, 0xc3, 0x75, 0x46, 0x0b, 0x9e, 0xc4, 0x5e, 0x57, 0x1a, 0x94, 0x32, 0x3a, 0x81, 0xb6,
+	0xe8, 0xca, 0xec, 0xca, 0xeb, 0xc9, 0x13, 0x2d, 0xa1, 0x09, 0x0c, 0x69, 0x50, 0xf0, 0xb7, 0xd2,
+	0x4a, 0xc5, 0x06, 0xdf, 0x99, 0xb8, 0x64, 0x57, 0x8d, 0x7f, 0x37, 0x13, 0x32, 0xa7, 0x19, 0x5d,
+	0xc9, 0x76, 0xd1, 0x30, 0xcc, 0x44, 0x23, 0x55, 0xbd, 0x8c, 0x88, 0xbe, 0x54, 0xb3, 0xae, 0x1a,
+	0x9f, 0x7b, 0x0d, 0xdf, 0x3d, 0x3c, 0x1e, 0xb6, 0x47, 0x25, 0x11, 0xb7, 0x9a, 0x08, 0x5e, 0xc3,
+	0x40, 0xcf, 0xe9, 0x2f, 0x6b, 0x96, 0x17, 0xe8, 0x02, 0xfa, 0xf9, 0x16, 0x95, 0x84, 0xd2, 0x3f,
+	0x7b, 0x5e, 0x73, 0x99, 0x85, 0xfd, 0xe5, 0x11, 0xb1, 0x9d, 0xd0, 0x09, 0xb4, 0x82, 0xe5, 0x3a,
+	0xfe, 0x59, 0xb6, 0x76, 0xf0, 0xf2, 0x88, 0x28, 0xf1, 0xa2, 0x07, 0x9d, 0x94, 0x6e, 0xa2, 0x84,
+	0x86, 0xf8, 0x1f, 0x07, 0x40, 0xdf, 0x9b, 0x46, 0x1b, 0x91, 0x7c, 0x48, 0x0b, 0xba, 0x1d, 0x16,
+	0x23, 0x22, 0x0c, 0x03, 0x6b, 0x6e, 0x54, 0xf6, 0x3d, 0x52, 0xd1, 0x89, 0x02, 0x2d, 0x28, 0x8f,
+	0x58, 0x28, 0x0a, 0x20, 0xf6, 0xe0, 0x29, 0x05, 0xb2, 0x3c, 0xd0, 0x0b, 0xe8, 0x64, 0x72, 0x4f,
+	0x73, 0xaf, 0xe9, 0xbb, 0x8f, 0x25, 0xac, 0xd6, 0x99, 0x18, 0x73, 0xfc, 0x1a, 0x06, 0xdf, 0xd3,
+	0x22, 0x58, 0x9a, 0xf2, 0x89, 0x25, 0xd1, 0xd0, 0x44, 0xf1, 0x04, 0xd6, 0xad, 0x42, 0xec, 0x45,
+	0xc6, 0xd2, 0x88, 0x6e, 0x6e, 0x78, 0xac, 0x09, 0xc7, 0x25, 0xb6, 0x0a, 0x5f, 0x03, 0xe8, 0x78,
+	0xa2, 0x2c, 0x9f, 0x41, 0x37, 0xd4, 0x3b, 0xa5, 0x3b, 0xf1, 0xee, 0x9e, 0xac, 0x84, 0x09, 0x29,
+	0x8d, 0xf1, 0x0f, 0x30, 0x24, 0xac, 0xc8, 0x38, 0x7b, 0x5b, 0x36, 0x76, 0xff, 0x7c, 0xfd, 0x1f,
+	0xdc, 0x80, 0x87, 0x12, 0x4d, 0x8f, 0x88, 0x4f, 0x31, 0x30, 0x2b, 0x7a, 0xaf, 0x58, 0x51, 0x0f,
+	0x8c, 0x91, 0xf1, 0xe7, 0x70, 0xac, 0x43, 0xeb, 0x65, 0xaf, 0xa7, 0xd6, 0x11, 0xb4, 0x52, 0x8b,
+	0x55, 0x95, 0x80, 0x7f, 0x2c, 0x9d, 0x75, 0xe3, 0x47, 0x66, 0x54, 0x1c, 0xb9, 0xb6, 0x4a, 0x40,
+	0x9f, 0x42, 0x93, 0x8b, 0x9c, 0x15, 0x13, 0xfa, 0x35, 0x39, 0x57, 0x20, 0x10, 0x69, 0x8d, 0x7f,
+	0x82, 0xa1, 0x28, 0xc5, 0x8d, 0x20, 0x8b, 0xcb, 0x25, 0x8d, 0x15, 0xd3, 0x19, 0x9a, 0x71, 0x1e,
+	0xa1, 0x99, 0xc6, 0x2e, 0xcd, 0x20, 0x68, 0x16, 0x5c, 0xf3, 0x8f, 0x4b, 0xe4, 0x37, 0xfe, 0xcb,
+	0x55, 0x2f, 0x0a, 0x61, 0x41, 0x92, 0x85, 0x4f, 0xe3, 0x38, 0x53, 0xf1, 0x46, 0xb5, 0xe2, 0x65,
+	0xc9, 0xdc, 0xfd, 0xaf, 0x51, 0xf3, 0xc1, 0x6b, 0x64, 0xaf, 0x71, 0x6b, 0x87, 0x8f, 0xac, 0x05,
+	0x6a, 0x57, 0x17, 0xc8, 0xa6, 0xf4, 0xce, 0x0e, 0xa5, 0x5b, 0xef, 0x5b, 0xb7, 0xfa, 0xbe, 0x99,
+	0x02, 0xf4, 0xb6, 0x05, 0x40, 0x5f, 0x40, 0x67, 0xc9, 0xc5, 0x9e, 0x6f, 0x3c, 0x90, 0x5b, 0x82,
+	0xf7, 0x0c, 0xa3, 0xd5, 0x01, 0x62, 0x5c, 0x44, 0xce, 0x0b, 0x1e, 0xd3, 0xc8, 0xeb, 0xcb, 0x9b,
+	0x94, 0x60, 0xf1, 0xe8, 0xa0, 0xc2, 0xa3, 0x86, 0xb5, 0x8f, 0x2d, 0xd6, 0xae, 0xe1, 0xd6, 0xff,
+	0xd5, 0x72, 0xab, 0xb8, 0x8b, 0x65, 0x59, 0x92, 0x79, 0x43, 0x55, 0x5f, 0x29, 0x08, 0xc6, 0x3d,
+	0xf9, 0x96, 0xe7, 0xc5, 0xb6, 0x89, 0xf9, 0xe1, 0xe5, 0x28, 0x5b, 0xd5, 0xb0, 0x5b, 0x65, 0x95,
+	0xdb, 0xad, 0x96, 0xdb, 0x87, 0x7e, 0x12, 0x47, 0x9b, 0x39, 0x8b, 0x43, 0x1e, 0xdf, 0xc9, 0x2e,
+	0x76, 0x89, 0xad, 0xc2, 0xdf, 0xc1, 0xe8, 0x01, 0x0a, 0xb5, 0xec, 0x9d, 0x4c, 0xc9, 0x9e, 0xf3,
+	0x28, 0x83, 0x29, 0x2f, 0x62, 0xac, 0xf1, 0x0b, 0x18, 0x7d, 0xc3, 0xac, 0x78, 0x26, 0xa9, 0x83,
+	0x13, 0x8a, 0x5f, 0x01, 0xda, 0xf1, 0x14, 0x40, 0xce, 0xa1, 0xad, 0x42, 0x6b, 0xce, 0x39, 0x80,
+	0x43, 0x1b, 0x9f, 0xfd, 0xed, 0x82, 0xfb, 0xd5, 0x7c, 0x86, 0x5e, 0x41, 0x4b, 0x52, 0x25, 0x7a,
+	0x6f, 0x3f, 0x89, 0x4a, 0x80, 0xe3, 0xd3, 0xfd, 0x06, 0x69, 0xb4, 0xc1, 0x47, 0x13, 0x47, 0x04,
+	0x93, 0x7c, 0x58, 0x1b, 0xcc, 0x66, 0xde, 0xf1, 0xe9, 0x7e, 0x03, 0x19, 0xec, 0x63, 0x07, 0xdd,
+	0x42, 0xd7, 0xb0, 0x0f, 0xc2, 0xfb, 0x49, 0xa5, 0xc4, 0xe7, 0x3f, 0x6a, 0x63, 0xa2, 0xde, 0xc1,
+	0x70, 0xa7, 0x9f, 0xe8, 0xa3, 0x1a, 0xc7, 0xfa, 0xc9, 0x1b, 0x7f, 0xf8, 0x14, 0x53, 0x79, 0x15,
+	0xa2, 0x70, 0x5c, 0xe9, 0x16, 0xaa, 0xf3, 0xad, 0x9b, 0x84, 0xf1, 0x07, 0x87, 0x0d, 0xe5, 0x15,
+	0x17, 0xe7, 0xf0, 0x8c, 0x27, 0xd3, 0x82, 0xdd, 0x17, 0x3c, 0x62, 0x0f, 0x9d, 0x2e, 0x8e, 0xbf,
+	0xd6, 0x2a, 0xe1, 0x9a, 0xcf, 0x9d, 0x3f, 0x1a, 0xee, 0xed, 0xed, 0xf5, 0x9b, 0xb6, 0xfc, 0x0b,
+	0xff, 0xe4, 0xbf, 0x01, 0x00, 0x32, 0x97, 0xd7, 0x79, 0x94, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -591,6 +1212,8 @@ type APIClient interface {
 	Store(ctx context.Context, opts ...grpc.CallOption) (API_StoreClient, error)
 	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (API_WatchClient, error)
 	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (API_RetrieveClient, error)
+	ListDealRecords(ctx context.Context, in *ListDealRecordsRequest, opts ...grpc.CallOption) (*ListDealRecordsReply, error)
+	GetDealRecord(ctx context.Context, in *GetDealRecordRequest, opts ...grpc.CallOption) (*GetDealRecordReply, error)
 }
 
 type aPIClient struct {
@@ -699,11 +1322,31 @@ func (x *aPIRetrieveClient) Recv() (*RetrieveReply, error) {
 	return m, nil
 }
 
+func (c *aPIClient) ListDealRecords(ctx context.Context, in *ListDealRecordsRequest, opts ...grpc.CallOption) (*ListDealRecordsReply, error) {
+	out := new(ListDealRecordsReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/ListDealRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetDealRecord(ctx context.Context, in *GetDealRecordRequest, opts ...grpc.CallOption) (*GetDealRecordReply, error) {
+	out := new(GetDealRecordReply)
+	err := c.cc.Invoke(ctx, "/filecoin.deals.pb.API/GetDealRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 type APIServer interface {
 	Store(API_StoreServer) error
 	Watch(*WatchRequest, API_WatchServer) error
 	Retrieve(*RetrieveRequest, API_RetrieveServer) error
+	ListDealRecords(context.Context, *ListDealRecordsRequest) (*ListDealRecordsReply, error)
+	GetDealRecord(context.Context, *GetDealRecordRequest) (*GetDealRecordReply, error)
 }
 
 // UnimplementedAPIServer can be embedded to have forward compatible implementations.
@@ -719,6 +1362,12 @@ func (*UnimplementedAPIServer) Watch(req *WatchRequest, srv API_WatchServer) err
 func (*UnimplementedAPIServer) Retrieve(req *RetrieveRequest, srv API_RetrieveServer) error {
 	return status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
 }
+func (*UnimplementedAPIServer) ListDealRecords(ctx context.Context, req *ListDealRecordsRequest) (*ListDealRecordsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDealRecords not implemented")
+}
+func (*UnimplementedAPIServer) GetDealRecord(ctx context.Context, req *GetDealRecordRequest) (*GetDealRecordReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDealRecord not implemented")
+}
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
 	s.RegisterService(&_API_serviceDesc, srv)
@@ -792,10 +1441,55 @@ func (x *aPIRetrieveServer) Send(m *RetrieveReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_ListDealRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDealRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListDealRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/ListDealRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListDealRecords(ctx, req.(*ListDealRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetDealRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDealRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetDealRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filecoin.deals.pb.API/GetDealRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetDealRecord(ctx, req.(*GetDealRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "filecoin.deals.pb.API",
 	HandlerType: (*APIServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDealRecords",
+			Handler:    _API_ListDealRecords_Handler,
+		},
+		{
+			MethodName: "GetDealRecord",
+			Handler:    _API_GetDealRecord_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Store",
@@ -0,0 +1,172 @@
+package deals
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+var (
+	dsDealRecord       = datastore.NewKey("dealrecord")
+	dsFailedDealRecord = dsDealRecord.ChildString("failed")
+)
+
+// recordStore persists deal records in a Datastore.
+type recordStore struct {
+	lock sync.Mutex
+	ds   datastore.Datastore
+}
+
+func newRecordStore(ds datastore.Datastore) *recordStore {
+	return &recordStore{
+		ds: ds,
+	}
+}
+
+// put saves a new deal record. Records of failed proposals, which
+// don't have a proposal cid, are saved with a unique key.
+func (s *recordStore) put(r DealRecord) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !r.ProposalCid.Defined() {
+		buf, err := json.Marshal(r)
+		if err != nil {
+			return fmt.Errorf("marshaling deal record for datastore: %s", err)
+		}
+		if err := s.ds.Put(dsFailedDealRecord.ChildString(uuid.New().String()), buf); err != nil {
+			return fmt.Errorf("put deal record in datastore: %s", err)
+		}
+		return nil
+	}
+	return s.save(r)
+}
+
+// get returns the deal record of a proposal.
+func (s *recordStore) get(pcid cid.Cid) (DealRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.load(pcid)
+}
+
+// addStateChange appends a new state to the history of a deal record, if
+// it's different from the last known state. Deals that weren't proposed with
+// the Module get a new record with the information available. It returns true
+// if the state changed.
+func (s *recordStore) addStateChange(di DealInfo, t time.Time) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, err := s.load(di.ProposalCid)
+	if err == ErrDealRecordNotFound {
+		r = DealRecord{
+			ProposalCid: di.ProposalCid,
+			Miner:       di.Miner,
+			EpochPrice:  di.PricePerEpoch,
+			Duration:    di.Duration,
+			Time:        t,
+		}
+	} else if err != nil {
+		return false, err
+	}
+	if l := len(r.History); l > 0 && r.History[l-1].StateID == di.StateID {
+		return false, nil
+	}
+	r.History = append(r.History, DealStateChange{
+		StateID:   di.StateID,
+		StateName: di.StateName,
+		Time:      t,
+	})
+	r.Final = isFinalState(di.StateID)
+	if !r.PieceCid.Defined() && di.PieceCID.Defined() {
+		r.PieceCid = di.PieceCID
+	}
+	if di.DealID != 0 {
+		r.DealID = di.DealID
+	}
+	if di.Size != 0 {
+		r.Size = di.Size
+	}
+	if di.ActivationEpoch != 0 {
+		r.ActivationEpoch = di.ActivationEpoch
+	}
+	return true, s.save(r)
+}
+
+// list returns the deal records selected by a filter, ordered by time.
+func (s *recordStore) list(f DealRecordFilter) ([]DealRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res, err := s.ds.Query(query.Query{Prefix: dsDealRecord.String()})
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var rs []DealRecord
+	for e := range res.Next() {
+		if e.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", e.Error)
+		}
+		var r DealRecord
+		if err := json.Unmarshal(e.Value, &r); err != nil {
+			return nil, fmt.Errorf("unmarshaling deal record from datastore: %s", err)
+		}
+		if f.Match(r) {
+			rs = append(rs, r)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Time.Before(rs[j].Time)
+	})
+	return rs, nil
+}
+
+func (s *recordStore) load(pcid cid.Cid) (DealRecord, error) {
+	buf, err := s.ds.Get(makeDealRecordKey(pcid))
+	if err == datastore.ErrNotFound {
+		return DealRecord{}, ErrDealRecordNotFound
+	}
+	if err != nil {
+		return DealRecord{}, fmt.Errorf("getting deal record from datastore: %s", err)
+	}
+	var r DealRecord
+	if err := json.Unmarshal(buf, &r); err != nil {
+		return DealRecord{}, fmt.Errorf("unmarshaling deal record from datastore: %s", err)
+	}
+	return r, nil
+}
+
+func (s *recordStore) save(r DealRecord) error {
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("marshaling deal record for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeDealRecordKey(r.ProposalCid), buf); err != nil {
+		return fmt.Errorf("put deal record in datastore: %s", err)
+	}
+	return nil
+}
+
+// isFinalState returns true if a deal in the state won't
+// change its state anymore.
+func isFinalState(stateID uint64) bool {
+	return stateID == storagemarket.StorageDealActive ||
+		stateID == storagemarket.StorageDealError ||
+		stateID == storagemarket.StorageDealFailing
+}
+
+func makeDealRecordKey(pcid cid.Cid) datastore.Key {
+	return dsDealRecord.ChildString(pcid.String())
+}
@@ -0,0 +1,128 @@
+package deals
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDealRecords(t *testing.T) {
+	s := newRecordStore(datastore.NewMapDatastore())
+	now := time.Now()
+	r1 := DealRecord{
+		ProposalCid: randomCid(t),
+		Addr:        "addr1",
+		Miner:       "t01000",
+		EpochPrice:  1000,
+		Duration:    100,
+		DataCid:     randomCid(t),
+		Time:        now,
+	}
+	r2 := DealRecord{
+		ProposalCid: randomCid(t),
+		Addr:        "addr2",
+		Miner:       "t01001",
+		EpochPrice:  2000,
+		Duration:    200,
+		DataCid:     r1.DataCid,
+		Time:        now.Add(time.Second),
+	}
+	require.NoError(t, s.put(r2))
+	require.NoError(t, s.put(r1))
+
+	_, err := s.get(randomCid(t))
+	require.Equal(t, ErrDealRecordNotFound, err)
+
+	pieceCid := randomCid(t)
+	di := DealInfo{ProposalCid: r1.ProposalCid, StateID: storagemarket.StorageDealStaged, StateName: "staged", PieceCID: pieceCid}
+	changed, err := s.addStateChange(di, now)
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = s.addStateChange(di, now.Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, changed)
+	di.StateID, di.StateName = storagemarket.StorageDealActive, "active"
+	changed, err = s.addStateChange(di, now.Add(2*time.Second))
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	r, err := s.get(r1.ProposalCid)
+	require.NoError(t, err)
+	require.Len(t, r.History, 2)
+	require.Equal(t, uint64(storagemarket.StorageDealStaged), r.History[0].StateID)
+	require.Equal(t, uint64(storagemarket.StorageDealActive), r.History[1].StateID)
+	require.True(t, r.Final)
+	require.Equal(t, pieceCid, r.PieceCid)
+
+	rs, err := s.list(DealRecordFilter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+	require.Equal(t, r1.ProposalCid, rs[0].ProposalCid)
+	require.Equal(t, r2.ProposalCid, rs[1].ProposalCid)
+
+	rs, err = s.list(DealRecordFilter{DataCid: r1.DataCid, OnlyPending: true})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	require.Equal(t, r2.ProposalCid, rs[0].ProposalCid)
+
+	rs, err = s.list(DealRecordFilter{Miner: "t01000"})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	require.Equal(t, r1.ProposalCid, rs[0].ProposalCid)
+}
+
+func TestFailedDealRecords(t *testing.T) {
+	s := newRecordStore(datastore.NewMapDatastore())
+	now := time.Now()
+	dataCid := randomCid(t)
+	failed := DealRecord{
+		Addr:       "addr1",
+		Miner:      "t01000",
+		EpochPrice: 1000,
+		Duration:   100,
+		DataCid:    dataCid,
+		Time:       now,
+		Final:      true,
+		Error:      "deal rejected",
+	}
+	// Many failed proposals can be recorded for the same data and miner.
+	require.NoError(t, s.put(failed))
+	require.NoError(t, s.put(failed))
+	pending := DealRecord{
+		ProposalCid: randomCid(t),
+		Addr:        "addr1",
+		Miner:       "t01001",
+		DataCid:     dataCid,
+		Time:        now.Add(time.Second),
+	}
+	require.NoError(t, s.put(pending))
+
+	rs, err := s.list(DealRecordFilter{DataCid: dataCid})
+	require.NoError(t, err)
+	require.Len(t, rs, 3)
+	for _, r := range rs[:2] {
+		require.False(t, r.ProposalCid.Defined())
+		require.Equal(t, "deal rejected", r.Error)
+		require.True(t, r.Final)
+	}
+
+	rs, err = s.list(DealRecordFilter{DataCid: dataCid, OnlyPending: true})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	require.Equal(t, pending.ProposalCid, rs[0].ProposalCid)
+}
+
+func randomCid(t *testing.T) cid.Cid {
+	r := make([]byte, 16)
+	_, err := rand.Read(r)
+	checkErr(t, err)
+	mh, err := multihash.Sum(r, multihash.IDENTITY, -1)
+	checkErr(t, err)
+	return cid.NewCidV1(cid.Raw, mh)
+}
@@ -3,6 +3,7 @@ package deals
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	pb "github.com/textileio/powergate/deals/pb"
@@ -22,6 +23,7 @@ type storeResult struct {
 	DataCid      cid.Cid
 	ProposalCids []cid.Cid
 	FailedDeals  []StorageDealConfig
+	Results      []StoreResult
 	Err          error
 }
 
@@ -39,6 +41,8 @@ func store(ctx context.Context, dealsModule *Module, storeParams *pb.StoreParams
 		dealConfigs[i] = StorageDealConfig{
 			Miner:      dealConfig.GetMiner(),
 			EpochPrice: dealConfig.GetEpochPrice(),
+			Offline:    dealConfig.GetOffline(),
+			ExportPath: dealConfig.GetExportPath(),
 		}
 	}
 	dcid, sr, err := dealsModule.Store(ctx, storeParams.GetAddress(), r, dealConfigs, storeParams.GetDuration(), false)
@@ -55,7 +59,7 @@ func store(ctx context.Context, dealsModule *Module, storeParams *pb.StoreParams
 			failedDeals = append(failedDeals, res.Config)
 		}
 	}
-	ch <- storeResult{DataCid: dcid, ProposalCids: pcids, FailedDeals: failedDeals}
+	ch <- storeResult{DataCid: dcid, ProposalCids: pcids, FailedDeals: failedDeals, Results: sr}
 }
 
 // Store calls deals.Store
@@ -109,10 +113,27 @@ func (s *Service) Store(srv pb.API_StoreServer) error {
 
 	replyFailedDeals := make([]*pb.DealConfig, len(storeResult.FailedDeals))
 	for i, dealConfig := range storeResult.FailedDeals {
-		replyFailedDeals[i] = &pb.DealConfig{Miner: dealConfig.Miner, EpochPrice: dealConfig.EpochPrice}
+		replyFailedDeals[i] = toRPCDealConfig(dealConfig)
 	}
 
-	return srv.SendAndClose(&pb.StoreReply{DataCid: storeResult.DataCid.String(), ProposalCids: replyCids, FailedDeals: replyFailedDeals})
+	replyResults := make([]*pb.StoreResult, len(storeResult.Results))
+	for i, res := range storeResult.Results {
+		replyResults[i] = &pb.StoreResult{
+			Config:    toRPCDealConfig(res.Config),
+			Success:   res.Success,
+			Message:   res.Message,
+			CarPath:   res.CarPath,
+			PieceSize: res.PieceSize,
+		}
+		if res.ProposalCid.Defined() {
+			replyResults[i].ProposalCid = res.ProposalCid.String()
+		}
+		if res.PieceCid.Defined() {
+			replyResults[i].PieceCid = res.PieceCid.String()
+		}
+	}
+
+	return srv.SendAndClose(&pb.StoreReply{DataCid: storeResult.DataCid.String(), ProposalCids: replyCids, FailedDeals: replyFailedDeals, Results: replyResults})
 }
 
 // Watch calls deals.Watch
@@ -125,21 +146,27 @@ func (s *Service) Watch(req *pb.WatchRequest, srv pb.API_WatchServer) error {
 		}
 		proposals[i] = id
 	}
-	ch, err := s.Module.Watch(srv.Context(), proposals)
+	var opts []WatchOption
+	if req.GetReplaySince() != 0 {
+		opts = append(opts, WithReplaySince(time.Unix(0, req.GetReplaySince())))
+	}
+	ch, err := s.Module.Watch(srv.Context(), proposals, opts...)
 	if err != nil {
 		return err
 	}
 
 	for update := range ch {
 		dealInfo := &pb.DealInfo{
-			ProposalCid:   update.ProposalCid.String(),
-			StateID:       update.StateID,
-			StateName:     update.StateName,
-			Miner:         update.Miner,
-			PieceCID:      update.PieceCID.Bytes(),
-			Size:          update.Size,
-			PricePerEpoch: update.PricePerEpoch,
-			Duration:      update.Duration,
+			ProposalCid:     update.ProposalCid.String(),
+			StateID:         update.StateID,
+			StateName:       update.StateName,
+			Miner:           update.Miner,
+			PieceCID:        update.PieceCID.Bytes(),
+			Size:            update.Size,
+			PricePerEpoch:   update.PricePerEpoch,
+			Duration:        update.Duration,
+			DealID:          update.DealID,
+			ActivationEpoch: update.ActivationEpoch,
 		}
 		if err := srv.Send(&pb.WatchReply{DealInfo: dealInfo}); err != nil {
 			log.Errorf("sending response: %s", err)
@@ -149,14 +176,15 @@ func (s *Service) Watch(req *pb.WatchRequest, srv pb.API_WatchServer) error {
 	return nil
 }
 
-// Retrieve calls deals.Retreive
+// Retrieve calls deals.Retreive. The first reply contains the information
+// of the retrieval, and the following ones the retrieved data.
 func (s *Service) Retrieve(req *pb.RetrieveRequest, srv pb.API_RetrieveServer) error {
 	cid, err := cid.Parse(req.GetCid())
 	if err != nil {
 		return err
 	}
 
-	reader, err := s.Module.Retrieve(srv.Context(), req.GetAddress(), cid, false)
+	reader, info, err := s.Module.Retrieve(srv.Context(), req.GetAddress(), cid, false, WithMaxPrice(req.GetMaxPrice()))
 	if err != nil {
 		return err
 	}
@@ -165,6 +193,9 @@ func (s *Service) Retrieve(req *pb.RetrieveRequest, srv pb.API_RetrieveServer) e
 			log.Errorf("closing reader on Retrieve: %s", err)
 		}
 	}()
+	if err := srv.Send(&pb.RetrieveReply{Info: &pb.RetrievalInfo{Miner: info.Miner, Price: info.Price}}); err != nil {
+		return err
+	}
 
 	buffer := make([]byte, 1024*32) // 32KB
 	for {
@@ -180,3 +211,85 @@ func (s *Service) Retrieve(req *pb.RetrieveRequest, srv pb.API_RetrieveServer) e
 		}
 	}
 }
+
+func toRPCDealConfig(c StorageDealConfig) *pb.DealConfig {
+	return &pb.DealConfig{
+		Miner:      c.Miner,
+		EpochPrice: c.EpochPrice,
+		Offline:    c.Offline,
+		ExportPath: c.ExportPath,
+	}
+}
+
+// ListDealRecords calls deals.ListDealRecords
+func (s *Service) ListDealRecords(ctx context.Context, req *pb.ListDealRecordsRequest) (*pb.ListDealRecordsReply, error) {
+	f := DealRecordFilter{
+		Addr:        req.GetAddress(),
+		Miner:       req.GetMiner(),
+		OnlyPending: req.GetOnlyPending(),
+	}
+	if req.GetDataCid() != "" {
+		c, err := cid.Decode(req.GetDataCid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "decoding data cid: %s", err)
+		}
+		f.DataCid = c
+	}
+	rs, err := s.Module.ListDealRecords(f)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*pb.DealRecord, len(rs))
+	for i, r := range rs {
+		records[i] = toRPCDealRecord(r)
+	}
+	return &pb.ListDealRecordsReply{Records: records}, nil
+}
+
+// GetDealRecord calls deals.GetDealRecord
+func (s *Service) GetDealRecord(ctx context.Context, req *pb.GetDealRecordRequest) (*pb.GetDealRecordReply, error) {
+	pcid, err := cid.Decode(req.GetProposalCid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "decoding proposal cid: %s", err)
+	}
+	r, err := s.Module.GetDealRecord(pcid)
+	if err == ErrDealRecordNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDealRecordReply{Record: toRPCDealRecord(r)}, nil
+}
+
+func toRPCDealRecord(r DealRecord) *pb.DealRecord {
+	res := &pb.DealRecord{
+		Address:         r.Addr,
+		Miner:           r.Miner,
+		EpochPrice:      r.EpochPrice,
+		Duration:        r.Duration,
+		DataCid:         r.DataCid.String(),
+		Offline:         r.Offline,
+		Time:            r.Time.UnixNano(),
+		History:         make([]*pb.DealStateChange, len(r.History)),
+		Final:           r.Final,
+		DealID:          r.DealID,
+		Size:            r.Size,
+		ActivationEpoch: r.ActivationEpoch,
+		Error:           r.Error,
+	}
+	if r.ProposalCid.Defined() {
+		res.ProposalCid = r.ProposalCid.String()
+	}
+	if r.PieceCid.Defined() {
+		res.PieceCid = r.PieceCid.String()
+	}
+	for i, h := range r.History {
+		res.History[i] = &pb.DealStateChange{
+			StateID:   h.StateID,
+			StateName: h.StateName,
+			Time:      h.Time.UnixNano(),
+		}
+	}
+	return res
+}
@@ -0,0 +1,363 @@
+package deals
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/lotus-client/api/apistruct"
+)
+
+var (
+	trackerRetryInterval = time.Second * 10
+	// trackerMaxErrors is how many consecutive polls can fail getting
+	// the state of a proposal before considering it failed.
+	trackerMaxErrors = 120
+)
+
+// tracker is a single subsystem that tracks the state of deal proposals. It
+// polls all tracked proposals once per tipset, persists every state transition
+// in the deal records, and notifies subscribed watchers. Proposals are tracked
+// until they reach a final state, or their state can't be known for too long.
+type tracker struct {
+	api     *apistruct.FullNodeStruct
+	records *recordStore
+	// onFinal is called when a tracked proposal reaches
+	// a final state.
+	onFinal func(cid.Cid)
+
+	lock    sync.Mutex
+	tracked map[cid.Cid]struct{}
+	// errors are the consecutive failed polls of tracked proposals.
+	errors map[cid.Cid]int
+	subs   map[*subscriber]struct{}
+
+	start    sync.Once
+	ctx      context.Context
+	cancel   context.CancelFunc
+	started  bool
+	finished chan struct{}
+}
+
+// subscriber receives state transitions of a set of proposals. Transitions are
+// queued without bounds, so a slow receiver doesn't lose any of them.
+type subscriber struct {
+	proposals map[cid.Cid]struct{}
+
+	lock   sync.Mutex
+	queue  []DealInfo
+	notify chan struct{}
+}
+
+func newTracker(api *apistruct.FullNodeStruct, records *recordStore, onFinal func(cid.Cid)) (*tracker, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &tracker{
+		api:      api,
+		records:  records,
+		onFinal:  onFinal,
+		tracked:  make(map[cid.Cid]struct{}),
+		errors:   make(map[cid.Cid]int),
+		subs:     make(map[*subscriber]struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	pending, err := records.list(DealRecordFilter{OnlyPending: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range pending {
+		t.track(r.ProposalCid)
+	}
+	return t, nil
+}
+
+// track starts tracking a proposal. The background polling starts
+// with the first tracked proposal.
+func (t *tracker) track(pcid cid.Cid) {
+	t.lock.Lock()
+	t.tracked[pcid] = struct{}{}
+	t.lock.Unlock()
+	t.start.Do(func() {
+		t.lock.Lock()
+		t.started = true
+		t.lock.Unlock()
+		go t.run()
+	})
+}
+
+// subscribe returns a channel with state transitions of the proposals. Recorded
+// transitions that happened after since are replayed first, followed by new ones
+// as they happen. The channel is closed when ctx is canceled.
+func (t *tracker) subscribe(ctx context.Context, proposals []cid.Cid, since time.Time) (<-chan DealInfo, error) {
+	s := &subscriber{
+		proposals: make(map[cid.Cid]struct{}, len(proposals)),
+		notify:    make(chan struct{}, 1),
+	}
+	// Reading the history and registering the subscriber while holding
+	// the lock guarantees that transitions persisted afterwards are
+	// published to it, after the replayed ones.
+	var toTrack []cid.Cid
+	t.lock.Lock()
+	for _, pcid := range proposals {
+		s.proposals[pcid] = struct{}{}
+		r, err := t.records.get(pcid)
+		if err == ErrDealRecordNotFound {
+			toTrack = append(toTrack, pcid)
+			continue
+		}
+		if err != nil {
+			t.lock.Unlock()
+			return nil, err
+		}
+		for _, h := range r.History {
+			if h.Time.After(since) {
+				s.queue = append(s.queue, toDealInfo(r, h))
+			}
+		}
+		if !r.Final {
+			toTrack = append(toTrack, pcid)
+		}
+	}
+	t.subs[s] = struct{}{}
+	t.lock.Unlock()
+	for _, pcid := range toTrack {
+		t.track(pcid)
+	}
+	s.signal()
+
+	ch := make(chan DealInfo)
+	go func() {
+		defer close(ch)
+		defer func() {
+			t.lock.Lock()
+			delete(t.subs, s)
+			t.lock.Unlock()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.ctx.Done():
+				return
+			case <-s.notify:
+			}
+			for _, di := range s.drain() {
+				select {
+				case <-ctx.Done():
+					return
+				case <-t.ctx.Done():
+					return
+				case ch <- di:
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// close stops tracking proposals.
+func (t *tracker) close() {
+	t.cancel()
+	t.lock.Lock()
+	started := t.started
+	t.lock.Unlock()
+	if started {
+		<-t.finished
+	}
+}
+
+// run is a long running job that polls tracked proposals on every new
+// tipset. If Lotus closes the notification channel, it subscribes again.
+func (t *tracker) run() {
+	defer close(t.finished)
+	for {
+		if err := t.listen(); err != nil {
+			log.Errorf("tracking deals: %s", err)
+		}
+		select {
+		case <-t.ctx.Done():
+			log.Info("graceful shutdown of deals tracker")
+			return
+		case <-time.After(trackerRetryInterval):
+		}
+	}
+}
+
+func (t *tracker) listen() error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	defer cancel()
+	notif, err := t.api.ChainNotify(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-notif:
+			if !ok {
+				log.Warnf("chain notify channel was closed by lotus node, resubscribing")
+				return nil
+			}
+			t.poll(ctx)
+		}
+	}
+}
+
+// poll gets the current state of every tracked proposal, and persists and
+// publishes the ones that changed.
+func (t *tracker) poll(ctx context.Context) {
+	t.lock.Lock()
+	proposals := make([]cid.Cid, 0, len(t.tracked))
+	for pcid := range t.tracked {
+		proposals = append(proposals, pcid)
+	}
+	t.lock.Unlock()
+
+	for _, pcid := range proposals {
+		dinfo, err := t.api.ClientGetDealInfo(ctx, pcid)
+		if err != nil {
+			log.Errorf("getting deal proposal info %s: %s", pcid, err)
+			t.pollFailed(pcid)
+			continue
+		}
+		di := DealInfo{
+			ProposalCid:   dinfo.ProposalCid,
+			StateID:       dinfo.State,
+			StateName:     storagemarket.DealStates[dinfo.State],
+			Miner:         dinfo.Provider.String(),
+			PieceCID:      dinfo.PieceCID,
+			Size:          dinfo.Size,
+			PricePerEpoch: dinfo.PricePerEpoch.Uint64(),
+			Duration:      dinfo.Duration,
+			DealID:        uint64(dinfo.DealID),
+		}
+		if dinfo.State == storagemarket.StorageDealActive {
+			ocd, err := t.api.StateMarketStorageDeal(ctx, dinfo.DealID, types.EmptyTSK)
+			if err != nil {
+				log.Errorf("getting on-chain deal info: %s", err)
+				continue
+			}
+			di.ActivationEpoch = int64(ocd.State.SectorStartEpoch)
+		}
+		t.lock.Lock()
+		changed, err := t.records.addStateChange(di, time.Now())
+		if err != nil {
+			t.lock.Unlock()
+			log.Errorf("saving state change of deal %s: %s", pcid, err)
+			continue
+		}
+		delete(t.errors, pcid)
+		final := isFinalState(di.StateID)
+		if final {
+			delete(t.tracked, pcid)
+		}
+		if changed {
+			t.publish(di)
+		}
+		t.lock.Unlock()
+		if final && t.onFinal != nil {
+			t.onFinal(pcid)
+		}
+	}
+}
+
+// pollFailed counts a failed poll of a tracked proposal. After trackerMaxErrors
+// consecutive ones, the proposal is untracked, and an error state is persisted
+// and published to watchers. If its record is already final, it's untracked
+// right away.
+func (t *tracker) pollFailed(pcid cid.Cid) {
+	r, err := t.records.get(pcid)
+	if err != nil && err != ErrDealRecordNotFound {
+		log.Errorf("getting deal record %s: %s", pcid, err)
+		return
+	}
+	t.lock.Lock()
+	if r.Final {
+		delete(t.tracked, pcid)
+		delete(t.errors, pcid)
+		t.lock.Unlock()
+		return
+	}
+	t.errors[pcid]++
+	if t.errors[pcid] < trackerMaxErrors {
+		t.lock.Unlock()
+		return
+	}
+	log.Warnf("untracking deal proposal %s after %d failed polls", pcid, t.errors[pcid])
+	delete(t.tracked, pcid)
+	delete(t.errors, pcid)
+	di := DealInfo{
+		ProposalCid:   pcid,
+		StateID:       storagemarket.StorageDealError,
+		StateName:     storagemarket.DealStates[storagemarket.StorageDealError],
+		Miner:         r.Miner,
+		PieceCID:      r.PieceCid,
+		Size:          r.Size,
+		PricePerEpoch: r.EpochPrice,
+		Duration:      r.Duration,
+		DealID:        r.DealID,
+	}
+	changed, err := t.records.addStateChange(di, time.Now())
+	if err != nil {
+		t.lock.Unlock()
+		log.Errorf("saving state change of deal %s: %s", pcid, err)
+		return
+	}
+	if changed {
+		t.publish(di)
+	}
+	t.lock.Unlock()
+	if t.onFinal != nil {
+		t.onFinal(pcid)
+	}
+}
+
+// publish queues a state transition to the interested subscribers. It
+// should be called while holding the lock.
+func (t *tracker) publish(di DealInfo) {
+	for s := range t.subs {
+		if _, ok := s.proposals[di.ProposalCid]; !ok {
+			continue
+		}
+		s.lock.Lock()
+		s.queue = append(s.queue, di)
+		s.lock.Unlock()
+		s.signal()
+	}
+}
+
+func (s *subscriber) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *subscriber) drain() []DealInfo {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	q := s.queue
+	s.queue = nil
+	return q
+}
+
+// toDealInfo returns the DealInfo of a recorded state transition.
+func toDealInfo(r DealRecord, h DealStateChange) DealInfo {
+	return DealInfo{
+		ProposalCid:     r.ProposalCid,
+		StateID:         h.StateID,
+		StateName:       h.StateName,
+		Miner:           r.Miner,
+		PieceCID:        r.PieceCid,
+		Size:            r.Size,
+		PricePerEpoch:   r.EpochPrice,
+		Duration:        r.Duration,
+		DealID:          r.DealID,
+		ActivationEpoch: r.ActivationEpoch,
+	}
+}
@@ -0,0 +1,110 @@
+package deals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackerReplay(t *testing.T) {
+	rs := newRecordStore(datastore.NewMapDatastore())
+	now := time.Now()
+	r := DealRecord{
+		ProposalCid: randomCid(t),
+		Miner:       "t01000",
+		Time:        now,
+		History: []DealStateChange{
+			{StateID: storagemarket.StorageDealStaged, StateName: "staged", Time: now},
+			{StateID: storagemarket.StorageDealSealing, StateName: "sealing", Time: now.Add(time.Second)},
+			{StateID: storagemarket.StorageDealActive, StateName: "active", Time: now.Add(2 * time.Second)},
+		},
+		Final: true,
+	}
+	require.NoError(t, rs.put(r))
+	tr, err := newTracker(nil, rs, nil)
+	require.NoError(t, err)
+	defer tr.close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := tr.subscribe(ctx, []cid.Cid{r.ProposalCid}, time.Time{})
+	require.NoError(t, err)
+	for _, h := range r.History {
+		di := <-ch
+		require.Equal(t, r.ProposalCid, di.ProposalCid)
+		require.Equal(t, h.StateID, di.StateID)
+		require.Equal(t, r.Miner, di.Miner)
+	}
+
+	ch2, err := tr.subscribe(ctx, []cid.Cid{r.ProposalCid}, now.Add(time.Second))
+	require.NoError(t, err)
+	di := <-ch2
+	require.Equal(t, uint64(storagemarket.StorageDealActive), di.StateID)
+
+	// Transitions published while the receiver isn't reading are
+	// queued and not dropped.
+	tr.lock.Lock()
+	for i := 0; i < 10; i++ {
+		tr.publish(DealInfo{ProposalCid: r.ProposalCid, StateID: uint64(i)})
+	}
+	tr.lock.Unlock()
+	time.Sleep(time.Millisecond * 100)
+	for i := 0; i < 10; i++ {
+		select {
+		case di := <-ch2:
+			require.Equal(t, uint64(i), di.StateID)
+		case <-time.After(time.Second):
+			t.Fatalf("transition %d wasn't received", i)
+		}
+	}
+}
+
+func TestTrackerPollErrors(t *testing.T) {
+	rs := newRecordStore(datastore.NewMapDatastore())
+	var finals []cid.Cid
+	tr, err := newTracker(nil, rs, func(pcid cid.Cid) { finals = append(finals, pcid) })
+	require.NoError(t, err)
+	defer tr.close()
+	// Polling isn't started, so failed polls are simulated.
+	tr.start.Do(func() {})
+	r := DealRecord{ProposalCid: randomCid(t), Miner: "t01000", Time: time.Now()}
+	require.NoError(t, rs.put(r))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := tr.subscribe(ctx, []cid.Cid{r.ProposalCid}, time.Time{})
+	require.NoError(t, err)
+
+	// The proposal is tracked until polling it fails too many times
+	// in a row, and then watchers get an error state.
+	for i := 0; i < trackerMaxErrors-1; i++ {
+		tr.pollFailed(r.ProposalCid)
+	}
+	require.Contains(t, tr.tracked, r.ProposalCid)
+	require.Empty(t, finals)
+	tr.pollFailed(r.ProposalCid)
+	require.NotContains(t, tr.tracked, r.ProposalCid)
+	require.NotContains(t, tr.errors, r.ProposalCid)
+	require.Equal(t, []cid.Cid{r.ProposalCid}, finals)
+	select {
+	case di := <-ch:
+		require.Equal(t, r.ProposalCid, di.ProposalCid)
+		require.Equal(t, uint64(storagemarket.StorageDealError), di.StateID)
+		require.Equal(t, r.Miner, di.Miner)
+	case <-time.After(time.Second):
+		t.Fatal("error state wasn't received")
+	}
+	r, err = rs.get(r.ProposalCid)
+	require.NoError(t, err)
+	require.True(t, r.Final)
+
+	// Proposals with a final record are untracked on the first failure.
+	tr.tracked[r.ProposalCid] = struct{}{}
+	tr.pollFailed(r.ProposalCid)
+	require.NotContains(t, tr.tracked, r.ProposalCid)
+}
@@ -1,15 +1,27 @@
 package deals
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
 )
 
 // StorageDealConfig contains information about a storage proposal for a miner
 type StorageDealConfig struct {
 	Miner      string
 	EpochPrice uint64
+	// Offline indicates that the data will be transferred manually
+	// to the miner, instead of online by Lotus.
+	Offline bool
+	// ExportPath is the directory where the CAR file of an offline
+	// deal is exported, so it can be shipped to the miner. It's
+	// relative to the export root of the Module, and empty means
+	// the export root.
+	ExportPath string
 }
 
 // StoreResult contains information about in-progress deals.
@@ -17,6 +29,27 @@ type StoreResult struct {
 	ProposalCid cid.Cid
 	Config      StorageDealConfig
 	Success     bool
+	// Message contains the reason of the failure
+	// if the proposal wasn't successful.
+	Message string
+
+	// CarPath is the path of the exported CAR file
+	// to be shipped to the miner, for offline deals.
+	CarPath string
+	// PieceCid is the piece commitment of the data,
+	// for offline deals.
+	PieceCid cid.Cid
+	// PieceSize is the unpadded piece size of the data,
+	// for offline deals.
+	PieceSize uint64
+}
+
+// RetrievalInfo contains information about a successful retrieval.
+type RetrievalInfo struct {
+	// Miner is the provider that served the data.
+	Miner string
+	// Price is the total price paid to the provider.
+	Price uint64
 }
 
 // DealInfo contains information about a proposal storage deal
@@ -36,9 +69,108 @@ type DealInfo struct {
 	ActivationEpoch int64
 }
 
+// DealRecord contains information about a deal proposal made with
+// the Module, including the ones that failed.
+type DealRecord struct {
+	ProposalCid cid.Cid
+	Addr        string
+	Miner       string
+	EpochPrice  uint64
+	Duration    uint64
+	DataCid     cid.Cid
+	PieceCid    cid.Cid
+	Offline     bool
+	// ImportFile is the temporary file with the data of the deal,
+	// which is kept until all deals made with it reach a final state.
+	ImportFile string
+	Time       time.Time
+	// DealID, Size and ActivationEpoch are the last
+	// known values reported for the deal.
+	DealID          uint64
+	Size            uint64
+	ActivationEpoch int64
+	// History contains the state transitions of the deal, sorted
+	// by time.
+	History []DealStateChange
+	// Final is true if the deal reached a final state, which is
+	// the last state in History.
+	Final bool
+	// Error is the reason the proposal was rejected or couldn't
+	// be made. Failed proposals don't have a ProposalCid nor
+	// History, and are always final.
+	Error string
+}
+
+// DealStateChange is a state transition of a deal.
+type DealStateChange struct {
+	StateID   uint64
+	StateName string
+	Time      time.Time
+}
+
+// DealRecordFilter selects deal records. Empty fields
+// represent no-filters applied.
+type DealRecordFilter struct {
+	Addr    string
+	Miner   string
+	DataCid cid.Cid
+	// OnlyPending selects deals that didn't reach a
+	// final state.
+	OnlyPending bool
+}
+
+// Match returns true if the deal record is selected by the filter.
+func (f DealRecordFilter) Match(r DealRecord) bool {
+	if f.Addr != "" && f.Addr != r.Addr {
+		return false
+	}
+	if f.Miner != "" && f.Miner != r.Miner {
+		return false
+	}
+	if f.DataCid.Defined() && !f.DataCid.Equals(r.DataCid) {
+		return false
+	}
+	if f.OnlyPending && r.Final {
+		return false
+	}
+	return true
+}
+
 // Config contains configuration for storing deals.
 type Config struct {
 	ImportPath string
+	// ExportRoot is the directory under which CAR files of offline
+	// deals are exported. If empty, offline deals are disabled.
+	ExportRoot string
+	// Datastore is where deal records are persisted.
+	Datastore datastore.Datastore
+	// MinFreeSpace is the minimum free disk space in bytes that
+	// the import path should keep while accepting new data to store.
+	// Zero disables the check.
+	MinFreeSpace uint64
+	// GCInterval is how often stale temporary files are removed
+	// from the import path. Zero disables the GC.
+	GCInterval time.Duration
+	// GCStaleAge is how old a temporary file should be to be
+	// considered stale by the GC.
+	GCStaleAge time.Duration
+	// RetrievalTimeout is the maximum time a retrieval from
+	// a single provider can take. Zero means no timeout.
+	RetrievalTimeout time.Duration
+	// Reputation is used to rank retrieval providers, and
+	// receives feedback of retrieval outcomes.
+	Reputation Reputation
+}
+
+// Reputation provides scores of miners, and receives feedback of
+// retrievals made with them.
+type Reputation interface {
+	// GetScore returns the score of a miner, and false if
+	// the miner isn't scored.
+	GetScore(addr string) (int, bool)
+	// AddRetrievalResult reports the outcome of a retrieval
+	// with a miner.
+	AddRetrievalResult(addr string, success bool)
 }
 
 // Option sets values on a Config.
@@ -55,3 +187,124 @@ func WithImportPath(path string) Option {
 		return nil
 	}
 }
+
+// WithExportRoot indicates the directory under which CAR files of
+// offline deals are exported. Export paths of offline deals are
+// relative to it.
+func WithExportRoot(path string) Option {
+	return func(c *Config) error {
+		if err := os.MkdirAll(path, 0700); err != nil {
+			return err
+		}
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("getting absolute export root: %s", err)
+		}
+		c.ExportRoot = abs
+		return nil
+	}
+}
+
+// WithDatastore indicates the Datastore where deal records
+// are persisted. By default, they're kept in memory.
+func WithDatastore(ds datastore.Datastore) Option {
+	return func(c *Config) error {
+		c.Datastore = ds
+		return nil
+	}
+}
+
+// WithMinFreeSpace indicates the minimum free disk space in bytes
+// that the import path should keep while accepting new data to store.
+// It defaults to 1GiB, and zero disables the check.
+func WithMinFreeSpace(bytes uint64) Option {
+	return func(c *Config) error {
+		c.MinFreeSpace = bytes
+		return nil
+	}
+}
+
+// WithGCInterval indicates how often stale temporary files from
+// stores and retrievals are removed from the import path. Zero
+// disables the GC.
+func WithGCInterval(interval time.Duration) Option {
+	return func(c *Config) error {
+		if interval < 0 {
+			return fmt.Errorf("gc interval can't be negative, got %s", interval)
+		}
+		c.GCInterval = interval
+		return nil
+	}
+}
+
+// WithGCStaleAge indicates how old a temporary file from stores
+// and retrievals should be to be removed by the GC.
+func WithGCStaleAge(age time.Duration) Option {
+	return func(c *Config) error {
+		if age <= 0 {
+			return fmt.Errorf("gc stale age should be greater than zero, got %s", age)
+		}
+		c.GCStaleAge = age
+		return nil
+	}
+}
+
+// WithRetrievalTimeout indicates the maximum time a retrieval from
+// a single provider can take before trying the next one. Zero means
+// no timeout.
+func WithRetrievalTimeout(timeout time.Duration) Option {
+	return func(c *Config) error {
+		if timeout < 0 {
+			return fmt.Errorf("retrieval timeout can't be negative, got %s", timeout)
+		}
+		c.RetrievalTimeout = timeout
+		return nil
+	}
+}
+
+// WithReputation indicates the Reputation used to rank retrieval
+// providers, which receives feedback of retrieval outcomes.
+func WithReputation(r Reputation) Option {
+	return func(c *Config) error {
+		c.Reputation = r
+		return nil
+	}
+}
+
+// RetrieveConfig contains configuration for a retrieval.
+type RetrieveConfig struct {
+	// MaxPrice is the maximum total price to pay for a retrieval.
+	// Zero means no limit.
+	MaxPrice uint64
+}
+
+// RetrieveOption sets values on a RetrieveConfig.
+type RetrieveOption func(*RetrieveConfig)
+
+// WithMaxPrice indicates the maximum total price to pay for
+// a retrieval. Offers above it are ignored.
+func WithMaxPrice(maxPrice uint64) RetrieveOption {
+	return func(c *RetrieveConfig) {
+		c.MaxPrice = maxPrice
+	}
+}
+
+// WatchConfig contains configuration for watching deals.
+type WatchConfig struct {
+	// ReplaySince indicates that only state transitions that
+	// happened after this time are replayed. The zero value
+	// replays the full history.
+	ReplaySince time.Time
+}
+
+// WatchOption sets values on a WatchConfig.
+type WatchOption func(*WatchConfig)
+
+// WithReplaySince indicates that only state transitions that happened
+// after t are replayed to the watcher, which is useful to resume
+// watching after a reconnection.
+func WithReplaySince(t time.Time) WatchOption {
+	return func(c *WatchConfig) {
+		c.ReplaySince = t
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"strconv"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -113,21 +112,15 @@ var dealCmd = &cobra.Command{
 
 		s = spin.New("%s Initiating selected storage deals...")
 		s.Start()
-		succeeded, failed, err := fcClient.Deals.Store(storeCtx, addr, file, dealConfigs, duration)
+		_, res, err := fcClient.Deals.Store(storeCtx, addr, file, dealConfigs, duration)
 		s.Stop()
 		checkErr(err)
 
+		succeeded, failed := splitStoreResults(res)
 		if len(failed) > 0 {
 			Message("Failed to initialize %v deals:", len(failed))
 			cmd.Println()
-			data := make([][]string, len(failed))
-			for i, dealConfig := range failed {
-				data[i] = []string{
-					dealConfig.Miner,
-					strconv.Itoa(int(dealConfig.EpochPrice)),
-				}
-			}
-			RenderTable(os.Stdout, []string{"miner", "price"}, data)
+			renderFailedStoreResults(failed)
 			cmd.Println()
 			time.Sleep(time.Second * 2)
 		}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/caarlos0/spin"
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	dealsCmd.AddCommand(dealsGetCmd)
+}
+
+var dealsGetCmd = &cobra.Command{
+	Use:   "get [proposal cid]",
+	Short: "Get the record of a deal made with powergate",
+	Long:  `Get the record of a deal made with powergate, including its state history`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		if len(args) != 1 {
+			Fatal(errors.New("you must provide a proposal cid"))
+		}
+
+		pcid, err := cid.Parse(args[0])
+		checkErr(err)
+
+		s := spin.New("%s Retrieving deal record...")
+		s.Start()
+		r, err := fcClient.Deals.GetDealRecord(ctx, pcid)
+		s.Stop()
+		checkErr(err)
+
+		buf, err := json.MarshalIndent(r, "", "  ")
+		checkErr(err)
+		Message("%s", buf)
+	},
+}
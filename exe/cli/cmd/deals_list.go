@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/deals"
+)
+
+func init() {
+	dealsListCmd.Flags().StringP("address", "a", "", "only list deals made with this wallet address")
+	dealsListCmd.Flags().StringP("miner", "m", "", "only list deals with this miner")
+	dealsListCmd.Flags().StringP("cid", "c", "", "only list deals of this data cid")
+	dealsListCmd.Flags().Bool("pending", false, "only list deals that didn't reach a final state")
+
+	dealsCmd.AddCommand(dealsListCmd)
+}
+
+var dealsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List records of deals made with powergate",
+	Long:  `List records of deals made with powergate`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		f := deals.DealRecordFilter{
+			Addr:        viper.GetString("address"),
+			Miner:       viper.GetString("miner"),
+			OnlyPending: viper.GetBool("pending"),
+		}
+		if c := viper.GetString("cid"); c != "" {
+			var err error
+			f.DataCid, err = cid.Decode(c)
+			checkErr(err)
+		}
+
+		s := spin.New("%s Retrieving deal records...")
+		s.Start()
+		rs, err := fcClient.Deals.ListDealRecords(ctx, f)
+		s.Stop()
+		checkErr(err)
+
+		data := make([][]string, len(rs))
+		for i, r := range rs {
+			state := ""
+			if len(r.History) > 0 {
+				state = r.History[len(r.History)-1].StateName
+			}
+			if r.Error != "" {
+				state = "failed"
+			}
+			pcid := ""
+			if r.ProposalCid.Defined() {
+				pcid = r.ProposalCid.String()
+			}
+			data[i] = []string{
+				pcid,
+				r.Miner,
+				r.DataCid.String(),
+				strconv.FormatUint(r.EpochPrice, 10),
+				strconv.FormatUint(r.Duration, 10),
+				r.Time.Format(time.RFC3339),
+				state,
+				strconv.FormatBool(r.Final),
+				r.Error,
+			}
+		}
+		RenderTable(os.Stdout, []string{"proposal cid", "miner", "data cid", "price", "duration", "time", "state", "final", "error"}, data)
+		Message("Found %d deal records", len(rs))
+	},
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/textileio/powergate/deals"
 )
 
 func init() {
 	retrieveCmd.Flags().StringP("address", "a", "", "wallet address to fund retrieval")
 	retrieveCmd.Flags().StringP("cid", "c", "", "cid of the data to fetch")
 	retrieveCmd.Flags().StringP("out", "o", "", "file path to write the data to")
+	retrieveCmd.Flags().Uint64P("maxPrice", "m", 0, "max price to pay for the retrieval, zero means no limit")
 
 	dealsCmd.AddCommand(retrieveCmd)
 }
@@ -54,7 +56,7 @@ var retrieveCmd = &cobra.Command{
 
 		s := spin.New("%s Retrieving specified data...")
 		s.Start()
-		reader, err := fcClient.Deals.Retrieve(ctx, addr, cid)
+		reader, info, err := fcClient.Deals.Retrieve(ctx, addr, cid, deals.WithMaxPrice(viper.GetUint64("maxPrice")))
 		checkErr(err)
 
 		dir := path.Dir(out)
@@ -79,5 +81,6 @@ var retrieveCmd = &cobra.Command{
 			}
 		}
 		s.Stop()
+		Success("Retrieved data from miner %s paying %d attoFIL", info.Miner, info.Price)
 	},
 }
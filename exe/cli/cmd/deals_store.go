@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/caarlos0/spin"
+	"github.com/ipfs/go-cid"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/deals"
@@ -19,6 +20,8 @@ func init() {
 	storeCmd.Flags().IntSliceP("prices", "p", []int{}, "prices of the deals to execute")
 	storeCmd.Flags().StringP("address", "a", "", "wallet address used to store the data")
 	storeCmd.Flags().Uint64P("duration", "d", 0, "duration to store the data for")
+	storeCmd.Flags().Bool("offline", false, "make offline deals, exporting the data as a CAR file to be shipped to the miners")
+	storeCmd.Flags().StringP("exportPath", "e", "", "directory relative to the server export root where the CAR file is exported for offline deals")
 
 	dealsCmd.AddCommand(storeCmd)
 }
@@ -40,6 +43,8 @@ var storeCmd = &cobra.Command{
 		path := viper.GetString("file")
 		miners := viper.GetStringSlice("miners")
 		prices := viper.GetIntSlice("prices")
+		offline := viper.GetBool("offline")
+		exportPath := viper.GetString("exportPath")
 
 		lMiners := len(miners)
 		lPrices := len(prices)
@@ -73,33 +78,66 @@ var storeCmd = &cobra.Command{
 			dealConfigs[i] = deals.StorageDealConfig{
 				Miner:      miner,
 				EpochPrice: uint64(prices[i]),
+				Offline:    offline,
+				ExportPath: exportPath,
 			}
 		}
 
 		s := spin.New("%s Initiating specified storage deals...")
 		s.Start()
-		success, failed, err := fcClient.Deals.Store(ctx, addr, file, dealConfigs, duration)
+		dataCid, res, err := fcClient.Deals.Store(ctx, addr, file, dealConfigs, duration)
 		s.Stop()
 		checkErr(err)
 
+		success, failed := splitStoreResults(res)
 		if len(success) > 0 {
-			Success("Initiation of %v deals succeeded with cids:", len(success))
+			Success("Initiation of %v deals for data cid %s succeeded with cids:", len(success), dataCid)
 			for _, cid := range success {
 				Message(cid.String())
 			}
 		}
+		if offline {
+			var data [][]string
+			for _, r := range res {
+				if r.Success {
+					data = append(data, []string{r.Config.Miner, r.ProposalCid.String(), r.CarPath, r.PieceCid.String(), strconv.FormatUint(r.PieceSize, 10)})
+				}
+			}
+			if len(data) > 0 {
+				cmd.Println()
+				Message("Ship the CAR files to the miners of the offline deals:")
+				RenderTable(os.Stdout, []string{"miner", "proposal cid", "car path", "piece cid", "piece size"}, data)
+			}
+		}
 		if len(failed) > 0 {
 			cmd.Println()
 			Message("Failed to initialize %v deals:", len(failed))
-			data := make([][]string, len(failed))
-			for i, dealConfig := range failed {
-				data[i] = []string{
-					dealConfig.Miner,
-					strconv.Itoa(int(dealConfig.EpochPrice)),
-				}
-			}
-			RenderTable(os.Stdout, []string{"miner", "price"}, data)
+			renderFailedStoreResults(failed)
 		}
-
 	},
 }
+
+func splitStoreResults(res []deals.StoreResult) ([]cid.Cid, []deals.StoreResult) {
+	var success []cid.Cid
+	var failed []deals.StoreResult
+	for _, r := range res {
+		if r.Success {
+			success = append(success, r.ProposalCid)
+		} else {
+			failed = append(failed, r)
+		}
+	}
+	return success, failed
+}
+
+func renderFailedStoreResults(failed []deals.StoreResult) {
+	data := make([][]string, len(failed))
+	for i, r := range failed {
+		data[i] = []string{
+			r.Config.Miner,
+			strconv.Itoa(int(r.Config.EpochPrice)),
+			r.Message,
+		}
+	}
+	RenderTable(os.Stdout, []string{"miner", "price", "reason"}, data)
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	ffsCmd.AddCommand(ffsAddrsCmd)
+}
+
+var ffsAddrsCmd = &cobra.Command{
+	Use:   "addrs",
+	Short: "Provides commands to manage FFS instance wallet addresses",
+	Long:  `Provides commands to manage FFS instance wallet addresses`,
+}
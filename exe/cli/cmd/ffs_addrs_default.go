@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	ffsAddrsDefaultCmd.Flags().StringP("token", "t", "", "FFS auth token")
+
+	ffsAddrsCmd.AddCommand(ffsAddrsDefaultCmd)
+}
+
+var ffsAddrsDefaultCmd = &cobra.Command{
+	Use:   "default [address]",
+	Short: "Sets the FFS instance default wallet address",
+	Long:  `Sets the FFS instance default wallet address, which pays for Cids that don't specify one`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		if len(args) != 1 {
+			Fatal(errors.New("you must provide an address"))
+		}
+
+		s := spin.New("%s Setting FFS instance default wallet address...")
+		s.Start()
+		err := fcClient.Ffs.SetDefaultAddr(authCtx(ctx), args[0])
+		s.Stop()
+		checkErr(err)
+		Success("Default wallet address set to %s", args[0])
+	},
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	ffsAddrsListCmd.Flags().StringP("token", "t", "", "FFS auth token")
+
+	ffsAddrsCmd.AddCommand(ffsAddrsListCmd)
+}
+
+var ffsAddrsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the FFS instance wallet addresses",
+	Long:  `List the FFS instance wallet addresses`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		s := spin.New("%s Getting FFS instance wallet addresses...")
+		s.Start()
+		addrs, defaultAddr, err := fcClient.Ffs.Addrs(authCtx(ctx))
+		s.Stop()
+		checkErr(err)
+
+		data := make([][]string, len(addrs))
+		for i, a := range addrs {
+			isDefault := ""
+			if a.Addr == defaultAddr {
+				isDefault = "yes"
+			}
+			data[i] = []string{a.Name, a.Addr, a.Type, isDefault}
+		}
+		RenderTable(os.Stdout, []string{"name", "address", "type", "default"}, data)
+	},
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/api/client"
+)
+
+func init() {
+	ffsAddrsNewCmd.Flags().StringP("token", "t", "", "FFS auth token")
+	ffsAddrsNewCmd.Flags().StringP("type", "f", "bls", "the wallet address type, bls or secp256k1")
+	ffsAddrsNewCmd.Flags().Bool("default", false, "make the new address the instance default address")
+
+	ffsAddrsCmd.AddCommand(ffsAddrsNewCmd)
+}
+
+var ffsAddrsNewCmd = &cobra.Command{
+	Use:   "new [name]",
+	Short: "Creates a new FFS instance wallet address labeled with name",
+	Long:  `Creates a new FFS instance wallet address labeled with name`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		if len(args) != 1 {
+			Fatal(errors.New("you must provide a name for the address"))
+		}
+
+		opts := []client.NewAddressOption{
+			client.WithAddressType(viper.GetString("type")),
+			client.WithMakeDefault(viper.GetBool("default")),
+		}
+
+		s := spin.New("%s Creating FFS instance wallet address...")
+		s.Start()
+		addr, err := fcClient.Ffs.NewAddr(authCtx(ctx), args[0], opts...)
+		s.Stop()
+		checkErr(err)
+		Success("Created new wallet address: %s", addr)
+	},
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	ffsCmd.AddCommand(ffsAdminCmd)
+}
+
+var ffsAdminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Provides admin commands to manage FFS instances",
+	Long:  `Provides admin commands to manage FFS instances`,
+}
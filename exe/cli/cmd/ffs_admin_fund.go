@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ff "github.com/textileio/powergate/ffs"
+)
+
+func init() {
+	ffsAdminFundCmd.Flags().StringP("admintoken", "a", "", "FFS admin token")
+	ffsAdminFundCmd.Flags().String("addr", "", "instance address to fund, the instance default address if empty")
+
+	ffsAdminCmd.AddCommand(ffsAdminFundCmd)
+}
+
+var ffsAdminFundCmd = &cobra.Command{
+	Use:   "fund [instance-id] [amount]",
+	Short: "Sends attoFIL from the master address to an FFS instance address",
+	Long:  `Sends attoFIL from the master address to an FFS instance address`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+
+		if len(args) != 2 {
+			Fatal(errors.New("you must provide an instance id and an amount"))
+		}
+		amount, ok := new(big.Int).SetString(args[1], 10)
+		if !ok {
+			Fatal(fmt.Errorf("invalid amount %s", args[1]))
+		}
+
+		s := spin.New(fmt.Sprintf("%s Funding instance %s with %s attoFIL...", "%s", args[0], amount))
+		s.Start()
+		mcid, err := fcClient.Ffs.Fund(adminCtx(ctx), ff.APIID(args[0]), viper.GetString("addr"), amount)
+		s.Stop()
+		checkErr(err)
+		Message("Message cid: %s", mcid)
+		Success("Funded instance %s with %s attoFIL", args[0], amount)
+	},
+}
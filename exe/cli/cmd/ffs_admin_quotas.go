@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ff "github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/rpc"
+)
+
+func init() {
+	ffsAdminQuotasCmd.Flags().StringP("admintoken", "a", "", "FFS admin token")
+	ffsAdminQuotasCmd.Flags().Int64("maxhotbytes", 0, "maximum bytes stored in hot storage, zero for no limit")
+	ffsAdminQuotasCmd.Flags().Int64("maxcoldbytes", 0, "maximum bytes stored in cold storage, zero for no limit")
+	ffsAdminQuotasCmd.Flags().Int64("maxcids", 0, "maximum number of Cids with a pushed config, zero for no limit")
+	ffsAdminQuotasCmd.Flags().Uint64("maxspend", 0, "maximum attoFIL committed to deals in the spend period, zero for no limit")
+	ffsAdminQuotasCmd.Flags().Duration("spendperiod", 0, "period considered for maxspend, zero for all time")
+
+	ffsAdminCmd.AddCommand(ffsAdminQuotasCmd)
+}
+
+var ffsAdminQuotasCmd = &cobra.Command{
+	Use:   "quotas [instance-id]",
+	Short: "Sets the quotas of an FFS instance",
+	Long:  `Sets the quotas of an FFS instance`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		if len(args) != 1 {
+			Fatal(errors.New("you must provide an instance id"))
+		}
+
+		q := &rpc.Quotas{
+			MaxHotBytes:  viper.GetInt64("maxhotbytes"),
+			MaxColdBytes: viper.GetInt64("maxcoldbytes"),
+			MaxCids:      viper.GetInt64("maxcids"),
+			MaxSpend:     viper.GetUint64("maxspend"),
+			SpendPeriod:  int64(viper.GetDuration("spendperiod") / time.Second),
+		}
+
+		s := spin.New("%s Setting instance quotas...")
+		s.Start()
+		err := fcClient.Ffs.SetQuotas(adminCtx(ctx), ff.APIID(args[0]), q)
+		s.Stop()
+		checkErr(err)
+		Success("Quotas of instance %s updated", args[0])
+	},
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ff "github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/rpc"
+)
+
+func init() {
+	ffsAdminTopUpCmd.Flags().StringP("admintoken", "a", "", "FFS admin token")
+	ffsAdminTopUpCmd.Flags().Uint64("threshold", 0, "balance in attoFIL below which instance addresses are topped-up")
+	ffsAdminTopUpCmd.Flags().Uint64("amount", 0, "attoFIL sent in each top-up, zero disables top-ups")
+	ffsAdminTopUpCmd.Flags().Uint64("dailycap", 0, "maximum attoFIL sent to the instance in 24 hours, zero for no limit")
+
+	ffsAdminCmd.AddCommand(ffsAdminTopUpCmd)
+}
+
+var ffsAdminTopUpCmd = &cobra.Command{
+	Use:   "topup [instance-id]",
+	Short: "Sets the automatic top-up policy of an FFS instance",
+	Long:  `Sets the automatic top-up policy of an FFS instance`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		if len(args) != 1 {
+			Fatal(errors.New("you must provide an instance id"))
+		}
+
+		p := &rpc.TopUpPolicy{
+			Threshold: viper.GetUint64("threshold"),
+			Amount:    viper.GetUint64("amount"),
+			DailyCap:  viper.GetUint64("dailycap"),
+		}
+
+		s := spin.New("%s Setting instance top-up policy...")
+		s.Start()
+		err := fcClient.Ffs.SetTopUpPolicy(adminCtx(ctx), ff.APIID(args[0]), p)
+		s.Stop()
+		checkErr(err)
+		Success("Top-up policy of instance %s updated", args[0])
+	},
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/ffs"
+)
+
+func init() {
+	ffsCancelCmd.Flags().StringP("token", "t", "", "FFS auth token")
+
+	ffsCmd.AddCommand(ffsCancelCmd)
+}
+
+var ffsCancelCmd = &cobra.Command{
+	Use:   "cancel [jobid]",
+	Short: "Cancel a queued or in-progress job",
+	Long:  `Cancel a queued or in-progress job and wait for its final state`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		if len(args) != 1 {
+			Fatal(errors.New("you must provide a job id"))
+		}
+		jid := ffs.JobID(args[0])
+
+		ch, cancelWatch, err := fcClient.Ffs.WatchJobs(authCtx(ctx), jid)
+		checkErr(err)
+		defer cancelWatch()
+
+		s := spin.New("%s Canceling job...")
+		s.Start()
+		err = fcClient.Ffs.CancelJob(authCtx(ctx), jid)
+		if err != nil {
+			s.Stop()
+			Fatal(err)
+		}
+
+		var final ffs.Job
+		for event := range ch {
+			if event.Err != nil {
+				s.Stop()
+				Fatal(event.Err)
+			}
+			if event.Job.Status != ffs.Queued && event.Job.Status != ffs.InProgress {
+				final = event.Job
+				break
+			}
+		}
+		s.Stop()
+		if final.ID == ffs.EmptyJobID {
+			Fatal(errors.New("job final state couldn't be watched"))
+		}
+
+		if final.Status == ffs.Failed {
+			Message("Job %s final state: %s %s", final.ID, displayName(final.Status), final.ErrCause)
+			return
+		}
+		Message("Job %s final state: %s", final.ID, displayName(final.Status))
+	},
+}
@@ -15,6 +15,7 @@ import (
 
 func init() {
 	ffsGetCmd.Flags().StringP("token", "t", "", "token of the request")
+	ffsGetCmd.Flags().Bool("cold", false, "retrieve the data from the cold storage as a CAR file, without using the hot storage")
 
 	ffsCmd.AddCommand(ffsGetCmd)
 }
@@ -40,7 +41,12 @@ var ffsGetCmd = &cobra.Command{
 
 		s := spin.New("%s Retrieving specified data...")
 		s.Start()
-		reader, err := fcClient.Ffs.Get(authCtx(ctx), c)
+		var reader io.Reader
+		if viper.GetBool("cold") {
+			reader, err = fcClient.Ffs.GetCold(authCtx(ctx), c)
+		} else {
+			reader, err = fcClient.Ffs.Get(authCtx(ctx), c)
+		}
 		checkErr(err)
 
 		dir := path.Dir(args[1])
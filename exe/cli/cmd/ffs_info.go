@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/caarlos0/spin"
 	"github.com/logrusorgru/aurora"
@@ -35,6 +37,23 @@ var ffsInfoCmd = &cobra.Command{
 		s.Stop()
 		Message("Information from instance ID %s:", aurora.White(resp.Info.ID).Bold())
 		Message("Address %s has balance %d", aurora.White(resp.Info.Wallet.Address), aurora.Green(resp.Info.Wallet.Balance))
+		Message("Jobs queued for execution: %d", aurora.White(resp.Info.QueuedJobs))
+		Message("Total spent in active deals: %d attoFIL", aurora.White(resp.Info.TotalSpend))
+		Message("Hot storage usage: %d bytes in %d blocks", aurora.White(resp.Info.HotStorage.Size), aurora.White(resp.Info.HotStorage.NumBlocks))
+
+		Message("Quotas:")
+		q, u := resp.Info.Quotas, resp.Info.Usage
+		spendPeriod := "all time"
+		if q.SpendPeriod > 0 {
+			spendPeriod = (time.Duration(q.SpendPeriod) * time.Second).String()
+		}
+		quotas := [][]string{
+			{"hot bytes", strconv.FormatInt(u.HotBytes, 10), formatQuota(uint64(q.MaxHotBytes))},
+			{"cold bytes", strconv.FormatInt(u.ColdBytes, 10), formatQuota(uint64(q.MaxColdBytes))},
+			{"cids", strconv.FormatInt(u.Cids, 10), formatQuota(uint64(q.MaxCids))},
+			{"spend (" + spendPeriod + ")", strconv.FormatUint(u.Spent, 10), formatQuota(q.MaxSpend)},
+		}
+		RenderTable(os.Stdout, []string{"quota", "usage", "limit"}, quotas)
 
 		Message("Pinned cids:")
 		data := make([][]string, len(resp.Info.Pins))
@@ -44,3 +63,10 @@ var ffsInfoCmd = &cobra.Command{
 		RenderTable(os.Stdout, []string{"cid"}, data)
 	},
 }
+
+func formatQuota(limit uint64) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatUint(limit, 10)
+}
@@ -7,20 +7,25 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/caarlos0/spin"
 	"github.com/ipfs/go-cid"
+	"github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/api/client"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/rpc"
 )
 
 func init() {
 	ffsPushCmd.Flags().StringP("token", "t", "", "FFS access token")
 	ffsPushCmd.Flags().StringP("config", "c", "", "Optional path to a file containing cid storage config json, falls back to stdin, uses FFS default by default")
 	ffsPushCmd.Flags().BoolP("override", "o", false, "Path to a file containing cid storage config json")
+	ffsPushCmd.Flags().IntP("priority", "p", 0, "Priority of the job relative to other queued jobs of the instance")
+	ffsPushCmd.Flags().Bool("dry-run", false, "Show what pushing the cid storage config would do, without executing it")
 
 	ffsCmd.AddCommand(ffsPushCmd)
 }
@@ -81,6 +86,20 @@ var ffsPushCmd = &cobra.Command{
 			options = append(options, client.WithOverride(viper.GetBool("override")))
 		}
 
+		if viper.IsSet("priority") {
+			options = append(options, client.WithPriority(viper.GetInt("priority")))
+		}
+
+		if viper.GetBool("dry-run") {
+			s := spin.New("%s Planning cid storage config in FFS...")
+			s.Start()
+			resp, err := fcClient.Ffs.PlanConfig(authCtx(ctx), c, options...)
+			s.Stop()
+			checkErr(err)
+			renderStoragePlan(resp.Plan)
+			return
+		}
+
 		s := spin.New("%s Adding cid storage config to FFS...")
 		s.Start()
 		jid, err := fcClient.Ffs.PushConfig(authCtx(ctx), c, options...)
@@ -89,3 +108,32 @@ var ffsPushCmd = &cobra.Command{
 		Success("Pushed cid config for %s to FFS with job id: %v", c.String(), jid.String())
 	},
 }
+
+func renderStoragePlan(plan *rpc.StoragePlan) {
+	Message("Storage plan for cid %s:", aurora.White(plan.Cid).Bold())
+	switch plan.Hot.Action {
+	case rpc.HotAction_HotActionPin:
+		if plan.Hot.CanUnfreeze {
+			Message("Hot storage: the cid will be fetched and pinned, unfreezing it from Filecoin if fetching fails")
+		} else {
+			Message("Hot storage: the cid will be fetched and pinned")
+		}
+	case rpc.HotAction_HotActionRemove:
+		Message("Hot storage: the cid will be removed")
+	case rpc.HotAction_HotActionUnref:
+		Message("Hot storage: the cid will be kept, since other instances still need it")
+	default:
+		Message("Hot storage: no changes")
+	}
+	if plan.Cold.NewDeals == 0 {
+		Message("Cold storage: no new deals")
+		return
+	}
+	Message("Cold storage: %d new deals with a duration of %d epochs", plan.Cold.NewDeals, plan.Cold.DealDuration)
+	data := make([][]string, len(plan.Cold.Miners))
+	for i, m := range plan.Cold.Miners {
+		data[i] = []string{m.Addr, strconv.FormatUint(m.EpochPrice, 10)}
+	}
+	RenderTable(os.Stdout, []string{"miner", "epoch price"}, data)
+	Message("Estimated total cost: %d", aurora.Green(plan.Cold.EstimatedCost))
+}
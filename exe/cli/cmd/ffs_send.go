@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	ffsSendCmd.Flags().StringP("token", "t", "", "FFS auth token")
+	ffsSendCmd.Flags().BoolP("wait", "w", false, "wait for the message to land on chain")
+
+	ffsCmd.AddCommand(ffsSendCmd)
+}
+
+var ffsSendCmd = &cobra.Command{
+	Use:   "send [from] [to] [amount]",
+	Short: "Send attoFIL from an FFS instance wallet address to another address",
+	Long:  `Send attoFIL from an FFS instance wallet address to another address`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+
+		if len(args) != 3 {
+			Fatal(errors.New("send command needs from and to addresses, and an amount"))
+		}
+		amount, ok := new(big.Int).SetString(args[2], 10)
+		if !ok {
+			Fatal(fmt.Errorf("invalid amount %s", args[2]))
+		}
+
+		s := spin.New(fmt.Sprintf("%s Sending %s attoFIL from %s to %s...", "%s", amount, args[0], args[1]))
+		s.Start()
+		mcid, err := fcClient.Ffs.SendFil(authCtx(ctx), args[0], args[1], amount)
+		s.Stop()
+		checkErr(err)
+		Message("Message cid: %s", mcid)
+
+		if viper.GetBool("wait") {
+			s := spin.New(fmt.Sprintf("%s Waiting for message %s to land on chain...", "%s", mcid))
+			s.Start()
+			err := fcClient.Ffs.WaitMessage(authCtx(ctx), mcid)
+			s.Stop()
+			checkErr(err)
+		}
+		Success("Sent %s attoFIL from %s to %s", amount, args[0], args[1])
+	},
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/ipfs/go-cid"
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/ffs"
+)
+
+func init() {
+	ffsSpendCmd.Flags().StringP("token", "t", "", "FFS auth token")
+	ffsSpendCmd.Flags().StringP("cid", "c", "", "only consider deals of this cid")
+	ffsSpendCmd.Flags().StringP("miner", "m", "", "only consider deals with this miner")
+	ffsSpendCmd.Flags().String("from", "", "only consider deals made at or after this RFC3339 time")
+	ffsSpendCmd.Flags().String("to", "", "only consider deals made before this RFC3339 time")
+
+	ffsCmd.AddCommand(ffsSpendCmd)
+}
+
+var ffsSpendCmd = &cobra.Command{
+	Use:   "spend",
+	Short: "Show the FIL spent in active deals",
+	Long:  `Show the FIL spent in active deals, broken down by cid, miner and day`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		checkErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+		defer cancel()
+
+		var filter ffs.SpendFilter
+		if c := viper.GetString("cid"); c != "" {
+			var err error
+			filter.Cid, err = cid.Decode(c)
+			checkErr(err)
+		}
+		filter.Miner = viper.GetString("miner")
+		if from := viper.GetString("from"); from != "" {
+			var err error
+			filter.From, err = time.Parse(time.RFC3339, from)
+			checkErr(err)
+		}
+		if to := viper.GetString("to"); to != "" {
+			var err error
+			filter.To, err = time.Parse(time.RFC3339, to)
+			checkErr(err)
+		}
+
+		s := spin.New("%s Retrieving spend report...")
+		s.Start()
+		resp, err := fcClient.Ffs.Spend(authCtx(ctx), filter)
+		s.Stop()
+		checkErr(err)
+
+		Message("Total spent: %d attoFIL", aurora.Green(resp.Total))
+		renderSpendTotals("cid", resp.ByCid)
+		renderSpendTotals("miner", resp.ByMiner)
+		renderSpendTotals("day", resp.ByDay)
+	},
+}
+
+func renderSpendTotals(key string, totals map[string]uint64) {
+	keys := make([]string, 0, len(totals))
+	for k := range totals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	data := make([][]string, len(keys))
+	for i, k := range keys {
+		data[i] = []string{k, strconv.FormatUint(totals[k], 10)}
+	}
+	RenderTable(os.Stdout, []string{key, "attoFIL"}, data)
+}
@@ -96,6 +96,8 @@ func updateJobsOutput(writer io.Writer, state map[string]*client.JobEvent) {
 		if state[k] != nil {
 			if state[k].Job.Status == ffs.Failed {
 				val = fmt.Sprintf("%v %v", displayName(state[k].Job.Status), state[k].Job.ErrCause)
+			} else if state[k].Job.Status == ffs.Queued && !state[k].Job.NextRetry.IsZero() {
+				val = fmt.Sprintf("%v for retry at %v, attempt %d failed: %v", displayName(state[k].Job.Status), state[k].Job.NextRetry.Format("01/02/06 15:04:05 MST"), state[k].Job.Attempts, state[k].Job.ErrCause)
 			} else if state[k].Err != nil {
 				val = fmt.Sprintf("Error: %v", state[k].Err.Error())
 			} else {
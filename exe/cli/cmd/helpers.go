@@ -73,6 +73,14 @@ func authCtx(ctx context.Context) context.Context {
 	return context.WithValue(ctx, authKey("ffstoken"), token)
 }
 
+func adminCtx(ctx context.Context) context.Context {
+	token := viper.GetString("admintoken")
+	if token == "" {
+		Fatal(errors.New("must provide -a admin token"))
+	}
+	return context.WithValue(ctx, authKey("ffsadmintoken"), token)
+}
+
 type authKey string
 
 type tokenAuth struct {
@@ -85,6 +93,10 @@ func (t tokenAuth) GetRequestMetadata(ctx context.Context, _ ...string) (map[str
 	if ok && token != "" {
 		md["X-ffs-Token"] = token
 	}
+	adminToken, ok := ctx.Value(authKey("ffsadmintoken")).(string)
+	if ok && adminToken != "" {
+		md["X-ffs-Admin-Token"] = adminToken
+	}
 	return md, nil
 }
 
@@ -43,6 +43,14 @@ func main() {
 	pflag.String("ipfsapiaddr", "/ip4/127.0.0.1/tcp/5001", "ipfs api multiaddr")
 	pflag.Int64("walletinitialfund", 4000000000, "created wallets initial fund in attoFIL")
 	pflag.String("gatewayhostaddr", "0.0.0.0:7000", "gateway host listening address")
+	pflag.Int("schedmaxparallel", 10, "max number of ffs jobs executed concurrently by the scheduler")
+	pflag.Duration("schedjobretention", 0, "time finished ffs jobs are kept before being pruned, zero keeps them forever")
+	pflag.Int("coldmaxreplacements", 3, "max number of replacement miners asked for when proposed miners reject or fail a deal")
+	pflag.Uint64("dealsminfreespace", 1<<30, "min free disk space in bytes the deals import path should keep while accepting new data, zero disables the check")
+	pflag.String("dealsexportroot", "", "directory under which CAR files of offline deals are exported, defaults to the exports directory of the repo path")
+	pflag.Duration("dealsgcinterval", time.Hour, "interval to remove stale temporary files from the deals import path, zero disables it")
+	pflag.Duration("dealsretrievaltimeout", 0, "max time a retrieval from a single provider can take, zero means no timeout")
+	pflag.String("ffsadmintoken", "", "token required by ffs admin endpoints, empty disables them")
 	pflag.Parse()
 
 	config.SetEnvPrefix("TEXPOWERGATE")
@@ -93,11 +101,19 @@ func main() {
 		LotusMasterAddr:    config.GetString("lotusmasteraddr"),
 		Embedded:           embedded,
 		// ToDo: Support secure gRPC connection
-		GrpcHostNetwork:     "tcp",
-		GrpcHostAddress:     config.GetString("grpchostaddr"),
-		GrpcWebProxyAddress: config.GetString("grpcwebproxyaddr"),
-		RepoPath:            repoPath,
-		GatewayHostAddr:     config.GetString("gatewayhostaddr"),
+		GrpcHostNetwork:       "tcp",
+		GrpcHostAddress:       config.GetString("grpchostaddr"),
+		GrpcWebProxyAddress:   config.GetString("grpcwebproxyaddr"),
+		RepoPath:              repoPath,
+		GatewayHostAddr:       config.GetString("gatewayhostaddr"),
+		SchedMaxParallel:      config.GetInt("schedmaxparallel"),
+		SchedJobRetention:     config.GetDuration("schedjobretention"),
+		ColdMaxReplacements:   config.GetInt("coldmaxreplacements"),
+		DealsMinFreeSpace:     config.GetUint64("dealsminfreespace"),
+		DealsExportRoot:       config.GetString("dealsexportroot"),
+		DealsGCInterval:       config.GetDuration("dealsgcinterval"),
+		DealsRetrievalTimeout: config.GetDuration("dealsretrievaltimeout"),
+		FFSAdminToken:         config.GetString("ffsadmintoken"),
 	}
 	confJSON, err := json.MarshalIndent(conf, "", "  ")
 	if err != nil {
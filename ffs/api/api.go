@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	logging "github.com/ipfs/go-log/v2"
@@ -15,6 +17,7 @@ import (
 
 var (
 	defaultWalletType = "bls"
+	initialAddrName   = "Initial Address"
 
 	log = logging.Logger("ffs-api")
 )
@@ -58,6 +61,7 @@ func New(ctx context.Context, iid ffs.APIID, is InstanceStore, sch ffs.Scheduler
 	config := Config{
 		ID:               iid,
 		WalletAddr:       addr,
+		Addrs:            []AddrInfo{{Name: initialAddrName, Addr: addr, Type: defaultWalletType}},
 		DefaultCidConfig: dc,
 	}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -74,6 +78,11 @@ func Load(iid ffs.APIID, is InstanceStore, sched ffs.Scheduler, wm ffs.WalletMan
 	if err != nil {
 		return nil, fmt.Errorf("loading instance: %s", err)
 	}
+	// Instances created before supporting multiple addresses
+	// only have the default address.
+	if len(c.Addrs) == 0 && c.WalletAddr != "" {
+		c.Addrs = []AddrInfo{{Name: initialAddrName, Addr: c.WalletAddr, Type: defaultWalletType}}
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	return new(ctx, iid, is, wm, c, sched, cancel), nil
 }
@@ -96,11 +105,139 @@ func (i *API) ID() ffs.APIID {
 	return i.cfg.ID
 }
 
-// WalletAddr returns the Lotus wallet address.
+// WalletAddr returns the default Lotus wallet address.
 func (i *API) WalletAddr() string {
+	i.lock.Lock()
+	defer i.lock.Unlock()
 	return i.cfg.WalletAddr
 }
 
+// NewAddr creates a new wallet address owned by the instance, labeled
+// with name. Names are unique within the instance.
+func (i *API) NewAddr(ctx context.Context, name string, opts ...NewAddressOption) (string, error) {
+	conf := &NewAddressConfig{addrType: defaultWalletType}
+	for _, o := range opts {
+		o(conf)
+	}
+	if name == "" {
+		return "", fmt.Errorf("address name can't be empty")
+	}
+	i.lock.Lock()
+	exists := i.hasAddrName(name)
+	i.lock.Unlock()
+	if exists {
+		return "", fmt.Errorf("address with name %s already exists", name)
+	}
+	// Creating the address may take a while since it's funded, so it's
+	// done without holding the lock.
+	addr, err := i.wm.NewAddress(ctx, conf.addrType)
+	if err != nil {
+		return "", fmt.Errorf("creating new wallet addr: %s", err)
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.hasAddrName(name) {
+		return "", fmt.Errorf("address with name %s already exists", name)
+	}
+	cfg := i.cfg
+	cfg.Addrs = make([]AddrInfo, len(i.cfg.Addrs), len(i.cfg.Addrs)+1)
+	copy(cfg.Addrs, i.cfg.Addrs)
+	cfg.Addrs = append(cfg.Addrs, AddrInfo{Name: name, Addr: addr, Type: conf.addrType})
+	if conf.makeDefault {
+		cfg.WalletAddr = addr
+	}
+	if err := i.is.PutConfig(cfg); err != nil {
+		return "", fmt.Errorf("saving new address: %s", err)
+	}
+	i.cfg = cfg
+	return addr, nil
+}
+
+// Addrs returns the wallet addresses owned by the instance.
+func (i *API) Addrs() []AddrInfo {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	res := make([]AddrInfo, len(i.cfg.Addrs))
+	copy(res, i.cfg.Addrs)
+	return res
+}
+
+// SetDefaultAddr sets the default wallet address of the instance, which pays
+// for Cids that don't specify an address in their configuration.
+func (i *API) SetDefaultAddr(addr string) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if !i.isManagedAddr(addr) {
+		return fmt.Errorf("%s isn't a managed address", addr)
+	}
+	cfg := i.cfg
+	cfg.WalletAddr = addr
+	if err := i.is.PutConfig(cfg); err != nil {
+		return fmt.Errorf("saving default address: %s", err)
+	}
+	i.cfg = cfg
+	return nil
+}
+
+// SendFil sends amount attoFIL from an address of the instance to another
+// address. It returns the Cid of the message, which can be tracked with
+// WaitMessage until it lands on chain.
+func (i *API) SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error) {
+	i.lock.Lock()
+	managed := i.isManagedAddr(from)
+	i.lock.Unlock()
+	if !managed {
+		return cid.Undef, fmt.Errorf("%s isn't a managed address", from)
+	}
+	mcid, err := i.wm.SendFil(ctx, from, to, amount)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("sending fil: %s", err)
+	}
+	return mcid, nil
+}
+
+// WaitMessage waits for a message to land on chain, e.g: one created
+// with SendFil. It returns an error if the message execution failed.
+func (i *API) WaitMessage(ctx context.Context, mcid cid.Cid) error {
+	return i.wm.WaitMessage(ctx, mcid)
+}
+
+// hasAddrName returns true if the instance already has an address
+// with the provided name. It should be called while holding the lock.
+func (i *API) hasAddrName(name string) bool {
+	for _, a := range i.cfg.Addrs {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isManagedAddr returns true if addr is owned by the instance. It should
+// be called while holding the lock.
+func (i *API) isManagedAddr(addr string) bool {
+	for _, a := range i.cfg.Addrs {
+		if a.Addr == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAddr returns the wallet address that pays for a Cid configuration.
+// It should be called while holding the lock.
+func (i *API) resolveAddr(cfg ffs.CidConfig) (string, error) {
+	addr := cfg.Cold.Filecoin.Addr
+	if addr == "" {
+		return i.cfg.WalletAddr, nil
+	}
+	if !i.isManagedAddr(addr) {
+		return "", fmt.Errorf("%s isn't a managed address", addr)
+	}
+	return addr, nil
+}
+
 // GetDefaultCidConfig returns the default instance Cid config, prepared for a particular Cid.
 func (i *API) GetDefaultCidConfig(c cid.Cid) ffs.CidConfig {
 	i.lock.Lock()
@@ -127,6 +264,9 @@ func (i *API) SetDefaultCidConfig(c ffs.DefaultCidConfig) error {
 	if err := c.Validate(); err != nil {
 		return fmt.Errorf("default cid config is invalid: %s", err)
 	}
+	if c.Cold.Filecoin.Addr != "" && !i.isManagedAddr(c.Cold.Filecoin.Addr) {
+		return fmt.Errorf("%s isn't a managed address", c.Cold.Filecoin.Addr)
+	}
 	i.cfg.DefaultCidConfig = c
 	return nil
 }
@@ -150,21 +290,89 @@ func (i *API) Info(ctx context.Context) (InstanceInfo, error) {
 	if err != nil {
 		return InstanceInfo{}, fmt.Errorf("getting pins from instance: %s", err)
 	}
-	balance, err := i.wm.Balance(ctx, i.cfg.WalletAddr)
+	waddr := i.WalletAddr()
+	balance, err := i.wm.Balance(ctx, waddr)
+	if err != nil {
+		return InstanceInfo{}, fmt.Errorf("getting balance of %s: %s", waddr, err)
+	}
+	queued, err := i.sched.ListJobs(ffs.JobFilter{APIID: i.iid, Statuses: []ffs.JobStatus{ffs.Queued}})
+	if err != nil {
+		return InstanceInfo{}, fmt.Errorf("getting queued jobs: %s", err)
+	}
+	hot, usage, err := i.storageUsage(pins)
+	if err != nil {
+		return InstanceInfo{}, fmt.Errorf("getting storage usage: %s", err)
+	}
+	spend, err := i.sched.GetSpendTotals(i.iid)
 	if err != nil {
-		return InstanceInfo{}, fmt.Errorf("getting balance of %s: %s", i.cfg.WalletAddr, err)
+		return InstanceInfo{}, fmt.Errorf("getting spend totals: %s", err)
+	}
+	i.lock.Lock()
+	quotas := i.cfg.Quotas
+	i.lock.Unlock()
+	usage.Spent, err = i.spent(quotas.SpendPeriod)
+	if err != nil {
+		return InstanceInfo{}, fmt.Errorf("getting spent in spend period: %s", err)
 	}
 	return InstanceInfo{
 		ID:               i.cfg.ID,
 		DefaultCidConfig: i.cfg.DefaultCidConfig,
 		Wallet: WalletInfo{
-			Address: i.cfg.WalletAddr,
+			Address: waddr,
 			Balance: balance,
 		},
-		Pins: pins,
+		Pins:       pins,
+		QueuedJobs: len(queued),
+		HotStorage: hot,
+		Quotas:     quotas,
+		Usage:      usage,
+		TotalSpend: spend.Total,
 	}, nil
 }
 
+// SetQuotas sets the quotas of the instance. It's meant to be called
+// by the Manager on behalf of an administrator.
+func (i *API) SetQuotas(q Quotas) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if q.MaxHotBytes < 0 || q.MaxColdBytes < 0 || q.MaxCids < 0 || q.SpendPeriod < 0 {
+		return fmt.Errorf("quotas can't be negative")
+	}
+	cfg := i.cfg
+	cfg.Quotas = q
+	if err := i.is.PutConfig(cfg); err != nil {
+		return fmt.Errorf("saving quotas: %s", err)
+	}
+	i.cfg = cfg
+	return nil
+}
+
+// CanAddToHot returns a *QuotaExceededError if the instance already reached
+// its Hot Storage or Cids quota, so no more data should be added to the Hot Storage.
+func (i *API) CanAddToHot() error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	q := i.cfg.Quotas
+	if q.MaxHotBytes == 0 && q.MaxCids == 0 {
+		return nil
+	}
+	pins, err := i.is.GetCids()
+	if err != nil {
+		return fmt.Errorf("getting pins from instance: %s", err)
+	}
+	_, usage, err := i.storageUsage(pins)
+	if err != nil {
+		return fmt.Errorf("getting storage usage: %s", err)
+	}
+	if q.MaxCids > 0 && usage.Cids >= q.MaxCids {
+		return &QuotaExceededError{Quota: "cids", Limit: uint64(q.MaxCids), Usage: uint64(usage.Cids)}
+	}
+	if q.MaxHotBytes > 0 && usage.HotBytes >= q.MaxHotBytes {
+		return &QuotaExceededError{Quota: "hot-bytes", Limit: uint64(q.MaxHotBytes), Usage: uint64(usage.HotBytes)}
+	}
+	return nil
+}
+
 // WatchJobs subscribes to Job status changes. If jids is empty, it subscribes to
 // all Job status changes corresonding to the instance. If jids is not empty,
 // it immediately sends current state of those Jobs. If empty, it doesn't.
@@ -213,6 +421,67 @@ func (i *API) WatchJobs(ctx context.Context, c chan<- ffs.Job, jids ...ffs.JobID
 	return nil
 }
 
+// CancelJob cancels a queued or in-progress Job of the instance. If the Job
+// was executing, the storage state reached until cancellation is kept.
+func (i *API) CancelJob(jid ffs.JobID) error {
+	j, err := i.sched.GetJob(jid)
+	if err == scheduler.ErrNotFound {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("getting job: %s", err)
+	}
+	if j.APIID != i.iid {
+		return ErrNotFound
+	}
+	if err := i.sched.CancelJob(jid); err != nil {
+		return fmt.Errorf("canceling job in scheduler: %s", err)
+	}
+	return nil
+}
+
+// ListJobs returns the instance Jobs selected by the provided options,
+// ordered by creation time.
+func (i *API) ListJobs(opts ...ListJobsOption) ([]ffs.Job, error) {
+	config := &ListJobsConfig{}
+	for _, o := range opts {
+		o(config)
+	}
+	config.filter.APIID = i.iid
+	js, err := i.sched.ListJobs(config.filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing jobs from scheduler: %s", err)
+	}
+	return js, nil
+}
+
+// Spend returns a report of the attoFIL committed to active deals by the
+// instance. By default all deals are considered, which can be narrowed with opts.
+func (i *API) Spend(opts ...SpendOption) (SpendReport, error) {
+	config := &SpendConfig{}
+	for _, o := range opts {
+		o(config)
+	}
+	config.filter.APIID = i.iid
+	rs, err := i.sched.ListSpends(config.filter)
+	if err != nil {
+		return SpendReport{}, fmt.Errorf("listing spends: %s", err)
+	}
+	report := SpendReport{
+		ByCid:   make(map[cid.Cid]uint64),
+		ByMiner: make(map[string]uint64),
+		ByDay:   make(map[string]uint64),
+		Records: rs,
+	}
+	for _, r := range rs {
+		report.Total += r.Amount
+		report.ByCid[r.Cid] += r.Amount
+		report.ByMiner[r.Miner] += r.Amount
+		report.ByDay[r.Time.UTC().Format("2006-01-02")] += r.Amount
+	}
+	return report, nil
+}
+
 // Replace push a CidConfig of c2 equal to c1, and removes c1. This operation
 // is more efficient than manually removing and adding in two separate operations.
 func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
@@ -224,7 +493,13 @@ func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
 		return ffs.EmptyJobID, fmt.Errorf("getting replaced cid config: %s", err)
 	}
 	cfg.Cid = c2
-	jid, err := i.sched.PushReplace(i.iid, i.cfg.WalletAddr, cfg, c1)
+	i.lock.Lock()
+	waddr, err := i.resolveAddr(cfg)
+	i.lock.Unlock()
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("resolving wallet address: %s", err)
+	}
+	jid, err := i.sched.PushReplace(i.iid, waddr, cfg, c1)
 	if err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("scheduling replacement %s to %s: %s", c1, c2, err)
 	}
@@ -240,36 +515,204 @@ func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
 // PushConfig push a new configuration for the Cid in the Hot and
 // Cold layer. If WithOverride opt isn't set it errors with ErrMustOverrideConfig
 func (i *API) PushConfig(c cid.Cid, opts ...PushConfigOption) (ffs.JobID, error) {
+	cost, err := i.estimateCost(c, opts...)
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
+	cfg, err := i.newPushConfig(c, opts...)
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+	waddr, err := i.resolveAddr(cfg.Config)
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("resolving wallet address: %s", err)
+	}
+	if err := i.checkQuotas(cfg.Config, cost); err != nil {
+		return ffs.EmptyJobID, err
+	}
+
+	jid, err := i.sched.PushConfig(i.cfg.ID, waddr, cfg.Config, cfg.Priority)
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("scheduling cid %s: %s", c, err)
+	}
+	if err := i.is.PutCidConfig(cfg.Config); err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("saving new config for cid %s: %s", c, err)
+	}
+	return jid, nil
+}
+
+// estimateCost returns the estimated cost of the new deals of pushing a
+// configuration, if a spend quota is set. The estimation asks miners for
+// their prices, so it's done without holding the instance lock.
+func (i *API) estimateCost(c cid.Cid, opts ...PushConfigOption) (uint64, error) {
+	i.lock.Lock()
+	cfg, err := i.newPushConfig(c, opts...)
+	maxSpend := i.cfg.Quotas.MaxSpend
+	i.lock.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	if maxSpend == 0 || !cfg.Config.Cold.Enabled {
+		return 0, nil
+	}
+	plan, err := i.sched.PlanConfig(i.ctx, i.cfg.ID, cfg.Config)
+	if err != nil {
+		return 0, fmt.Errorf("estimating cost of cid %s: %s", c, err)
+	}
+	return plan.Cold.EstimatedCost, nil
+}
+
+// checkQuotas returns a *QuotaExceededError if pushing cfg would exceed a
+// quota of the instance. The spend quota is checked adding the estimated
+// cost of the new deals to the spends recorded for active deals.
+func (i *API) checkQuotas(cfg ffs.CidConfig, cost uint64) error {
+	q := i.cfg.Quotas
+	if q == (Quotas{}) {
+		return nil
+	}
+	pins, err := i.is.GetCids()
+	if err != nil {
+		return fmt.Errorf("getting pins from instance: %s", err)
+	}
+	_, usage, err := i.storageUsage(pins)
+	if err != nil {
+		return fmt.Errorf("getting storage usage: %s", err)
+	}
+	curr, err := i.sched.GetCidInfo(cfg.Cid)
+	if err != nil && err != scheduler.ErrNotFound {
+		return fmt.Errorf("getting cid information: %s", err)
+	}
+
+	if q.MaxCids > 0 && !containsCid(pins, cfg.Cid) && usage.Cids >= q.MaxCids {
+		return &QuotaExceededError{Quota: "cids", Limit: uint64(q.MaxCids), Usage: uint64(usage.Cids + 1)}
+	}
+	// The size of the Cid is only known if it was already stored,
+	// so the storage quotas are checked with the current usage otherwise.
+	size := curr.Hot.Size
+	if curr.Cold.Filecoin.Size > size {
+		size = curr.Cold.Filecoin.Size
+	}
+	if q.MaxHotBytes > 0 && cfg.Hot.Enabled && !curr.Refs.HasHot(i.iid) {
+		if usage.HotBytes >= q.MaxHotBytes || usage.HotBytes+size > q.MaxHotBytes {
+			return &QuotaExceededError{Quota: "hot-bytes", Limit: uint64(q.MaxHotBytes), Usage: uint64(usage.HotBytes + size)}
+		}
+	}
+	if q.MaxColdBytes > 0 && cfg.Cold.Enabled && !curr.Refs.HasCold(i.iid) {
+		if usage.ColdBytes >= q.MaxColdBytes || usage.ColdBytes+size > q.MaxColdBytes {
+			return &QuotaExceededError{Quota: "cold-bytes", Limit: uint64(q.MaxColdBytes), Usage: uint64(usage.ColdBytes + size)}
+		}
+	}
+	if q.MaxSpend == 0 || !cfg.Cold.Enabled {
+		return nil
+	}
+	spent, err := i.spent(q.SpendPeriod)
+	if err != nil {
+		return fmt.Errorf("getting spent in spend period: %s", err)
+	}
+	if spent+cost > q.MaxSpend {
+		return &QuotaExceededError{Quota: "spend", Limit: q.MaxSpend, Usage: spent + cost}
+	}
+	return nil
+}
+
+// storageUsage returns the Hot Storage information and the storage
+// usage of the instance considering its pinned Cids.
+func (i *API) storageUsage(pins []cid.Cid) (HotStorageInfo, QuotasUsage, error) {
+	var hot HotStorageInfo
+	usage := QuotasUsage{Cids: len(pins)}
+	for _, c := range pins {
+		inf, err := i.sched.GetCidInfo(c)
+		if err == scheduler.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return HotStorageInfo{}, QuotasUsage{}, fmt.Errorf("getting cid information of %s: %s", c, err)
+		}
+		if inf.Hot.Enabled && inf.Refs.HasHot(i.iid) {
+			hot.Size += inf.Hot.Size
+			hot.NumBlocks += inf.Hot.NumBlocks
+		}
+		if len(inf.Cold.Filecoin.Proposals) > 0 && inf.Refs.HasCold(i.iid) {
+			usage.ColdBytes += inf.Cold.Filecoin.Size
+		}
+	}
+	usage.HotBytes = hot.Size
+	return hot, usage, nil
+}
+
+// spent returns the attoFIL committed to active deals of the instance in
+// the last period. If period is zero, all active deals are considered.
+func (i *API) spent(period time.Duration) (uint64, error) {
+	if period == 0 {
+		t, err := i.sched.GetSpendTotals(i.iid)
+		if err != nil {
+			return 0, fmt.Errorf("getting spend totals: %s", err)
+		}
+		return t.Total, nil
+	}
+	rs, err := i.sched.ListSpends(ffs.SpendFilter{APIID: i.iid, From: time.Now().Add(-period)})
+	if err != nil {
+		return 0, fmt.Errorf("listing spends: %s", err)
+	}
+	var total uint64
+	for _, r := range rs {
+		total += r.Amount
+	}
+	return total, nil
+}
+
+func containsCid(cids []cid.Cid, c cid.Cid) bool {
+	for _, e := range cids {
+		if e == c {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanConfig returns what pushing a configuration for the Cid would do in the Hot
+// and Cold layer, without creating a Job. It accepts the same options as PushConfig,
+// and fails in the same cases.
+func (i *API) PlanConfig(ctx context.Context, c cid.Cid, opts ...PushConfigOption) (ffs.StoragePlan, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	cfg, err := i.newPushConfig(c, opts...)
+	if err != nil {
+		return ffs.StoragePlan{}, err
+	}
+
+	plan, err := i.sched.PlanConfig(ctx, i.cfg.ID, cfg.Config)
+	if err != nil {
+		return ffs.StoragePlan{}, fmt.Errorf("planning cid %s: %s", c, err)
+	}
+	return plan, nil
+}
+
+func (i *API) newPushConfig(c cid.Cid, opts ...PushConfigOption) (PushConfig, error) {
 	cfg := newDefaultPushConfig(c, i.cfg.DefaultCidConfig)
 	for _, opt := range opts {
 		if err := opt(&cfg); err != nil {
-			return ffs.EmptyJobID, fmt.Errorf("config option: %s", err)
+			return PushConfig{}, fmt.Errorf("config option: %s", err)
 		}
 	}
 	if !cfg.OverrideConfig {
 		_, err := i.is.GetCidConfig(c)
 		if err == nil {
-			return ffs.EmptyJobID, ErrMustOverrideConfig
+			return PushConfig{}, ErrMustOverrideConfig
 		}
 		if err != ErrNotFound {
-			return ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
+			return PushConfig{}, fmt.Errorf("getting cid config: %s", err)
 		}
 	}
 	if err := cfg.Config.Validate(); err != nil {
-		return ffs.EmptyJobID, err
-	}
-
-	jid, err := i.sched.PushConfig(i.cfg.ID, i.cfg.WalletAddr, cfg.Config)
-	if err != nil {
-		return ffs.EmptyJobID, fmt.Errorf("scheduling cid %s: %s", c, err)
+		return PushConfig{}, err
 	}
-	if err := i.is.PutCidConfig(cfg.Config); err != nil {
-		return ffs.EmptyJobID, fmt.Errorf("saving new config for cid %s: %s", c, err)
-	}
-	return jid, nil
+	return cfg, nil
 }
 
 // Remove removes a Cid from being tracked as an active storage. The Cid should have
@@ -288,7 +731,7 @@ func (i *API) Remove(c cid.Cid) error {
 	if cfg.Hot.Enabled || cfg.Cold.Enabled {
 		return ErrActiveInStorage
 	}
-	if err := i.sched.Untrack(c); err != nil {
+	if err := i.sched.Untrack(i.cfg.ID, c); err != nil {
 		return fmt.Errorf("untracking from scheduler: %s", err)
 	}
 	if err := i.is.RemoveCidConfig(c); err != nil {
@@ -316,6 +759,34 @@ func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	return r, nil
 }
 
+// GetFromCold returns a CAR stream with the Cid data retrieved from the Cold Storage,
+// paying the retrieval with the Cid configured wallet address. It doesn't need the Cid to be enabled
+// in the Hot Storage, and doesn't change its storage state. The reader should be closed
+// when done.
+func (i *API) GetFromCold(ctx context.Context, c cid.Cid) (io.ReadCloser, error) {
+	if !c.Defined() {
+		return nil, fmt.Errorf("cid is undefined")
+	}
+	cfg, err := i.is.GetCidConfig(c)
+	if err != nil {
+		return nil, fmt.Errorf("getting cid config: %s", err)
+	}
+	i.lock.Lock()
+	waddr, err := i.resolveAddr(cfg)
+	i.lock.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("resolving wallet address: %s", err)
+	}
+	r, err := i.sched.GetCidFromCold(ctx, c, waddr)
+	if err == ffs.ErrColdStorageEmpty {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting from cold layer %s: %s", c, err)
+	}
+	return r, nil
+}
+
 // WatchLogs pushes human-friendly messages about Cid executions. The method is blocking
 // and will continue to send messages until the context is canceled.
 func (i *API) WatchLogs(ctx context.Context, ch chan<- ffs.LogEntry, c cid.Cid, opts ...GetLogsOption) error {
@@ -14,6 +14,7 @@ type PushConfigOption func(o *PushConfig) error
 type PushConfig struct {
 	Config         ffs.CidConfig
 	OverrideConfig bool
+	Priority       int
 }
 
 func newDefaultPushConfig(c cid.Cid, dc ffs.DefaultCidConfig) PushConfig {
@@ -24,9 +25,10 @@ func newDefaultPushConfig(c cid.Cid, dc ffs.DefaultCidConfig) PushConfig {
 
 func newDefaultCidConfig(c cid.Cid, dc ffs.DefaultCidConfig) ffs.CidConfig {
 	return ffs.CidConfig{
-		Cid:  c,
-		Hot:  dc.Hot,
-		Cold: dc.Cold,
+		Cid:   c,
+		Hot:   dc.Hot,
+		Cold:  dc.Cold,
+		Retry: dc.Retry,
 	}
 }
 
@@ -47,6 +49,16 @@ func WithOverride(override bool) PushConfigOption {
 	}
 }
 
+// WithPriority sets the priority of the created Job relative to other
+// queued Jobs of the instance. Jobs with higher priority are executed
+// first. The default priority is zero.
+func WithPriority(priority int) PushConfigOption {
+	return func(o *PushConfig) error {
+		o.Priority = priority
+		return nil
+	}
+}
+
 // Validate validates a PushConfig.
 func (pc PushConfig) Validate() error {
 	if err := pc.Config.Validate(); err != nil {
@@ -2,6 +2,8 @@ package api
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/ffs"
@@ -25,9 +27,59 @@ type InstanceStore interface {
 
 // Config has general information about a Api instance.
 type Config struct {
-	ID               ffs.APIID
-	WalletAddr       string
+	ID ffs.APIID
+	// WalletAddr is the default wallet address, which pays for
+	// Cids that don't specify one.
+	WalletAddr string
+	// Addrs are the wallet addresses owned by the instance.
+	Addrs            []AddrInfo
 	DefaultCidConfig ffs.DefaultCidConfig
+	Quotas           Quotas
+}
+
+// Quotas are limits on the storage and spending of an Api instance.
+// A zero value of any limit means that it's unlimited.
+type Quotas struct {
+	// MaxHotBytes is the maximum total size in bytes of the Cids
+	// stored in the Hot Storage.
+	MaxHotBytes int
+	// MaxColdBytes is the maximum total size in bytes of the Cids
+	// stored in the Cold Storage.
+	MaxColdBytes int
+	// MaxCids is the maximum number of Cids with a pushed configuration.
+	MaxCids int
+	// MaxSpend is the maximum amount of attoFIL that can be committed
+	// to deals in SpendPeriod. Pushing a configuration is rejected if the
+	// estimated cost of its new deals would exceed it.
+	MaxSpend uint64
+	// SpendPeriod is the period of time considered for MaxSpend. If
+	// zero, all past spends are considered.
+	SpendPeriod time.Duration
+}
+
+// QuotasUsage is the current usage of the resources limited by Quotas.
+type QuotasUsage struct {
+	HotBytes  int
+	ColdBytes int
+	Cids      int
+	// Spent is the amount of attoFIL committed to active deals
+	// in the current spend period.
+	Spent uint64
+}
+
+// QuotaExceededError is returned when an operation is rejected since it
+// would exceed a quota of the Api instance.
+type QuotaExceededError struct {
+	// Quota is the name of the exceeded quota.
+	Quota string
+	Limit uint64
+	// Usage is the usage that the operation would reach, if known,
+	// or the current usage otherwise.
+	Usage uint64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded: usage %d, limit %d", e.Quota, e.Usage, e.Limit)
 }
 
 // InstanceInfo has general information about a running Api instance.
@@ -36,6 +88,62 @@ type InstanceInfo struct {
 	DefaultCidConfig ffs.DefaultCidConfig
 	Wallet           WalletInfo
 	Pins             []cid.Cid
+	// QueuedJobs is the number of Jobs of the instance
+	// waiting to be executed.
+	QueuedJobs int
+	HotStorage HotStorageInfo
+	Quotas     Quotas
+	Usage      QuotasUsage
+	// TotalSpend is the attoFIL committed to active deals by
+	// the instance.
+	TotalSpend uint64
+}
+
+// HotStorageInfo contains information about the Hot Storage
+// usage of the Api instance.
+type HotStorageInfo struct {
+	// Size is the total size in bytes of the Cids stored in the
+	// Hot Storage for the instance.
+	Size int
+	// NumBlocks is the total number of blocks of the Cids stored
+	// in the Hot Storage for the instance.
+	NumBlocks int
+}
+
+// AddrInfo contains information about a wallet address owned by
+// the Api instance.
+type AddrInfo struct {
+	// Name is a human readable label of the address.
+	Name string
+	Addr string
+	// Type is the wallet address type, e.g: bls or secp256k1.
+	Type string
+}
+
+// NewAddressConfig contains options for creating a new wallet
+// address.
+type NewAddressConfig struct {
+	addrType    string
+	makeDefault bool
+}
+
+// NewAddressOption is a function that changes NewAddressConfig.
+type NewAddressOption func(config *NewAddressConfig)
+
+// WithAddressType specifies the type of the new address, bls
+// or secp256k1. The default is bls.
+func WithAddressType(addrType string) NewAddressOption {
+	return func(c *NewAddressConfig) {
+		c.addrType = addrType
+	}
+}
+
+// WithMakeDefault indicates that the new address should become
+// the default address of the instance.
+func WithMakeDefault(makeDefault bool) NewAddressOption {
+	return func(c *NewAddressConfig) {
+		c.makeDefault = makeDefault
+	}
 }
 
 // WalletInfo contains information about the Wallet associated with
@@ -61,3 +169,86 @@ func WithJidFilter(jid ffs.JobID) GetLogsOption {
 		c.jid = jid
 	}
 }
+
+// ListJobsConfig contains filters and pagination for listing Jobs.
+type ListJobsConfig struct {
+	filter ffs.JobFilter
+}
+
+// ListJobsOption is a function that changes ListJobsConfig.
+type ListJobsOption func(config *ListJobsConfig)
+
+// WithJobsCid filters only Jobs of Cid c.
+func WithJobsCid(c cid.Cid) ListJobsOption {
+	return func(conf *ListJobsConfig) {
+		conf.filter.Cid = c
+	}
+}
+
+// WithJobsStatus filters only Jobs in one of the provided statuses.
+func WithJobsStatus(statuses ...ffs.JobStatus) ListJobsOption {
+	return func(conf *ListJobsConfig) {
+		conf.filter.Statuses = statuses
+	}
+}
+
+// WithJobsCreatedBetween filters only Jobs created in the [from, to)
+// range. A zero value in any of the bounds leaves that side open.
+func WithJobsCreatedBetween(from, to time.Time) ListJobsOption {
+	return func(conf *ListJobsConfig) {
+		conf.filter.CreatedFrom = from
+		conf.filter.CreatedTo = to
+	}
+}
+
+// WithJobsPage skips the first offset Jobs and returns at most
+// limit of them. A zero limit means no limit.
+func WithJobsPage(offset, limit int) ListJobsOption {
+	return func(conf *ListJobsConfig) {
+		conf.filter.Offset = offset
+		conf.filter.Limit = limit
+	}
+}
+
+// SpendReport breaks down the attoFIL committed to active deals
+// by the instance.
+type SpendReport struct {
+	Total   uint64
+	ByCid   map[cid.Cid]uint64
+	ByMiner map[string]uint64
+	// ByDay is keyed by the UTC date in which deals were recorded,
+	// with YYYY-MM-DD format.
+	ByDay   map[string]uint64
+	Records []ffs.SpendRecord
+}
+
+// SpendConfig contains filters for a SpendReport.
+type SpendConfig struct {
+	filter ffs.SpendFilter
+}
+
+// SpendOption is a function that changes SpendConfig.
+type SpendOption func(config *SpendConfig)
+
+// WithSpendCid considers only deals of Cid c.
+func WithSpendCid(c cid.Cid) SpendOption {
+	return func(conf *SpendConfig) {
+		conf.filter.Cid = c
+	}
+}
+
+// WithSpendMiner considers only deals with a miner.
+func WithSpendMiner(miner string) SpendOption {
+	return func(conf *SpendConfig) {
+		conf.filter.Miner = miner
+	}
+}
+
+// WithSpendBetween considers only deals recorded in the [from, to)
+// range. A zero value in any of the bounds leaves that side open.
+func WithSpendBetween(from, to time.Time) SpendOption {
+	return func(conf *SpendConfig) {
+		conf.filter.From = from
+		conf.filter.To = to
+	}
+}
@@ -32,6 +32,7 @@ type logEntry struct {
 	Timestamp int64
 	Jid       ffs.JobID
 	Msg       string
+	Event     ffs.LogEvent
 }
 
 var _ ffs.CidLogger = (*CidLogger)(nil)
@@ -44,13 +45,18 @@ func New(ds datastore.Datastore) *CidLogger {
 }
 
 // Log logs a log entry for a Cid. The ctx can contain an optional ffs.CtxKeyJid to add
-// additional metadata about the log entry being part of a Job execution.
+// additional metadata about the log entry being part of a Job execution, and an optional
+// ffs.CtxKeyEvent to signal an event.
 func (cl *CidLogger) Log(ctx context.Context, c cid.Cid, format string, a ...interface{}) {
 	log.Infof(format, a...)
 	jid := ffs.EmptyJobID
 	if ctxjid, ok := ctx.Value(ffs.CtxKeyJid).(ffs.JobID); ok {
 		jid = ctxjid
 	}
+	event := ffs.EventNone
+	if ctxevent, ok := ctx.Value(ffs.CtxKeyEvent).(ffs.LogEvent); ok {
+		event = ctxevent
+	}
 	now := time.Now().UnixNano()
 	key := makeKey(c, now)
 	le := logEntry{
@@ -58,6 +64,7 @@ func (cl *CidLogger) Log(ctx context.Context, c cid.Cid, format string, a ...int
 		Jid:       jid,
 		Msg:       fmt.Sprintf(format, a...),
 		Timestamp: now,
+		Event:     event,
 	}
 	b, err := json.Marshal(le)
 	if err != nil {
@@ -74,6 +81,7 @@ func (cl *CidLogger) Log(ctx context.Context, c cid.Cid, format string, a ...int
 		Jid:       le.Jid,
 		Timestamp: time.Unix(0, le.Timestamp),
 		Msg:       fmt.Sprintf(format, a...),
+		Event:     le.Event,
 	}
 	cl.lock.Lock()
 	defer cl.lock.Unlock()
@@ -96,30 +96,66 @@ func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 }
 
 // Store stores a Cid in the HotStorage. At the IPFS level, it also mark the Cid as pinned.
-func (ci *CoreIpfs) Store(ctx context.Context, c cid.Cid) (int, error) {
+func (ci *CoreIpfs) Store(ctx context.Context, c cid.Cid) (ffs.DagStat, error) {
 	log.Debugf("fetching and pinning cid %s", c)
 	p := path.IpfsPath(c)
 	if err := ci.ipfs.Pin().Add(ctx, p, options.Pin.Recursive(true)); err != nil {
-		return 0, fmt.Errorf("pinning cid %s: %s", c, err)
+		return ffs.DagStat{}, fmt.Errorf("pinning cid %s: %s", c, err)
 	}
-	s, err := ci.ipfs.Block().Stat(ctx, p)
+	stat, err := ci.dagStat(ctx, c)
 	if err != nil {
-		return 0, fmt.Errorf("getting stats of cid %s: %s", c, err)
+		return ffs.DagStat{}, fmt.Errorf("getting stats of cid %s: %s", c, err)
 	}
-	return s.Size(), nil
+	return stat, nil
 }
 
 // Replace replaces a stored Cid with other Cid.
-func (ci *CoreIpfs) Replace(ctx context.Context, c1 cid.Cid, c2 cid.Cid) (int, error) {
+func (ci *CoreIpfs) Replace(ctx context.Context, c1 cid.Cid, c2 cid.Cid) (ffs.DagStat, error) {
 	p1 := path.IpfsPath(c1)
 	p2 := path.IpfsPath(c2)
 	log.Debugf("updating pin from %s to %s", p1, p2)
 	if err := ci.ipfs.Pin().Update(ctx, p1, p2); err != nil {
-		return 0, fmt.Errorf("updating pin %s to %s: %s", c1, c2, err)
+		return ffs.DagStat{}, fmt.Errorf("updating pin %s to %s: %s", c1, c2, err)
 	}
-	stat, err := ci.ipfs.Block().Stat(ctx, p2)
+	stat, err := ci.dagStat(ctx, c2)
 	if err != nil {
-		return 0, fmt.Errorf("getting stats of cid %s: %s", c2, err)
+		return ffs.DagStat{}, fmt.Errorf("getting stats of cid %s: %s", c2, err)
 	}
-	return stat.Size(), nil
+	return stat, nil
+}
+
+// Stat returns the size of the whole DAG of a Cid.
+func (ci *CoreIpfs) Stat(ctx context.Context, c cid.Cid) (ffs.DagStat, error) {
+	stat, err := ci.dagStat(ctx, c)
+	if err != nil {
+		return ffs.DagStat{}, fmt.Errorf("getting stats of cid %s: %s", c, err)
+	}
+	return stat, nil
+}
+
+// dagStat walks the DAG of a Cid and sums the size of its unique blocks.
+// Blocks that aren't available locally are fetched from the IPFS network.
+func (ci *CoreIpfs) dagStat(ctx context.Context, c cid.Cid) (ffs.DagStat, error) {
+	var stat ffs.DagStat
+	seen := map[cid.Cid]struct{}{c: {}}
+	level := []cid.Cid{c}
+	for len(level) > 0 {
+		var next []cid.Cid
+		for no := range ci.ipfs.Dag().GetMany(ctx, level) {
+			if no.Err != nil {
+				return ffs.DagStat{}, fmt.Errorf("getting dag node: %s", no.Err)
+			}
+			stat.Size += len(no.Node.RawData())
+			stat.NumBlocks++
+			for _, l := range no.Node.Links() {
+				if _, ok := seen[l.Cid]; ok {
+					continue
+				}
+				seen[l.Cid] = struct{}{}
+				next = append(next, l.Cid)
+			}
+		}
+		level = next
+	}
+	return stat, nil
 }
@@ -2,6 +2,7 @@ package filcold
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -16,17 +17,26 @@ import (
 
 var (
 	log = logger.Logger("ffs-filcold")
+
+	errRenewalPriceExceeded = errors.New("all candidate miners are above the renewal price limit")
 )
 
 // FilCold is a ffs.ColdStorage implementation that stores data in Filecoin.
 type FilCold struct {
 	ms    ffs.MinerSelector
-	dm    *deals.Module
+	dm    DealModule
 	dag   format.DAGService
 	chain FilChain
 	l     ffs.CidLogger
+
+	maxReplacements int
 }
 
+// dealRound is the state of making deals for a Cid, used to replace
+// failed proposals. It keeps the miner selection filter, which excludes
+// miners already proposed, and the remaining replacement budget.
+type dealRound ffs.FilDealRound
+
 var _ ffs.ColdStorage = (*FilCold)(nil)
 
 // FilChain is an abstraction of a Filecoin node to get information of the network.
@@ -34,21 +44,40 @@ type FilChain interface {
 	GetHeight(context.Context) (uint64, error)
 }
 
+// DealModule is an abstraction of the deals Module to make and watch deals,
+// and retrieve data.
+type DealModule interface {
+	Store(context.Context, string, io.Reader, []deals.StorageDealConfig, uint64, bool) (cid.Cid, []deals.StoreResult, error)
+	Watch(context.Context, []cid.Cid, ...deals.WatchOption) (<-chan deals.DealInfo, error)
+	GetDealStatus(context.Context, cid.Cid) (storagemarket.StorageDealStatus, bool, error)
+	Retrieve(context.Context, string, cid.Cid, bool, ...deals.RetrieveOption) (io.ReadCloser, deals.RetrievalInfo, error)
+}
+
+var _ DealModule = (*deals.Module)(nil)
+
 // New returns a new FilCold instance
-func New(ms ffs.MinerSelector, dm *deals.Module, dag format.DAGService, chain FilChain, l ffs.CidLogger) *FilCold {
+func New(ms ffs.MinerSelector, dm DealModule, dag format.DAGService, chain FilChain, l ffs.CidLogger, opts ...Option) (*FilCold, error) {
+	cfg := Config{MaxReplacements: defaultMaxReplacements}
+	for _, o := range opts {
+		if err := o(&cfg); err != nil {
+			return nil, err
+		}
+	}
 	return &FilCold{
 		ms:    ms,
 		dm:    dm,
 		dag:   dag,
 		chain: chain,
 		l:     l,
-	}
+
+		maxReplacements: cfg.MaxReplacements,
+	}, nil
 }
 
 // Retrieve returns the original data Cid, from the CAR encoded data Cid. The returned Cid is available in the
 // car.Store received as a parameter.
 func (fc *FilCold) Retrieve(ctx context.Context, dataCid cid.Cid, cs car.Store, waddr string) (cid.Cid, error) {
-	carR, err := fc.dm.Retrieve(ctx, waddr, dataCid, true)
+	carR, _, err := fc.dm.Retrieve(ctx, waddr, dataCid, true)
 	if err != nil {
 		return cid.Undef, fmt.Errorf("retrieving from deal module: %s", err)
 	}
@@ -67,26 +96,64 @@ func (fc *FilCold) Retrieve(ctx context.Context, dataCid cid.Cid, cs car.Store,
 	return h.Roots[0], nil
 }
 
-// Store stores a Cid in Filecoin considering the configuration provided. The Cid is retrieved using
-// the DAGService registered on instance creation.
-func (fc *FilCold) Store(ctx context.Context, c cid.Cid, waddr string, cfg ffs.FilConfig) (ffs.FilInfo, error) {
-	f := ffs.MinerSelectorFilter{
-		ExcludedMiners: cfg.ExcludedMiners,
-		CountryCodes:   cfg.CountryCodes,
+// RetrieveCAR returns the data of a Cid stored in Filecoin under dataCid, as a CAR
+// stream. The provider that served the data and the retrieval price are logged
+// in the Cid logs.
+func (fc *FilCold) RetrieveCAR(ctx context.Context, c cid.Cid, dataCid cid.Cid, waddr string) (io.ReadCloser, error) {
+	fc.l.Log(ctx, c, "Retrieving data from Filecoin...")
+	carR, info, err := fc.dm.Retrieve(ctx, waddr, dataCid, true)
+	if err != nil {
+		fc.l.Log(ctx, c, "Retrieval from Filecoin failed.")
+		return nil, fmt.Errorf("retrieving from deal module: %s", err)
 	}
-	cfgs, err := makeDealConfigs(ctx, fc.ms, cfg.RepFactor, f)
+	fc.l.Log(ctx, c, "Data retrieved from miner %s paying %d attoFIL.", info.Miner, info.Price)
+	return carR, nil
+}
+
+// Store makes deal proposals for a Cid in Filecoin considering the configuration provided. The Cid is
+// retrieved using the DAGService registered on instance creation. The returned proposals should be
+// watched with WaitForDeals. If proposed miners reject or fail the proposal, replacement
+// miners are asked for until RepFactor proposals are accepted or the replacement budget
+// runs out. The returned proposals carry their deal round, which WaitForDeals uses to
+// replace deals that fail after being accepted.
+func (fc *FilCold) Store(ctx context.Context, c cid.Cid, size int, waddr string, cfg ffs.FilConfig) ([]ffs.FilProposal, error) {
+	r := fc.newDealRound(cfg.RepFactor, waddr, cfg, newMinerSelectorFilter(cfg, size))
+	props, err := fc.proposeDeals(ctx, c, r, cfg.RepFactor)
 	if err != nil {
-		return ffs.FilInfo{}, fmt.Errorf("making deal configs: %s", err)
+		return nil, fmt.Errorf("executing deals: %s", err)
+	}
+	for i := range props {
+		props[i].Round = (*ffs.FilDealRound)(r)
 	}
-	props, err := fc.makeDeals(ctx, c, cfgs, waddr, cfg)
+	return props, nil
+}
+
+// PlanDeals returns the miners and prices that Store would use to make deal
+// proposals with the configuration provided, without making them.
+func (fc *FilCold) PlanDeals(ctx context.Context, size int, cfg ffs.FilConfig) ([]ffs.MinerProposal, error) {
+	f := newMinerSelectorFilter(cfg, size)
+	mps, err := fc.ms.GetMiners(cfg.RepFactor, f)
 	if err != nil {
-		return ffs.FilInfo{}, fmt.Errorf("executing deals: %s", err)
+		return nil, fmt.Errorf("getting miners from minerselector: %s", err)
 	}
+	return mps, nil
+}
 
-	return ffs.FilInfo{
+// WaitForDeals waits for deal proposals of a Cid to become active on-chain or fail. If deals
+// fail, replacement miners are proposed and waited for while the replacement budget of the
+// deal round of the proposals allows it. Proposals without a deal round aren't replaced. If
+// waiting fails or gets canceled, the returned FilInfo contains the deals that became active
+// anyway.
+func (fc *FilCold) WaitForDeals(ctx context.Context, c cid.Cid, props []ffs.FilProposal, duration int64) (ffs.FilInfo, error) {
+	active, err := fc.waitAndReplace(ctx, c, props, duration, proposalsRound(props))
+	res := ffs.FilInfo{
 		DataCid:   c,
-		Proposals: props,
-	}, nil
+		Proposals: active,
+	}
+	if err != nil {
+		return res, fmt.Errorf("waiting for deals to finish: %s", err)
+	}
+	return res, nil
 }
 
 // IsFilDealActive returns true if a deal is considered active on-chain, false otherwise.
@@ -104,13 +171,59 @@ func (fc *FilCold) IsFilDealActive(ctx context.Context, proposalCid cid.Cid) (bo
 
 // EnsureRenewals analyzes a FilInfo state for a Cid and executes renewals considering the FilConfig desired configuration.
 func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInfo, waddr string, cfg ffs.FilConfig) (ffs.FilInfo, error) {
-	var activeMiners []string
-	for _, p := range inf.Proposals {
-		activeMiners = append(activeMiners, p.Miner)
+	activeMiners := filMiners(inf)
+	toRenew, err := fc.dealsToRenew(ctx, inf, cfg)
+	if err != nil {
+		return ffs.FilInfo{}, err
+	}
+	for i, p := range toRenew {
+		newProposal, err := fc.renewDeal(ctx, c, inf.Size, waddr, p, activeMiners, cfg)
+		if err == errRenewalPriceExceeded {
+			log.Warnf("skipping renewal of deal %s: %s", p.ProposalCid, err)
+			ectx := context.WithValue(ctx, ffs.CtxKeyEvent, ffs.EventRenewalBlocked)
+			fc.l.Log(ectx, c, "Renewal of deal with miner %s skipped, all candidate miners are above %d attoFIL per epoch.", p.Miner, renewalPriceLimit(p, cfg))
+			continue
+		}
+		if err != nil {
+			log.Errorf("renewing deal %s: %s", p.ProposalCid, err)
+			continue
+		}
+		inf.Proposals = append(inf.Proposals, newProposal)
+		inf.Proposals[i].Renewed = true
+	}
+
+	return inf, nil
+}
+
+// PlanRenewals returns the miners and prices that EnsureRenewals would use to
+// renew the deals of a FilInfo, without making any deal. Deals that can't be
+// renewed with the current miners are skipped.
+func (fc *FilCold) PlanRenewals(ctx context.Context, inf ffs.FilInfo, cfg ffs.FilConfig) ([]ffs.MinerProposal, error) {
+	activeMiners := filMiners(inf)
+	toRenew, err := fc.dealsToRenew(ctx, inf, cfg)
+	if err != nil {
+		return nil, err
 	}
+	var res []ffs.MinerProposal
+	for _, p := range toRenew {
+		limit := renewalPriceLimit(p, cfg)
+		for _, f := range renewalFilters(inf.Size, p, activeMiners, cfg, limit) {
+			mps, err := fc.ms.GetMiners(1, f)
+			if err == nil {
+				res = append(res, mps[0])
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// dealsToRenew returns the deals of a FilInfo that should be renewed now to
+// keep the configured replication factor.
+func (fc *FilCold) dealsToRenew(ctx context.Context, inf ffs.FilInfo, cfg ffs.FilConfig) ([]ffs.FilStorage, error) {
 	height, err := fc.chain.GetHeight(ctx)
 	if err != nil {
-		return ffs.FilInfo{}, fmt.Errorf("get current filecoin height: %s", err)
+		return nil, fmt.Errorf("get current filecoin height: %s", err)
 	}
 	var renewable []ffs.FilStorage
 	for _, p := range inf.Proposals {
@@ -132,48 +245,254 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	if numToBeRenewed <= 0 {
 		// Nothing to be renewed to ensure RepFactor,
 		// most prob the RepFactor was decreased.
-		return inf, nil
+		return nil, nil
 	}
 	if numToBeRenewed > len(renewable) {
 		// We need even more deals than renewable to ensure RepFactor,
-		// that's job of the repair module. We renew as many as can be
-		// renewed.
+		// that's job of the Scheduler repair checks if enabled. We renew
+		// as many as can be renewed.
 		numToBeRenewed = len(renewable)
 	}
-	toRenew := renewable[:numToBeRenewed]
-	for i, p := range toRenew {
-		newProposal, err := fc.renewDeal(ctx, c, waddr, p, activeMiners, cfg)
-		if err != nil {
-			log.Errorf("renewing deal %s: %s", p.ProposalCid, err)
-			continue
+	return renewable[:numToBeRenewed], nil
+}
+
+func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, size int, waddr string, p ffs.FilStorage, activeMiners []string, fcfg ffs.FilConfig) (ffs.FilStorage, error) {
+	fc.l.Log(ctx, c, "Renewing deal with miner %s using %s strategy...", p.Miner, fcfg.Renew.Strategy)
+	props, r, err := fc.proposeRenewal(ctx, c, size, waddr, p, activeMiners, fcfg)
+	if err == errRenewalPriceExceeded {
+		return ffs.FilStorage{}, err
+	}
+	if err != nil {
+		return ffs.FilStorage{}, fmt.Errorf("executing renewed deal: %s", err)
+	}
+	for _, np := range props {
+		fc.l.Log(ctx, c, "Renewal of deal with miner %s chose miner %s with price %d (%+d attoFIL per epoch).", p.Miner, np.Miner, np.EpochPrice, int64(np.EpochPrice)-int64(p.EpochPrice))
+	}
+	active, err := fc.waitAndReplace(ctx, c, props, fcfg.DealDuration, r)
+	if err != nil {
+		return ffs.FilStorage{}, fmt.Errorf("waiting for renewed deal: %s", err)
+	}
+	if len(active) != 1 {
+		return ffs.FilStorage{}, fmt.Errorf("unsuccessful renewal")
+	}
+	return active[0], nil
+}
+
+// proposeRenewal makes the deal proposal renewing p, selecting the miner with the
+// configured renew strategy. The FilConfig miner filters and the renewal price
+// limits are always respected. If there're candidate miners but all of them are
+// above the renewal price limit, it returns errRenewalPriceExceeded. The returned
+// dealRound allows replacing the proposal if it fails.
+func (fc *FilCold) proposeRenewal(ctx context.Context, c cid.Cid, size int, waddr string, p ffs.FilStorage, activeMiners []string, fcfg ffs.FilConfig) ([]ffs.FilProposal, *dealRound, error) {
+	limit := renewalPriceLimit(p, fcfg)
+	fs := renewalFilters(size, p, activeMiners, fcfg, limit)
+	if limit > 0 && !fc.anyMiner(fs) && fc.anyMiner(renewalFilters(size, p, activeMiners, fcfg, 0)) {
+		return nil, nil, errRenewalPriceExceeded
+	}
+	if fcfg.Renew.Strategy == ffs.RenewSameMiner && len(fs) == 1 {
+		fc.l.Log(ctx, c, "Miner %s isn't trusted anymore, falling back to new miner selection.", p.Miner)
+	}
+	for _, f := range fs[:len(fs)-1] {
+		r := fc.newDealRound(1, waddr, fcfg, f)
+		props, err := fc.proposeDeals(ctx, c, r, 1)
+		if err == nil {
+			return props, r, nil
 		}
-		inf.Proposals = append(inf.Proposals, newProposal)
-		inf.Proposals[i].Renewed = true
+		fc.l.Log(ctx, c, "Renewal with the same miner %s isn't possible, falling back to new miner selection: %s", p.Miner, err)
 	}
+	r := fc.newDealRound(1, waddr, fcfg, fs[len(fs)-1])
+	props, err := fc.proposeDeals(ctx, c, r, 1)
+	return props, r, err
+}
 
-	return inf, nil
+// renewalFilters returns the miner selector filters to renew p with the
+// configured renew strategy, in order of preference. The last one selects
+// a new miner, unless the strategy selects the cheapest one. Zero limit
+// means there's no renewal price limit.
+func renewalFilters(size int, p ffs.FilStorage, activeMiners []string, fcfg ffs.FilConfig, limit uint64) []ffs.MinerSelectorFilter {
+	// Miners with active deals are excluded, except the miner of the
+	// renewed deal if the strategy allows renewing with it.
+	otherActiveMiners := make([]string, 0, len(activeMiners))
+	for _, m := range activeMiners {
+		if m != p.Miner {
+			otherActiveMiners = append(otherActiveMiners, m)
+		}
+	}
+	var fs []ffs.MinerSelectorFilter
+	switch fcfg.Renew.Strategy {
+	case ffs.RenewSameMiner:
+		f := newRenewalFilter(fcfg, size, limit)
+		if len(f.TrustedMiners) == 0 || containsMiner(f.TrustedMiners, p.Miner) {
+			f.TrustedMiners = []string{p.Miner}
+			f.ExcludedMiners = append(f.ExcludedMiners, otherActiveMiners...)
+			fs = append(fs, f)
+		}
+	case ffs.RenewCheapest:
+		f := newRenewalFilter(fcfg, size, limit)
+		f.ExcludedMiners = append(f.ExcludedMiners, otherActiveMiners...)
+		f.Cheapest = true
+		return []ffs.MinerSelectorFilter{f}
+	}
+	f := newRenewalFilter(fcfg, size, limit)
+	f.ExcludedMiners = append(f.ExcludedMiners, activeMiners...)
+	return append(fs, f)
 }
 
-func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, waddr string, p ffs.FilStorage, activeMiners []string, fcfg ffs.FilConfig) (ffs.FilStorage, error) {
-	f := ffs.MinerSelectorFilter{
-		ExcludedMiners: activeMiners,
+// anyMiner returns true if the miner selector has a miner for any
+// of the filters.
+func (fc *FilCold) anyMiner(fs []ffs.MinerSelectorFilter) bool {
+	for _, f := range fs {
+		if _, err := fc.ms.GetMiners(1, f); err == nil {
+			return true
+		}
 	}
-	dealConfig, err := makeDealConfigs(ctx, fc.ms, 1, f)
-	if err != nil {
-		return ffs.FilStorage{}, fmt.Errorf("making new deal config: %s", err)
+	return false
+}
+
+// renewalPriceLimit returns the maximum epoch price allowed to renew p
+// considering the renew configuration. Zero means there's no limit.
+func renewalPriceLimit(p ffs.FilStorage, fcfg ffs.FilConfig) uint64 {
+	limit := fcfg.Renew.MaxPrice
+	if fcfg.Renew.MaxPriceIncrease > 0 {
+		incLimit := p.EpochPrice + p.EpochPrice*uint64(fcfg.Renew.MaxPriceIncrease)/100
+		if limit == 0 || incLimit < limit {
+			limit = incLimit
+		}
 	}
+	return limit
+}
 
-	props, err := fc.makeDeals(ctx, c, dealConfig, waddr, fcfg)
-	if err != nil {
-		return ffs.FilStorage{}, fmt.Errorf("executing renewed deal: %s", err)
+// newRenewalFilter returns the miner selector filter for renewals, with the
+// maximum price lowered to limit if it's stricter. Zero limit means there's
+// no renewal price limit.
+func newRenewalFilter(cfg ffs.FilConfig, size int, limit uint64) ffs.MinerSelectorFilter {
+	f := newMinerSelectorFilter(cfg, size)
+	if limit > 0 && (f.MaxPrice == 0 || limit < f.MaxPrice) {
+		f.MaxPrice = limit
 	}
-	if len(props) != 1 {
-		return ffs.FilStorage{}, fmt.Errorf("unsuccessful renewal")
+	return f
+}
+
+// newDealRound returns a dealRound to make n deals with miners selected with filter f,
+// with the configured replacement budget.
+func (fc *FilCold) newDealRound(n int, waddr string, fcfg ffs.FilConfig, f ffs.MinerSelectorFilter) *dealRound {
+	return &dealRound{
+		Wanted:    n,
+		Waddr:     waddr,
+		Config:    fcfg,
+		Filter:    f,
+		Remaining: fc.maxReplacements,
+	}
+}
+
+// proposeDeals makes n deal proposals for a Cid with miners selected with the filter of r.
+// Proposed miners are excluded from future selections of r. Miners that reject or fail the
+// proposal are replaced until n proposals are accepted or the replacement budget of r runs
+// out. It returns an error only if no proposal was accepted.
+func (fc *FilCold) proposeDeals(ctx context.Context, c cid.Cid, r *dealRound, n int) ([]ffs.FilProposal, error) {
+	var props []ffs.FilProposal
+	for cant := n; cant > 0; {
+		cfgs, err := makeDealConfigs(ctx, fc.ms, cant, r.Filter)
+		if err != nil {
+			if len(props) == 0 {
+				return nil, fmt.Errorf("making deal configs: %s", err)
+			}
+			fc.l.Log(ctx, c, "No more miners available to replace failed proposals: %s", err)
+			break
+		}
+		accepted, failed, err := fc.makeDeals(ctx, c, cfgs, r.Waddr, r.Config)
+		if err != nil {
+			if len(props) == 0 {
+				return nil, err
+			}
+			log.Errorf("making replacement deals: %s", err)
+			fc.l.Log(ctx, c, "Making replacement deals failed: %s", err)
+			break
+		}
+		props = append(props, accepted...)
+		r.Filter.ExcludedMiners = append(r.Filter.ExcludedMiners, failed...)
+		for _, p := range accepted {
+			r.Filter.ExcludedMiners = append(r.Filter.ExcludedMiners, p.Miner)
+		}
+
+		missing := n - len(props)
+		if missing == 0 {
+			break
+		}
+		cant = r.takeReplacements(missing)
+		if cant == 0 {
+			fc.l.Log(ctx, c, "Replacement budget exhausted with %d of %d proposals accepted.", len(props), n)
+			break
+		}
+		fc.l.Log(ctx, c, "Asking for %d replacement miners...", cant)
+	}
+	if len(props) == 0 {
+		return nil, fmt.Errorf("all proposed deals where rejected")
+	}
+	return props, nil
+}
+
+// proposalsRound returns a copy of the deal round of the proposals, or
+// nil if they don't have one.
+func proposalsRound(props []ffs.FilProposal) *dealRound {
+	for _, p := range props {
+		if p.Round == nil {
+			continue
+		}
+		r := dealRound(*p.Round)
+		r.Filter.ExcludedMiners = append([]string(nil), p.Round.Filter.ExcludedMiners...)
+		return &r
+	}
+	return nil
+}
+
+// takeReplacements takes up to n replacements from the budget, and
+// returns how many were taken.
+func (r *dealRound) takeReplacements(n int) int {
+	if n > r.Remaining {
+		n = r.Remaining
+	}
+	r.Remaining -= n
+	return n
+}
+
+// waitAndReplace waits for deal proposals of a Cid to become active on-chain or fail.
+// If r isn't nil and proposals fail, their miners are replaced while less than r.Wanted deals
+// are active and the replacement budget allows it. If waiting fails or gets canceled,
+// it returns the deals that became active anyway.
+func (fc *FilCold) waitAndReplace(ctx context.Context, c cid.Cid, props []ffs.FilProposal, duration int64, r *dealRound) ([]ffs.FilStorage, error) {
+	var active []ffs.FilStorage
+	for {
+		res, failed, err := fc.waitForDeals(ctx, c, props, duration)
+		active = append(active, res...)
+		if err != nil {
+			return active, err
+		}
+		if r == nil || len(failed) == 0 || len(active) >= r.Wanted {
+			break
+		}
+		cant := r.takeReplacements(r.Wanted - len(active))
+		if cant == 0 {
+			fc.l.Log(ctx, c, "Replacement budget exhausted with %d of %d deals active.", len(active), r.Wanted)
+			break
+		}
+		fc.l.Log(ctx, c, "Asking for %d replacement miners for failed deals...", cant)
+		props, err = fc.proposeDeals(ctx, c, r, cant)
+		if err != nil {
+			log.Errorf("making replacement deals for failed deals: %s", err)
+			fc.l.Log(ctx, c, "Making replacement deals for failed deals failed: %s", err)
+			break
+		}
+	}
+	if len(active) == 0 {
+		return nil, fmt.Errorf("all accepted proposals failed before becoming active")
 	}
-	return props[0], nil
+	return active, nil
 }
 
-func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, cfgs []deals.StorageDealConfig, waddr string, fcfg ffs.FilConfig) ([]ffs.FilStorage, error) {
+// makeDeals proposes deals with the configs provided, and returns the accepted
+// proposals and the miners that rejected or failed the proposal.
+func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, cfgs []deals.StorageDealConfig, waddr string, fcfg ffs.FilConfig) ([]ffs.FilProposal, []string, error) {
 	r := ipldToFileTransform(ctx, fc.dag, c)
 
 	for _, cfg := range cfgs {
@@ -183,33 +502,41 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, cfgs []deals.Storag
 	var sres []deals.StoreResult
 	dataCid, sres, err := fc.dm.Store(ctx, waddr, r, cfgs, uint64(fcfg.DealDuration), true)
 	if err != nil {
-		return nil, fmt.Errorf("storing deals in deal module: %s", err)
+		return nil, nil, fmt.Errorf("storing deals in deal module: %s", err)
 	}
 	if dataCid != c {
-		return nil, fmt.Errorf("stored data cid doesn't match with sent data")
+		return nil, nil, fmt.Errorf("stored data cid doesn't match with sent data")
 	}
 
-	proposals, err := fc.waitForDeals(ctx, c, sres, fcfg.DealDuration)
-	if err != nil {
-		return nil, fmt.Errorf("waiting for deals to finish: %s", err)
-	}
-	return proposals, nil
-}
-
-func (fc *FilCold) waitForDeals(ctx context.Context, c cid.Cid, storeResults []deals.StoreResult, duration int64) ([]ffs.FilStorage, error) {
-	notDone := make(map[cid.Cid]struct{})
-	var inProgressDeals []cid.Cid
-	for _, d := range storeResults {
+	var props []ffs.FilProposal
+	var failed []string
+	for _, d := range sres {
 		if !d.Success {
-			fc.l.Log(ctx, c, "Proposal with miner %s failed.", d.Config.Miner)
-			log.Warnf("failed store result")
+			fc.l.Log(ctx, c, "Proposal with miner %s failed: %s", d.Config.Miner, d.Message)
+			log.Warnf("failed store result with miner %s: %s", d.Config.Miner, d.Message)
+			failed = append(failed, d.Config.Miner)
 			continue
 		}
-		inProgressDeals = append(inProgressDeals, d.ProposalCid)
-		notDone[d.ProposalCid] = struct{}{}
+		props = append(props, ffs.FilProposal{
+			ProposalCid: d.ProposalCid,
+			Miner:       d.Config.Miner,
+			EpochPrice:  d.Config.EpochPrice,
+		})
 	}
-	if len(inProgressDeals) == 0 {
-		return nil, fmt.Errorf("all proposed deals where rejected")
+	return props, failed, nil
+}
+
+// waitForDeals waits for deal proposals of a Cid to become active on-chain or fail,
+// and returns the deals that became active and the miners of the failed ones.
+func (fc *FilCold) waitForDeals(ctx context.Context, c cid.Cid, props []ffs.FilProposal, duration int64) ([]ffs.FilStorage, []string, error) {
+	if len(props) == 0 {
+		return nil, nil, fmt.Errorf("there aren't proposals to wait for")
+	}
+	notDone := make(map[cid.Cid]ffs.FilProposal)
+	inProgressDeals := make([]cid.Cid, len(props))
+	for i, p := range props {
+		inProgressDeals[i] = p.ProposalCid
+		notDone[p.ProposalCid] = p
 	}
 
 	fc.l.Log(ctx, c, "Watching in-progress deals unfold...")
@@ -217,31 +544,26 @@ func (fc *FilCold) waitForDeals(ctx context.Context, c cid.Cid, storeResults []d
 	defer cancel()
 	chDi, err := fc.dm.Watch(ctx, inProgressDeals)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	activeProposals := make(map[cid.Cid]*ffs.FilStorage)
+	var failed []string
 	for di := range chDi {
 		log.Infof("watching pending %d deals unfold...", len(notDone))
-		if _, ok := notDone[di.ProposalCid]; !ok {
+		p, ok := notDone[di.ProposalCid]
+		if !ok {
 			continue
 		}
 		if di.StateID == storagemarket.StorageDealActive {
-			var d *deals.StoreResult
-			for _, dr := range storeResults {
-				if dr.ProposalCid == di.ProposalCid {
-					d = &dr
-					break
-				}
-			}
-			if d == nil {
-				return nil, fmt.Errorf("deal watcher return unasked proposal, this must never happen")
-			}
 			activeProposals[di.ProposalCid] = &ffs.FilStorage{
 				ProposalCid:     di.ProposalCid,
 				Duration:        duration,
-				Miner:           d.Config.Miner,
+				Miner:           p.Miner,
 				ActivationEpoch: di.ActivationEpoch,
+				EpochPrice:      p.EpochPrice,
+				PieceSize:       di.Size,
+				TotalCost:       p.EpochPrice * uint64(duration),
 			}
 			delete(notDone, di.ProposalCid)
 			fc.l.Log(ctx, c, "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
@@ -249,7 +571,8 @@ func (fc *FilCold) waitForDeals(ctx context.Context, c cid.Cid, storeResults []d
 			log.Errorf("deal %d failed with state %s", di.DealID, storagemarket.DealStates[di.StateID])
 			delete(activeProposals, di.ProposalCid)
 			delete(notDone, di.ProposalCid)
-			fc.l.Log(ctx, c, "Deal %d with miner %s failed and won't be active on-chain", di.DealID, di.Miner)
+			failed = append(failed, p.Miner)
+			fc.l.Log(ctx, c, "Deal %d with miner %s failed with state %s and won't be active on-chain", di.DealID, di.Miner, storagemarket.DealStates[di.StateID])
 		} else {
 			fc.l.Log(ctx, c, "Deal with miner %s changed state to %s", di.Miner, storagemarket.DealStates[di.StateID])
 		}
@@ -258,15 +581,17 @@ func (fc *FilCold) waitForDeals(ctx context.Context, c cid.Cid, storeResults []d
 		}
 	}
 
-	if len(activeProposals) == 0 {
-		return nil, fmt.Errorf("all accepted proposals failed before becoming active")
-	}
 	res := make([]ffs.FilStorage, 0, len(activeProposals))
 	for _, v := range activeProposals {
 		res = append(res, *v)
 	}
+	if ctx.Err() != nil {
+		// Deals that became active before cancellation are still
+		// returned, so they can be tracked.
+		return res, failed, fmt.Errorf("waiting for deals was canceled: %s", ctx.Err())
+	}
 	fc.l.Log(ctx, c, "Finished all in-progress deals reached final state.")
-	return res, nil
+	return res, failed, nil
 }
 
 func ipldToFileTransform(ctx context.Context, dag format.DAGService, c cid.Cid) io.Reader {
@@ -285,6 +610,23 @@ func ipldToFileTransform(ctx context.Context, dag format.DAGService, c cid.Cid)
 	return r
 }
 
+// newMinerSelectorFilter returns the filter for selecting miners considering
+// the configuration and the data size, if known.
+func newMinerSelectorFilter(cfg ffs.FilConfig, size int) ffs.MinerSelectorFilter {
+	f := ffs.MinerSelectorFilter{
+		ExcludedMiners: make([]string, len(cfg.ExcludedMiners)),
+		CountryCodes:   cfg.CountryCodes,
+		TrustedMiners:  cfg.TrustedMiners,
+		MaxPrice:       cfg.MaxPrice,
+		MinScore:       cfg.MinScore,
+	}
+	copy(f.ExcludedMiners, cfg.ExcludedMiners)
+	if size > 0 {
+		f.PieceSize = uint64(size)
+	}
+	return f
+}
+
 func makeDealConfigs(ctx context.Context, ms ffs.MinerSelector, cantMiners int, f ffs.MinerSelectorFilter) ([]deals.StorageDealConfig, error) {
 	mps, err := ms.GetMiners(cantMiners, f)
 	if err != nil {
@@ -296,3 +638,21 @@ func makeDealConfigs(ctx context.Context, ms ffs.MinerSelector, cantMiners int,
 	}
 	return res, nil
 }
+
+// filMiners returns the miners of the deals of a FilInfo.
+func filMiners(inf ffs.FilInfo) []string {
+	res := make([]string, 0, len(inf.Proposals))
+	for _, p := range inf.Proposals {
+		res = append(res, p.Miner)
+	}
+	return res
+}
+
+func containsMiner(miners []string, addr string) bool {
+	for _, m := range miners {
+		if m == addr {
+			return true
+		}
+	}
+	return false
+}
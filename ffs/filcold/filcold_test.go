@@ -0,0 +1,340 @@
+package filcold
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/deals"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/minerselector/fixed"
+)
+
+const waddr = "t3waddr"
+
+func TestRenewalStrategies(t *testing.T) {
+	t.Parallel()
+	// The renewed deal is with t01000. The selector prefers t01001,
+	// and t01002 is the cheapest miner.
+	miners := []fixed.Miner{
+		{Addr: "t01001", EpochPrice: 300},
+		{Addr: "t01000", EpochPrice: 200},
+		{Addr: "t01002", EpochPrice: 100},
+	}
+	tests := []struct {
+		name     string
+		strategy ffs.RenewStrategy
+		trusted  []string
+		want     string
+	}{
+		{name: "SameMiner", strategy: ffs.RenewSameMiner, want: "t01000"},
+		{name: "SameMinerNotTrusted", strategy: ffs.RenewSameMiner, trusted: []string{"t01001", "t01002"}, want: "t01001"},
+		{name: "NewMiner", strategy: ffs.RenewNewMiner, want: "t01001"},
+		{name: "Cheapest", strategy: ffs.RenewCheapest, want: "t01002"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newCid(t, tt.name)
+			fc, dm, _ := newFilCold(t, miners, c)
+			cfg := newRenewConfig(tt.strategy)
+			cfg.TrustedMiners = tt.trusted
+
+			inf, err := fc.EnsureRenewals(context.Background(), c, newFilInfo(t, c, "t01000", 200), waddr, cfg)
+			require.NoError(t, err)
+			require.Len(t, inf.Proposals, 2)
+			require.True(t, inf.Proposals[0].Renewed)
+			require.Equal(t, tt.want, inf.Proposals[1].Miner)
+			require.Equal(t, []string{tt.want}, dm.proposedMiners())
+		})
+	}
+}
+
+func TestRenewalPriceLimit(t *testing.T) {
+	t.Parallel()
+	// The renewed deal is with t01000 at 200 attoFIL per epoch,
+	// and the new miner candidates are more expensive.
+	miners := []fixed.Miner{
+		{Addr: "t01000", EpochPrice: 200},
+		{Addr: "t01001", EpochPrice: 300},
+		{Addr: "t01002", EpochPrice: 220},
+	}
+	tests := []struct {
+		name             string
+		maxPrice         uint64
+		maxPriceIncrease int
+		want             string
+	}{
+		{name: "NoLimit", want: "t01001"},
+		{name: "MaxPriceUnder", maxPrice: 250, want: "t01002"},
+		{name: "MaxPriceOver", maxPrice: 210},
+		{name: "MaxPriceIncreaseUnder", maxPriceIncrease: 10, want: "t01002"},
+		{name: "MaxPriceIncreaseOver", maxPriceIncrease: 5},
+		{name: "StricterLimitOver", maxPrice: 250, maxPriceIncrease: 5},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newCid(t, tt.name)
+			fc, dm, l := newFilCold(t, miners, c)
+			cfg := newRenewConfig(ffs.RenewNewMiner)
+			cfg.Renew.MaxPrice = tt.maxPrice
+			cfg.Renew.MaxPriceIncrease = tt.maxPriceIncrease
+
+			inf, err := fc.EnsureRenewals(context.Background(), c, newFilInfo(t, c, "t01000", 200), waddr, cfg)
+			require.NoError(t, err)
+			blocked := l.events(ffs.EventRenewalBlocked)
+			if tt.want == "" {
+				require.Len(t, inf.Proposals, 1)
+				require.False(t, inf.Proposals[0].Renewed)
+				require.Empty(t, dm.proposedMiners())
+				require.Len(t, blocked, 1)
+				require.Equal(t, c, blocked[0].Cid)
+				return
+			}
+			require.Len(t, inf.Proposals, 2)
+			require.True(t, inf.Proposals[0].Renewed)
+			require.Equal(t, tt.want, inf.Proposals[1].Miner)
+			require.Equal(t, []string{tt.want}, dm.proposedMiners())
+			require.Empty(t, blocked)
+		})
+	}
+}
+
+func TestPlanRenewals(t *testing.T) {
+	t.Parallel()
+	miners := []fixed.Miner{
+		{Addr: "t01000", EpochPrice: 200},
+		{Addr: "t01001", EpochPrice: 300},
+		{Addr: "t01002", EpochPrice: 220},
+	}
+	c := newCid(t, "TestPlanRenewals")
+	fc, dm, _ := newFilCold(t, miners, c)
+
+	t.Run("Due", func(t *testing.T) {
+		cfg := newRenewConfig(ffs.RenewCheapest)
+		mps, err := fc.PlanRenewals(context.Background(), newFilInfo(t, c, "t01000", 200), cfg)
+		require.NoError(t, err)
+		require.Equal(t, []ffs.MinerProposal{{Addr: "t01000", EpochPrice: 200}}, mps)
+	})
+	t.Run("NotDue", func(t *testing.T) {
+		inf := newFilInfo(t, c, "t01000", 200)
+		inf.Proposals[0].Duration = 2000
+		mps, err := fc.PlanRenewals(context.Background(), inf, newRenewConfig(ffs.RenewCheapest))
+		require.NoError(t, err)
+		require.Empty(t, mps)
+	})
+	t.Run("PriceExceeded", func(t *testing.T) {
+		cfg := newRenewConfig(ffs.RenewNewMiner)
+		cfg.Renew.MaxPrice = 210
+		mps, err := fc.PlanRenewals(context.Background(), newFilInfo(t, c, "t01000", 200), cfg)
+		require.NoError(t, err)
+		require.Empty(t, mps)
+	})
+	require.Empty(t, dm.proposedMiners())
+}
+
+func TestWaitForDealsReplacement(t *testing.T) {
+	t.Parallel()
+	miners := []fixed.Miner{
+		{Addr: "t01000", EpochPrice: 200},
+		{Addr: "t01001", EpochPrice: 300},
+		{Addr: "t01002", EpochPrice: 400},
+	}
+	c := newCid(t, "TestWaitForDealsReplacement")
+	fc, dm, _ := newFilCold(t, miners, c)
+	dm.failing = map[string]bool{"t01000": true}
+	cfg := ffs.FilConfig{RepFactor: 1, DealDuration: 1000}
+	props, err := fc.Store(context.Background(), c, 100, waddr, cfg)
+	require.NoError(t, err)
+	require.Len(t, props, 1)
+	require.Equal(t, "t01000", props[0].Miner)
+
+	// Proposals saved and loaded again, as when resuming after a restart
+	// with another FilCold, still know how to replace the failed deal.
+	buf, err := json.Marshal(props)
+	require.NoError(t, err)
+	var loaded []ffs.FilProposal
+	require.NoError(t, json.Unmarshal(buf, &loaded))
+	fc2, err := New(fixed.New(miners), dm, nil, fakeChain{height: 1000}, &fakeLogger{})
+	require.NoError(t, err)
+	inf, err := fc2.WaitForDeals(context.Background(), c, loaded, cfg.DealDuration)
+	require.NoError(t, err)
+	require.Len(t, inf.Proposals, 1)
+	require.Equal(t, "t01001", inf.Proposals[0].Miner)
+	require.Equal(t, []string{"t01000", "t01001"}, dm.proposedMiners())
+
+	// Proposals without a deal round aren't replaced.
+	dm.failing = map[string]bool{"t01000": true, "t01001": true}
+	loaded[0].Round = nil
+	_, err = fc2.WaitForDeals(context.Background(), c, loaded, cfg.DealDuration)
+	require.Error(t, err)
+	require.Len(t, dm.proposedMiners(), 2)
+}
+
+func newFilCold(t *testing.T, miners []fixed.Miner, c cid.Cid) (*FilCold, *fakeDeals, *fakeLogger) {
+	dm := &fakeDeals{dataCid: c}
+	l := &fakeLogger{}
+	fc, err := New(fixed.New(miners), dm, nil, fakeChain{height: 1000}, l)
+	require.NoError(t, err)
+	return fc, dm, l
+}
+
+// newRenewConfig returns a config whose deals expiring before
+// 100 epochs from now are renewed with the strategy.
+func newRenewConfig(strategy ffs.RenewStrategy) ffs.FilConfig {
+	return ffs.FilConfig{
+		RepFactor:    1,
+		DealDuration: 1000,
+		Renew: ffs.FilRenew{
+			Enabled:   true,
+			Threshold: 100,
+			Strategy:  strategy,
+		},
+	}
+}
+
+// newFilInfo returns a FilInfo with a single deal with
+// the miner, which is about to expire.
+func newFilInfo(t *testing.T, c cid.Cid, miner string, epochPrice uint64) ffs.FilInfo {
+	return ffs.FilInfo{
+		DataCid: c,
+		Size:    100,
+		Proposals: []ffs.FilStorage{{
+			ProposalCid:     newCid(t, "proposal-"+c.String()),
+			Miner:           miner,
+			EpochPrice:      epochPrice,
+			ActivationEpoch: 0,
+			Duration:        1000,
+		}},
+	}
+}
+
+func newCid(t *testing.T, data string) cid.Cid {
+	h, err := multihash.Sum([]byte(data), multihash.SHA2_256, -1)
+	require.NoError(t, err)
+	return cid.NewCidV1(cid.Raw, h)
+}
+
+type fakeChain struct {
+	height uint64
+}
+
+func (fc fakeChain) GetHeight(context.Context) (uint64, error) {
+	return fc.height, nil
+}
+
+// fakeDeals accepts every proposal, and every accepted
+// proposal becomes active unless its miner is failing.
+type fakeDeals struct {
+	dataCid cid.Cid
+	failing map[string]bool
+
+	lock     sync.Mutex
+	proposed []deals.StorageDealConfig
+	miners   map[cid.Cid]string
+}
+
+var _ DealModule = (*fakeDeals)(nil)
+
+func (d *fakeDeals) Store(ctx context.Context, waddr string, data io.Reader, cfgs []deals.StorageDealConfig, dur uint64, isCAR bool) (cid.Cid, []deals.StoreResult, error) {
+	// The data isn't needed, closing the reader stops generating it.
+	if c, ok := data.(io.Closer); ok {
+		_ = c.Close()
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	res := make([]deals.StoreResult, len(cfgs))
+	for i, cfg := range cfgs {
+		d.proposed = append(d.proposed, cfg)
+		h, err := multihash.Sum([]byte(fmt.Sprintf("%s-%d", cfg.Miner, len(d.proposed))), multihash.SHA2_256, -1)
+		if err != nil {
+			return cid.Undef, nil, err
+		}
+		res[i] = deals.StoreResult{
+			ProposalCid: cid.NewCidV1(cid.Raw, h),
+			Config:      cfg,
+			Success:     true,
+		}
+		if d.miners == nil {
+			d.miners = make(map[cid.Cid]string)
+		}
+		d.miners[res[i].ProposalCid] = cfg.Miner
+	}
+	return d.dataCid, res, nil
+}
+
+func (d *fakeDeals) Watch(ctx context.Context, proposals []cid.Cid, opts ...deals.WatchOption) (<-chan deals.DealInfo, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	ch := make(chan deals.DealInfo, len(proposals))
+	for _, p := range proposals {
+		state := storagemarket.StorageDealActive
+		if d.failing[d.miners[p]] {
+			state = storagemarket.StorageDealError
+		}
+		ch <- deals.DealInfo{ProposalCid: p, StateID: state, ActivationEpoch: 1000}
+	}
+	close(ch)
+	return ch, nil
+}
+
+func (d *fakeDeals) GetDealStatus(ctx context.Context, pcid cid.Cid) (storagemarket.StorageDealStatus, bool, error) {
+	return storagemarket.StorageDealActive, false, nil
+}
+
+func (d *fakeDeals) Retrieve(ctx context.Context, waddr string, c cid.Cid, exportCAR bool, opts ...deals.RetrieveOption) (io.ReadCloser, deals.RetrievalInfo, error) {
+	return nil, deals.RetrievalInfo{}, errors.New("retrieval isn't supported")
+}
+
+func (d *fakeDeals) proposedMiners() []string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	res := make([]string, len(d.proposed))
+	for i, cfg := range d.proposed {
+		res[i] = cfg.Miner
+	}
+	return res
+}
+
+// fakeLogger keeps logged entries in memory.
+type fakeLogger struct {
+	lock    sync.Mutex
+	entries []ffs.LogEntry
+}
+
+func (l *fakeLogger) Log(ctx context.Context, c cid.Cid, format string, a ...interface{}) {
+	event := ffs.EventNone
+	if e, ok := ctx.Value(ffs.CtxKeyEvent).(ffs.LogEvent); ok {
+		event = e
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.entries = append(l.entries, ffs.LogEntry{Cid: c, Msg: fmt.Sprintf(format, a...), Event: event})
+}
+
+func (l *fakeLogger) Watch(context.Context, chan<- ffs.LogEntry) error {
+	return nil
+}
+
+func (l *fakeLogger) events(e ffs.LogEvent) []ffs.LogEntry {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	var res []ffs.LogEntry
+	for _, le := range l.entries {
+		if le.Event == e {
+			res = append(res, le)
+		}
+	}
+	return res
+}
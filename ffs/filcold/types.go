@@ -0,0 +1,33 @@
+package filcold
+
+import "fmt"
+
+var (
+	defaultMaxReplacements = 3
+)
+
+// Config contains configuration for FilCold.
+type Config struct {
+	// MaxReplacements is the maximum number of replacement miners
+	// asked for when making deals for a Cid, if proposed miners
+	// reject or fail the deal proposal, or deals fail before
+	// becoming active.
+	MaxReplacements int
+}
+
+// Option sets values on a Config.
+type Option func(*Config) error
+
+// WithMaxReplacements indicates the maximum number of replacement
+// miners that will be asked for when making deals for a Cid, if
+// proposed miners reject or fail the deal proposal, or deals fail
+// before becoming active. Zero disables replacements.
+func WithMaxReplacements(maxReplacements int) Option {
+	return func(c *Config) error {
+		if maxReplacements < 0 {
+			return fmt.Errorf("max replacements can't be negative, got %d", maxReplacements)
+		}
+		c.MaxReplacements = maxReplacements
+		return nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-car"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
@@ -34,6 +35,7 @@ import (
 	"github.com/textileio/powergate/ffs/scheduler/astore"
 	"github.com/textileio/powergate/ffs/scheduler/cistore"
 	"github.com/textileio/powergate/ffs/scheduler/jstore"
+	"github.com/textileio/powergate/ffs/scheduler/sstore"
 	"github.com/textileio/powergate/tests"
 	txndstr "github.com/textileio/powergate/txndstransform"
 	"github.com/textileio/powergate/util"
@@ -155,6 +157,9 @@ func TestInfo(t *testing.T) {
 		require.NotEmpty(t, first.Wallet.Address)
 		require.Greater(t, first.Wallet.Balance, uint64(0))
 		require.Equal(t, len(first.Pins), 0)
+		require.Equal(t, 0, first.QueuedJobs)
+		require.Equal(t, 0, first.HotStorage.Size)
+		require.Equal(t, 0, first.HotStorage.NumBlocks)
 	})
 
 	r := rand.New(rand.NewSource(22))
@@ -174,9 +179,79 @@ func TestInfo(t *testing.T) {
 		require.Equal(t, second.Wallet.Address, first.Wallet.Address)
 		require.Less(t, second.Wallet.Balance, first.Wallet.Balance)
 		require.Equal(t, n, len(second.Pins))
+		require.Equal(t, 0, second.QueuedJobs)
+		require.Greater(t, second.HotStorage.Size, 0)
+		require.Greater(t, second.HotStorage.NumBlocks, 0)
 	})
 }
 
+func TestInstanceAddrs(t *testing.T) {
+	ctx := context.Background()
+	ipfs, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	initialAddr := fapi.WalletAddr()
+	addrs := fapi.Addrs()
+	require.Len(t, addrs, 1)
+	require.Equal(t, initialAddr, addrs[0].Addr)
+
+	newAddr, err := fapi.NewAddr(ctx, "my addr")
+	require.Nil(t, err)
+	_, err = fapi.NewAddr(ctx, "my addr")
+	require.NotNil(t, err)
+	addrs = fapi.Addrs()
+	require.Len(t, addrs, 2)
+	require.Equal(t, "my addr", addrs[1].Name)
+	require.Equal(t, newAddr, addrs[1].Addr)
+	require.Equal(t, initialAddr, fapi.WalletAddr())
+
+	t.Run("CidConfigAddr", func(t *testing.T) {
+		r := rand.New(rand.NewSource(22))
+		cid, _ := addRandomFile(t, r, ipfs)
+		config := fapi.GetDefaultCidConfig(cid).WithColdAddr("t3unknown")
+		_, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+		require.NotNil(t, err)
+
+		time.Sleep(time.Second * 5)
+		before, err := fapi.Info(ctx)
+		require.Nil(t, err)
+		config = fapi.GetDefaultCidConfig(cid).WithColdAddr(newAddr)
+		jid, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+		require.Nil(t, err)
+		requireJobState(t, fapi, jid, ffs.Success)
+		requireCidConfig(t, fapi, cid, &config)
+		after, err := fapi.Info(ctx)
+		require.Nil(t, err)
+		require.Equal(t, before.Wallet.Balance, after.Wallet.Balance)
+	})
+
+	t.Run("SetDefaultAddr", func(t *testing.T) {
+		err := fapi.SetDefaultAddr("t3unknown")
+		require.NotNil(t, err)
+		err = fapi.SetDefaultAddr(newAddr)
+		require.Nil(t, err)
+		require.Equal(t, newAddr, fapi.WalletAddr())
+	})
+}
+
+func TestSendFil(t *testing.T) {
+	ctx := context.Background()
+	_, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	newAddr, err := fapi.NewAddr(ctx, "my addr")
+	require.Nil(t, err)
+	time.Sleep(time.Second * 5)
+
+	_, err = fapi.SendFil(ctx, "t3unknown", newAddr, big.NewInt(100))
+	require.NotNil(t, err)
+
+	mcid, err := fapi.SendFil(ctx, fapi.WalletAddr(), newAddr, big.NewInt(100))
+	require.Nil(t, err)
+	err = fapi.WaitMessage(ctx, mcid)
+	require.Nil(t, err)
+}
+
 func TestShow(t *testing.T) {
 	ctx := context.Background()
 	ipfs, fapi, cls := newAPI(t, 1)
@@ -208,6 +283,7 @@ func TestShow(t *testing.T) {
 		require.True(t, s.Cid.Defined())
 		require.True(t, time.Now().After(s.Created))
 		require.Greater(t, s.Hot.Size, 0)
+		require.Greater(t, s.Hot.NumBlocks, 0)
 		require.NotNil(t, s.Hot.Ipfs)
 		require.True(t, time.Now().After(s.Hot.Ipfs.Created))
 		require.NotNil(t, s.Cold.Filecoin)
@@ -219,6 +295,153 @@ func TestShow(t *testing.T) {
 	})
 }
 
+func TestHotDagSize(t *testing.T) {
+	ctx := context.Background()
+	ipfs, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	r := rand.New(rand.NewSource(22))
+	size := 1024 * 1024
+	c, _ := addRandomFileSize(t, r, ipfs, size)
+	jid, err := fapi.PushConfig(c)
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid, ffs.Success)
+
+	s, err := fapi.Show(c)
+	require.Nil(t, err)
+	// The file is chunked in many blocks, so the DAG size should
+	// account for all of them and not only the root block.
+	require.Greater(t, s.Hot.Size, size)
+	require.Greater(t, s.Hot.NumBlocks, 1)
+
+	inf, err := fapi.Info(ctx)
+	require.Nil(t, err)
+	require.Equal(t, s.Hot.Size, inf.HotStorage.Size)
+	require.Equal(t, s.Hot.NumBlocks, inf.HotStorage.NumBlocks)
+}
+
+func TestQuotas(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	t.Run("Cids", func(t *testing.T) {
+		ipfs, fapi, cls := newAPI(t, 1)
+		defer cls()
+		err := fapi.SetQuotas(api.Quotas{MaxCids: 1})
+		require.Nil(t, err)
+
+		c1, _ := addRandomFile(t, r, ipfs)
+		jid, err := fapi.PushConfig(c1)
+		require.Nil(t, err)
+		requireJobState(t, fapi, jid, ffs.Success)
+
+		c2, _ := addRandomFile(t, r, ipfs)
+		_, err = fapi.PushConfig(c2)
+		qerr, ok := err.(*api.QuotaExceededError)
+		require.True(t, ok)
+		require.Equal(t, "cids", qerr.Quota)
+		require.NotNil(t, fapi.CanAddToHot())
+
+		// Pushing a new config for an already pushed Cid is allowed.
+		_, err = fapi.PushConfig(c1, api.WithOverride(true))
+		require.Nil(t, err)
+	})
+
+	t.Run("HotBytes", func(t *testing.T) {
+		ipfs, fapi, cls := newAPI(t, 1)
+		defer cls()
+		err := fapi.SetQuotas(api.Quotas{MaxHotBytes: 1})
+		require.Nil(t, err)
+
+		c1, _ := addRandomFile(t, r, ipfs)
+		jid, err := fapi.PushConfig(c1)
+		require.Nil(t, err)
+		requireJobState(t, fapi, jid, ffs.Success)
+		inf, err := fapi.Info(ctx)
+		require.Nil(t, err)
+		require.Greater(t, inf.Usage.HotBytes, 1)
+		require.Greater(t, inf.Usage.ColdBytes, 0)
+
+		c2, _ := addRandomFile(t, r, ipfs)
+		_, err = fapi.PushConfig(c2)
+		qerr, ok := err.(*api.QuotaExceededError)
+		require.True(t, ok)
+		require.Equal(t, "hot-bytes", qerr.Quota)
+		require.NotNil(t, fapi.CanAddToHot())
+	})
+
+	t.Run("Spend", func(t *testing.T) {
+		ipfs, fapi, cls := newAPI(t, 1)
+		defer cls()
+
+		c1, _ := addRandomFile(t, r, ipfs)
+		plan, err := fapi.PlanConfig(ctx, c1)
+		require.Nil(t, err)
+		require.Greater(t, plan.Cold.EstimatedCost, uint64(0))
+		err = fapi.SetQuotas(api.Quotas{MaxSpend: plan.Cold.EstimatedCost, SpendPeriod: time.Hour})
+		require.Nil(t, err)
+
+		jid, err := fapi.PushConfig(c1)
+		require.Nil(t, err)
+		requireJobState(t, fapi, jid, ffs.Success)
+		inf, err := fapi.Info(ctx)
+		require.Nil(t, err)
+		require.Greater(t, inf.Usage.Spent, uint64(0))
+		require.Equal(t, inf.TotalSpend, inf.Usage.Spent)
+
+		c2, _ := addRandomFile(t, r, ipfs)
+		_, err = fapi.PushConfig(c2)
+		qerr, ok := err.(*api.QuotaExceededError)
+		require.True(t, ok)
+		require.Equal(t, "spend", qerr.Quota)
+	})
+}
+
+func TestSpend(t *testing.T) {
+	ctx := context.Background()
+	ipfs, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	r := rand.New(rand.NewSource(22))
+	c, _ := addRandomFile(t, r, ipfs)
+	jid, err := fapi.PushConfig(c)
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid, ffs.Success)
+
+	s, err := fapi.Show(c)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(s.Cold.Filecoin.Proposals))
+	p := s.Cold.Filecoin.Proposals[0]
+	require.Greater(t, p.EpochPrice, uint64(0))
+	require.Greater(t, p.PieceSize, uint64(0))
+	require.Equal(t, p.EpochPrice*uint64(p.Duration), p.TotalCost)
+
+	t.Run("All", func(t *testing.T) {
+		report, err := fapi.Spend()
+		require.Nil(t, err)
+		require.Equal(t, p.TotalCost, report.Total)
+		require.Equal(t, p.TotalCost, report.ByCid[c])
+		require.Equal(t, p.TotalCost, report.ByMiner[p.Miner])
+		require.Equal(t, 1, len(report.ByDay))
+		require.Equal(t, 1, len(report.Records))
+		require.Equal(t, p.ProposalCid, report.Records[0].ProposalCid)
+
+		inf, err := fapi.Info(ctx)
+		require.Nil(t, err)
+		require.Equal(t, p.TotalCost, inf.TotalSpend)
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		report, err := fapi.Spend(api.WithSpendMiner("t0999"))
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), report.Total)
+		require.Equal(t, 0, len(report.Records))
+
+		report, err = fapi.Spend(api.WithSpendBetween(time.Now(), time.Time{}))
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), report.Total)
+	})
+}
+
 func TestColdInstanceLoad(t *testing.T) {
 	ctx := context.Background()
 	ipfsDocker, cls := tests.LaunchIPFSDocker()
@@ -258,6 +481,87 @@ func TestColdInstanceLoad(t *testing.T) {
 	require.True(t, bytes.Equal(data, fetched))
 }
 
+func TestResumeInterruptedJob(t *testing.T) {
+	ipfsDocker, cls := tests.LaunchIPFSDocker()
+	t.Cleanup(func() { cls() })
+
+	ds := tests.NewTxMapDatastore()
+	addr, client, ms := newDevnet(t, 1)
+
+	ipfsAPI, fapi, cls := newAPIFromDs(t, ds, ffs.EmptyInstanceID, client, addr, ms, ipfsDocker)
+	ra := rand.New(rand.NewSource(22))
+	cid, _ := addRandomFile(t, ra, ipfsAPI)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan ffs.LogEntry, 100)
+	go func() {
+		_ = fapi.WatchLogs(ctx, ch, cid)
+		close(ch)
+	}()
+	jid, err := fapi.PushConfig(cid)
+	require.Nil(t, err)
+	// Stop the Scheduler once deals were proposed, so
+	// the Job is interrupted while waiting for them.
+	for le := range ch {
+		if le.Msg == "Watching in-progress deals unfold..." {
+			break
+		}
+	}
+	cancel()
+	cls()
+
+	_, fapi, cls = newAPIFromDs(t, ds, fapi.ID(), client, addr, ms, ipfsDocker)
+	defer cls()
+	requireJobState(t, fapi, jid, ffs.Success)
+	requireCidConfig(t, fapi, cid, nil)
+
+	i, err := fapi.Show(cid)
+	require.Nil(t, err)
+	require.Equal(t, jid, i.JobID)
+	require.True(t, i.Hot.Enabled)
+	require.Equal(t, 1, len(i.Cold.Filecoin.Proposals))
+}
+
+func TestPlanConfig(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+	ipfsAPI, fapi, cls := newAPI(t, 2)
+	defer cls()
+
+	cid, _ := addRandomFile(t, r, ipfsAPI)
+	config := fapi.GetDefaultCidConfig(cid)
+	plan, err := fapi.PlanConfig(ctx, cid)
+	require.Nil(t, err)
+	require.Equal(t, cid, plan.Cid)
+	require.Equal(t, ffs.HotActionPin, plan.Hot.Action)
+	require.Equal(t, 1, plan.Cold.NewDeals)
+	require.Len(t, plan.Cold.Miners, 1)
+	require.Equal(t, config.Cold.Filecoin.DealDuration, plan.Cold.DealDuration)
+	require.Equal(t, plan.Cold.Miners[0].EpochPrice*uint64(plan.Cold.DealDuration), plan.Cold.EstimatedCost)
+
+	js, err := fapi.ListJobs()
+	require.Nil(t, err)
+	require.Len(t, js, 0)
+
+	jid, err := fapi.PushConfig(cid)
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid, ffs.Success)
+
+	_, err = fapi.PlanConfig(ctx, cid)
+	require.Equal(t, api.ErrMustOverrideConfig, err)
+
+	plan, err = fapi.PlanConfig(ctx, cid, api.WithOverride(true))
+	require.Nil(t, err)
+	require.Equal(t, ffs.HotActionNone, plan.Hot.Action)
+	require.Equal(t, 0, plan.Cold.NewDeals)
+
+	config = config.WithHotEnabled(false).WithColdFilRepFactor(2)
+	plan, err = fapi.PlanConfig(ctx, cid, api.WithCidConfig(config), api.WithOverride(true))
+	require.Nil(t, err)
+	require.Equal(t, ffs.HotActionRemove, plan.Hot.Action)
+	require.Equal(t, 1, plan.Cold.NewDeals)
+}
+
 func TestRepFactor(t *testing.T) {
 	rfs := []int{1, 2}
 	r := rand.New(rand.NewSource(22))
@@ -349,6 +653,128 @@ func TestHotTimeoutConfig(t *testing.T) {
 	})
 }
 
+func TestCancelJob(t *testing.T) {
+	_, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	// The Cid isn't available in the network, so the first Job
+	// stays in-progress trying to fetch it, and the second one
+	// stays queued waiting for the first one to finish.
+	cid, _ := cid.Decode("Qmc5gCcjYypU7y28oCALwfSvxCBskLuPKWpK4qpterKC7z")
+	config := fapi.GetDefaultCidConfig(cid).WithHotIpfsAddTimeout(100)
+	jid1, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+	require.Nil(t, err)
+	jid2, err := fapi.PushConfig(cid, api.WithCidConfig(config), api.WithOverride(true))
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan ffs.Job, 10)
+	go func() {
+		_ = fapi.WatchJobs(ctx, ch, jid1)
+		close(ch)
+	}()
+	for j := range ch {
+		if j.Status == ffs.InProgress {
+			break
+		}
+	}
+
+	require.Nil(t, fapi.CancelJob(jid2))
+	requireJobState(t, fapi, jid2, ffs.Canceled)
+
+	require.Nil(t, fapi.CancelJob(jid1))
+	requireJobState(t, fapi, jid1, ffs.Canceled)
+
+	i, err := fapi.Show(cid)
+	require.Nil(t, err)
+	require.Equal(t, jid1, i.JobID)
+	require.False(t, i.Hot.Enabled)
+
+	require.Equal(t, api.ErrNotFound, fapi.CancelJob(ffs.NewJobID()))
+}
+
+func TestRetryConfig(t *testing.T) {
+	_, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	// The Cid isn't available in the network, so every
+	// attempt fails in the Hot Storage.
+	cid, _ := cid.Decode("Qmc5gCcjYypU7y28oCALwfSvxCBskLuPKWpK4qpterKC7z")
+
+	t.Run("Retried", func(t *testing.T) {
+		config := fapi.GetDefaultCidConfig(cid).WithHotIpfsAddTimeout(1).WithRetry(3, 1, 2, ffs.FailureHotStorage)
+		jid, err := fapi.PushConfig(cid, api.WithCidConfig(config), api.WithOverride(true))
+		require.Nil(t, err)
+		j := requireJobState(t, fapi, jid, ffs.Failed)
+		require.Equal(t, 3, j.Attempts)
+		require.True(t, j.NextRetry.IsZero())
+	})
+	t.Run("NotRetriedClass", func(t *testing.T) {
+		config := fapi.GetDefaultCidConfig(cid).WithHotIpfsAddTimeout(1).WithRetry(3, 1, 0, ffs.FailureColdStorage)
+		jid, err := fapi.PushConfig(cid, api.WithCidConfig(config), api.WithOverride(true))
+		require.Nil(t, err)
+		j := requireJobState(t, fapi, jid, ffs.Failed)
+		require.Equal(t, 1, j.Attempts)
+	})
+}
+
+func TestListJobs(t *testing.T) {
+	r := rand.New(rand.NewSource(22))
+	ipfsAPI, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	cid1, _ := addRandomFile(t, r, ipfsAPI)
+	jid1, err := fapi.PushConfig(cid1)
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid1, ffs.Success)
+	cid2, _ := addRandomFile(t, r, ipfsAPI)
+	jid2, err := fapi.PushConfig(cid2)
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid2, ffs.Success)
+
+	t.Run("All", func(t *testing.T) {
+		js, err := fapi.ListJobs()
+		require.Nil(t, err)
+		require.Len(t, js, 2)
+		require.Equal(t, jid1, js[0].ID)
+		require.Equal(t, cid1, js[0].Cid)
+		require.Equal(t, jid2, js[1].ID)
+		for _, j := range js {
+			require.False(t, j.Created.IsZero())
+			require.False(t, j.Started.Before(j.Created))
+			require.False(t, j.Finished.Before(j.Started))
+		}
+	})
+	t.Run("ByCid", func(t *testing.T) {
+		js, err := fapi.ListJobs(api.WithJobsCid(cid2))
+		require.Nil(t, err)
+		require.Len(t, js, 1)
+		require.Equal(t, jid2, js[0].ID)
+	})
+	t.Run("ByStatus", func(t *testing.T) {
+		js, err := fapi.ListJobs(api.WithJobsStatus(ffs.Failed, ffs.Canceled))
+		require.Nil(t, err)
+		require.Len(t, js, 0)
+	})
+	t.Run("ByCreation", func(t *testing.T) {
+		all, err := fapi.ListJobs()
+		require.Nil(t, err)
+		js, err := fapi.ListJobs(api.WithJobsCreatedBetween(all[1].Created, time.Time{}))
+		require.Nil(t, err)
+		require.Len(t, js, 1)
+		require.Equal(t, jid2, js[0].ID)
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		js, err := fapi.ListJobs(api.WithJobsPage(1, 1))
+		require.Nil(t, err)
+		require.Len(t, js, 1)
+		require.Equal(t, jid2, js[0].ID)
+		_, err = fapi.ListJobs(api.WithJobsPage(-1, 0))
+		require.NotNil(t, err)
+	})
+}
+
 func TestDurationConfig(t *testing.T) {
 	ipfsAPI, fapi, cls := newAPI(t, 1)
 	defer cls()
@@ -422,6 +848,96 @@ func TestFilecoinCountryFilter(t *testing.T) {
 	require.Equal(t, p.Miner, "t01001")
 }
 
+func TestFilecoinMinerConstraints(t *testing.T) {
+	ipfsDocker, cls := tests.LaunchIPFSDocker()
+	t.Cleanup(func() { cls() })
+
+	numMiners := 3
+	client, addr, _ := tests.CreateLocalDevnet(t, numMiners)
+	fixedMiners := []fixed.Miner{
+		{Addr: "t01000", Country: "China", EpochPrice: 3000000, Score: 90},
+		{Addr: "t01001", Country: "China", EpochPrice: 1000000, Score: 10},
+		{Addr: "t01002", Country: "China", EpochPrice: 2000000, Score: 50, MinPieceSize: 1 << 30},
+	}
+	ms := fixed.New(fixedMiners)
+	ds := tests.NewTxMapDatastore()
+	ipfsAPI, fapi, closeInternal := newAPIFromDs(t, ds, ffs.EmptyInstanceID, client, addr, ms, ipfsDocker)
+	defer closeInternal()
+
+	r := rand.New(rand.NewSource(22))
+	tableTest := []struct {
+		Name     string
+		Config   func(ffs.CidConfig) ffs.CidConfig
+		Expected string
+	}{
+		{
+			Name:     "TrustedMiners",
+			Config:   func(c ffs.CidConfig) ffs.CidConfig { return c.WithColdFilTrustedMiners([]string{"t01001"}) },
+			Expected: "t01001",
+		},
+		{
+			Name:     "MaxPrice",
+			Config:   func(c ffs.CidConfig) ffs.CidConfig { return c.WithColdFilMaxPrice(1500000) },
+			Expected: "t01001",
+		},
+		{
+			// t01002 has a better score than t01001, but its minimum
+			// piece size is bigger than the data.
+			Name:     "MinScoreAndPieceSize",
+			Config:   func(c ffs.CidConfig) ffs.CidConfig { return c.WithColdFilMinScore(20).WithColdFilMaxPrice(2500000) },
+			Expected: "",
+		},
+		{
+			Name:     "MinScore",
+			Config:   func(c ffs.CidConfig) ffs.CidConfig { return c.WithColdFilMinScore(60) },
+			Expected: "t01000",
+		},
+	}
+	for _, tt := range tableTest {
+		t.Run(tt.Name, func(t *testing.T) {
+			c, _ := addRandomFile(t, r, ipfsAPI)
+			config := tt.Config(fapi.GetDefaultCidConfig(c))
+			jid, err := fapi.PushConfig(c, api.WithCidConfig(config))
+			require.Nil(t, err)
+			if tt.Expected == "" {
+				requireJobState(t, fapi, jid, ffs.Failed)
+				return
+			}
+			requireJobState(t, fapi, jid, ffs.Success)
+			cinfo, err := fapi.Show(c)
+			require.Nil(t, err)
+			require.Equal(t, tt.Expected, cinfo.Cold.Filecoin.Proposals[0].Miner)
+		})
+	}
+}
+
+func TestFilecoinReplacementMiners(t *testing.T) {
+	ipfsDocker, cls := tests.LaunchIPFSDocker()
+	t.Cleanup(func() { cls() })
+
+	client, addr, _ := tests.CreateLocalDevnet(t, 1)
+	// t0999 doesn't exist in the devnet, so the proposal fails
+	// and a replacement miner should be used.
+	fixedMiners := []fixed.Miner{
+		{Addr: "t0999", Country: "China", EpochPrice: 1000000},
+		{Addr: "t01000", Country: "China", EpochPrice: 1000000},
+	}
+	ms := fixed.New(fixedMiners)
+	ds := tests.NewTxMapDatastore()
+	ipfsAPI, fapi, closeInternal := newAPIFromDs(t, ds, ffs.EmptyInstanceID, client, addr, ms, ipfsDocker)
+	defer closeInternal()
+
+	r := rand.New(rand.NewSource(22))
+	c, _ := addRandomFile(t, r, ipfsAPI)
+	jid, err := fapi.PushConfig(c)
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid, ffs.Success)
+	cinfo, err := fapi.Show(c)
+	require.Nil(t, err)
+	require.Len(t, cinfo.Cold.Filecoin.Proposals, 1)
+	require.Equal(t, "t01000", cinfo.Cold.Filecoin.Proposals[0].Miner)
+}
+
 func TestFilecoinEnableConfig(t *testing.T) {
 	tableTest := []struct {
 		HotEnabled  bool
@@ -656,6 +1172,45 @@ func TestUnfreeze(t *testing.T) {
 	require.True(t, bytes.Equal(data, fetched))
 }
 
+func TestGetFromCold(t *testing.T) {
+	ipfsAPI, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	ra := rand.New(rand.NewSource(22))
+	ctx := context.Background()
+
+	t.Run("WithoutDeals", func(t *testing.T) {
+		cid, _ := addRandomFile(t, ra, ipfsAPI)
+		config := fapi.GetDefaultCidConfig(cid).WithColdEnabled(false)
+		jid, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+		require.Nil(t, err)
+		requireJobState(t, fapi, jid, ffs.Success)
+
+		_, err = fapi.GetFromCold(ctx, cid)
+		require.Equal(t, ffs.ErrColdStorageEmpty, err)
+	})
+
+	t.Run("WithDeals", func(t *testing.T) {
+		c, _ := addRandomFile(t, ra, ipfsAPI)
+		config := fapi.GetDefaultCidConfig(c).WithHotEnabled(false)
+		jid, err := fapi.PushConfig(c, api.WithCidConfig(config))
+		require.Nil(t, err)
+		requireJobState(t, fapi, jid, ffs.Success)
+
+		r, err := fapi.GetFromCold(ctx, c)
+		require.Nil(t, err)
+		defer func() { require.Nil(t, r.Close()) }()
+		cr, err := car.NewCarReader(r)
+		require.Nil(t, err)
+		require.Equal(t, []cid.Cid{c}, cr.Header.Roots)
+
+		// Hot Storage state didn't change.
+		i, err := fapi.Show(c)
+		require.Nil(t, err)
+		require.False(t, i.Hot.Enabled)
+	})
+}
+
 func TestRenew(t *testing.T) {
 	// ToDo: unskip when testnet/3  allows more than one deal
 	// See https://bit.ly/2JxQSQk
@@ -769,6 +1324,57 @@ Loop:
 	}
 }
 
+func TestRepair(t *testing.T) {
+	// ToDo: unskip when testnet/3  allows more than one deal
+	// See https://bit.ly/2JxQSQk
+	t.SkipNow()
+	util.AvgBlockTime = time.Millisecond * 200
+	ipfsDocker, cls := tests.LaunchIPFSDocker()
+	t.Cleanup(func() { cls() })
+	ds := tests.NewTxMapDatastore()
+	addr, client, ms := newDevnet(t, 2)
+	ipfsAPI, fapi, closeInternal := newAPIFromDs(t, ds, ffs.EmptyInstanceID, client, addr, ms, ipfsDocker)
+	defer closeInternal()
+
+	ra := rand.New(rand.NewSource(22))
+	cid, _ := addRandomFile(t, ra, ipfsAPI)
+
+	// Deals aren't renewed, so when the first one expires the
+	// repair logic should make a new one with another miner.
+	config := fapi.GetDefaultCidConfig(cid).WithColdFilDealDuration(int64(200)).WithColdFilRepair(true)
+	jid, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+	require.Nil(t, err)
+	requireJobState(t, fapi, jid, ffs.Success)
+	requireCidConfig(t, fapi, cid, &config)
+
+	i, err := fapi.Show(cid)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(i.Cold.Filecoin.Proposals))
+	firstDeal := i.Cold.Filecoin.Proposals[0]
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	lchain := lotuschain.New(client)
+Loop:
+	for range ticker.C {
+		i, err := fapi.Show(cid)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(i.Cold.Filecoin.Proposals))
+
+		h, err := lchain.GetHeight(context.Background())
+		require.Nil(t, err)
+		if firstDeal.ActivationEpoch+firstDeal.Duration+int64(100) > int64(h) {
+			continue
+		}
+
+		newDeal := i.Cold.Filecoin.Proposals[0]
+		require.NotEqual(t, firstDeal.ProposalCid, newDeal.ProposalCid)
+		require.NotEqual(t, firstDeal.Miner, newDeal.Miner)
+		require.Equal(t, config.Cold.Filecoin.DealDuration, newDeal.Duration)
+		break Loop
+	}
+}
+
 func TestCidLogger(t *testing.T) {
 	t.Run("WithNoFilters", func(t *testing.T) {
 		ipfs, fapi, cls := newAPI(t, 1)
@@ -919,6 +1525,57 @@ func TestPushCidReplace(t *testing.T) {
 	requireFilUnstored(ctx, t, client, c2)
 }
 
+func TestSharedCidRefs(t *testing.T) {
+	ctx := context.Background()
+	ipfsDocker, cls := tests.LaunchIPFSDocker()
+	t.Cleanup(func() { cls() })
+	ds := tests.NewTxMapDatastore()
+	addr, client, ms := newDevnet(t, 1)
+	ipfsAPI, sched, wm, closeSched := newSchedulerFromDs(t, ds, client, addr, ms, ipfsDocker)
+	defer closeSched()
+	fapi1 := newInstanceFromDs(t, ds, ffs.EmptyInstanceID, sched, wm)
+	defer func() { require.Nil(t, fapi1.Close()) }()
+	fapi2 := newInstanceFromDs(t, ds, ffs.EmptyInstanceID, sched, wm)
+	defer func() { require.Nil(t, fapi2.Close()) }()
+
+	r := rand.New(rand.NewSource(22))
+	cid, _ := addRandomFile(t, r, ipfsAPI)
+	config := fapi1.GetDefaultCidConfig(cid).WithColdEnabled(false)
+	jid, err := fapi1.PushConfig(cid, api.WithCidConfig(config))
+	require.Nil(t, err)
+	requireJobState(t, fapi1, jid, ffs.Success)
+	jid, err = fapi2.PushConfig(cid, api.WithCidConfig(config))
+	require.Nil(t, err)
+	requireJobState(t, fapi2, jid, ffs.Success)
+
+	i, err := fapi1.Show(cid)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []ffs.APIID{fapi1.ID(), fapi2.ID()}, i.Refs.Hot)
+	require.Empty(t, i.Refs.Cold)
+
+	// The first instance disables the Hot Storage, but the
+	// second one still needs it, so it stays pinned.
+	config = config.WithHotEnabled(false)
+	jid, err = fapi1.PushConfig(cid, api.WithCidConfig(config), api.WithOverride(true))
+	require.Nil(t, err)
+	requireJobState(t, fapi1, jid, ffs.Success)
+	requireIpfsPinnedCid(ctx, t, cid, ipfsAPI)
+	i, err = fapi1.Show(cid)
+	require.Nil(t, err)
+	require.True(t, i.Hot.Enabled)
+	require.Equal(t, []ffs.APIID{fapi2.ID()}, i.Refs.Hot)
+
+	// The last reference goes away, so the Cid is unpinned.
+	jid, err = fapi2.PushConfig(cid, api.WithCidConfig(config), api.WithOverride(true))
+	require.Nil(t, err)
+	requireJobState(t, fapi2, jid, ffs.Success)
+	requireIpfsUnpinnedCid(ctx, t, cid, ipfsAPI)
+	i, err = fapi2.Show(cid)
+	require.Nil(t, err)
+	require.False(t, i.Hot.Enabled)
+	require.Empty(t, i.Refs.Hot)
+}
+
 func TestRemove(t *testing.T) {
 	ipfs, fapi, cls := newAPI(t, 1)
 	defer cls()
@@ -946,6 +1603,92 @@ func TestRemove(t *testing.T) {
 	require.Equal(t, api.ErrNotFound, err)
 }
 
+func TestParallelExecution(t *testing.T) {
+	ipfs, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	r := rand.New(rand.NewSource(22))
+	n := 3
+	cids := make([]cid.Cid, n)
+	jids := make([]ffs.JobID, n)
+	for i := 0; i < n; i++ {
+		cid, _ := addRandomFile(t, r, ipfs)
+		config := fapi.GetDefaultCidConfig(cid).WithColdEnabled(false)
+		jid, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+		require.Nil(t, err)
+		cids[i] = cid
+		jids[i] = jid
+	}
+	for i := 0; i < n; i++ {
+		requireJobState(t, fapi, jids[i], ffs.Success)
+		requireCidConfig(t, fapi, cids[i], nil)
+	}
+}
+
+func TestJobPriority(t *testing.T) {
+	ipfs, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	r := rand.New(rand.NewSource(22))
+	priorities := []int{0, 5, -1}
+	jids := make([]ffs.JobID, len(priorities))
+	for i, p := range priorities {
+		cid, _ := addRandomFile(t, r, ipfs)
+		config := fapi.GetDefaultCidConfig(cid).WithColdEnabled(false)
+		jid, err := fapi.PushConfig(cid, api.WithCidConfig(config), api.WithPriority(p))
+		require.Nil(t, err)
+		jids[i] = jid
+	}
+	for i := range jids {
+		j := requireJobState(t, fapi, jids[i], ffs.Success)
+		require.Equal(t, priorities[i], j.Priority)
+	}
+}
+
+func TestSameCidSerialExecution(t *testing.T) {
+	ctx := context.Background()
+	ipfs, fapi, cls := newAPI(t, 1)
+	defer cls()
+
+	// Watch all Jobs of the instance before pushing, so no state
+	// transition is missed.
+	ch := make(chan ffs.Job, 10)
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		_ = fapi.WatchJobs(wctx, ch)
+		close(ch)
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	r := rand.New(rand.NewSource(22))
+	cid, _ := addRandomFile(t, r, ipfs)
+	config := fapi.GetDefaultCidConfig(cid).WithColdEnabled(false)
+	jid1, err := fapi.PushConfig(cid, api.WithCidConfig(config))
+	require.Nil(t, err)
+	config2 := config.WithHotEnabled(false)
+	jid2, err := fapi.PushConfig(cid, api.WithCidConfig(config2), api.WithOverride(true))
+	require.Nil(t, err)
+
+	// The second Job can only start executing after the first one finished.
+	firstFinished := false
+	for j := range ch {
+		if j.ID == jid1 && (j.Status == ffs.Success || j.Status == ffs.Failed) {
+			require.Equal(t, ffs.Success, j.Status, j.ErrCause)
+			firstFinished = true
+		}
+		if j.ID == jid2 && j.Status == ffs.InProgress {
+			require.True(t, firstFinished, "second job started before the first one finished")
+		}
+		if j.ID == jid2 && (j.Status == ffs.Success || j.Status == ffs.Failed) {
+			require.Equal(t, ffs.Success, j.Status, j.ErrCause)
+			cancel()
+		}
+	}
+	requireCidConfig(t, fapi, cid, &config2)
+	requireIpfsUnpinnedCid(ctx, t, cid, ipfs)
+}
+
 func newAPI(t *testing.T, numMiners int) (*httpapi.HttpApi, *api.API, func()) {
 	ipfsDocker, cls := tests.LaunchIPFSDocker()
 	t.Cleanup(func() { cls() })
@@ -973,7 +1716,19 @@ func newDevnet(t *testing.T, numMiners int) (address.Address, *apistruct.FullNod
 }
 
 func newAPIFromDs(t *testing.T, ds datastore.TxnDatastore, iid ffs.APIID, client *apistruct.FullNodeStruct, waddr address.Address, ms ffs.MinerSelector, ipfsDocker *dockertest.Resource) (*httpapi.HttpApi, *api.API, func()) {
-	ctx := context.Background()
+	ipfsClient, sched, wm, closeSched := newSchedulerFromDs(t, ds, client, waddr, ms, ipfsDocker)
+	fapi := newInstanceFromDs(t, ds, iid, sched, wm)
+	time.Sleep(time.Second * 2)
+
+	return ipfsClient, fapi, func() {
+		if err := fapi.Close(); err != nil {
+			t.Fatalf("closing api: %s", err)
+		}
+		closeSched()
+	}
+}
+
+func newSchedulerFromDs(t *testing.T, ds datastore.TxnDatastore, client *apistruct.FullNodeStruct, waddr address.Address, ms ffs.MinerSelector, ipfsDocker *dockertest.Resource) (*httpapi.HttpApi, *scheduler.Scheduler, *wallet.Module, func()) {
 	ipfsAddr := util.MustParseAddr("/ip4/127.0.0.1/tcp/" + ipfsDocker.GetPort("5001/tcp"))
 	ipfsClient, err := httpapi.NewApi(ipfsAddr)
 	require.Nil(t, err)
@@ -983,17 +1738,36 @@ func newAPIFromDs(t *testing.T, ds datastore.TxnDatastore, iid ffs.APIID, client
 
 	fchain := lotuschain.New(client)
 	l := cidlogger.New(txndstr.Wrap(ds, "ffs/scheduler/logger"))
-	cl := filcold.New(ms, dm, ipfsClient.Dag(), fchain, l)
+	cl, err := filcold.New(ms, dm, ipfsClient.Dag(), fchain, l)
+	require.Nil(t, err)
 	cis := cistore.New(txndstr.Wrap(ds, "ffs/scheduler/cistore"))
+	ss := sstore.New(txndstr.Wrap(ds, "ffs/scheduler/sstore"))
 	as := astore.New(txndstr.Wrap(ds, "ffs/scheduler/astore"))
 	js := jstore.New(txndstr.Wrap(ds, "ffs/scheduler/jstore"))
 	hl := coreipfs.New(ipfsClient, l)
-	sched := scheduler.New(js, as, cis, l, hl, cl)
+	sched, err := scheduler.New(js, as, cis, ss, l, hl, cl)
+	require.Nil(t, err)
 
 	wm, err := wallet.New(client, &waddr, *big.NewInt(4000000000))
 	require.Nil(t, err)
 
+	return ipfsClient, sched, wm, func() {
+		if err := sched.Close(); err != nil {
+			t.Fatalf("closing scheduler: %s", err)
+		}
+		if err := js.Close(); err != nil {
+			t.Fatalf("closing jobstore: %s", err)
+		}
+		if err := l.Close(); err != nil {
+			t.Fatalf("closing cidlogger: %s", err)
+		}
+	}
+}
+
+func newInstanceFromDs(t *testing.T, ds datastore.TxnDatastore, iid ffs.APIID, sched *scheduler.Scheduler, wm *wallet.Module) *api.API {
+	ctx := context.Background()
 	var fapi *api.API
+	var err error
 	if iid == ffs.EmptyInstanceID {
 		iid = ffs.NewAPIID()
 		is := istore.New(iid, txndstr.Wrap(ds, "ffs/api/istore"))
@@ -1021,22 +1795,7 @@ func newAPIFromDs(t *testing.T, ds datastore.TxnDatastore, iid ffs.APIID, client
 		fapi, err = api.Load(iid, is, sched, wm)
 		require.Nil(t, err)
 	}
-	time.Sleep(time.Second * 2)
-
-	return ipfsClient, fapi, func() {
-		if err := fapi.Close(); err != nil {
-			t.Fatalf("closing api: %s", err)
-		}
-		if err := sched.Close(); err != nil {
-			t.Fatalf("closing scheduler: %s", err)
-		}
-		if err := js.Close(); err != nil {
-			t.Fatalf("closing jobstore: %s", err)
-		}
-		if err := l.Close(); err != nil {
-			t.Fatalf("closing cidlogger: %s", err)
-		}
-	}
+	return fapi
 }
 
 func requireJobState(t *testing.T, fapi *api.API, jid ffs.JobID, status ffs.JobStatus) ffs.Job {
@@ -1088,7 +1847,12 @@ func randomBytes(r *rand.Rand, size int) []byte {
 
 func addRandomFile(t *testing.T, r *rand.Rand, ipfs *httpapi.HttpApi) (cid.Cid, []byte) {
 	t.Helper()
-	data := randomBytes(r, 600)
+	return addRandomFileSize(t, r, ipfs, 600)
+}
+
+func addRandomFileSize(t *testing.T, r *rand.Rand, ipfs *httpapi.HttpApi, size int) (cid.Cid, []byte) {
+	t.Helper()
+	data := randomBytes(r, size)
 	node, err := ipfs.Unixfs().Add(context.Background(), ipfsfiles.NewReaderFile(bytes.NewReader(data)), options.Unixfs.Pin(false))
 	if err != nil {
 		t.Fatalf("error adding random file: %s", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"math/big"
 
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-car"
@@ -16,6 +17,26 @@ type WalletManager interface {
 	NewAddress(context.Context, string) (string, error)
 	// Balance returns the current balance for an address.
 	Balance(context.Context, string) (uint64, error)
+	// SendFil sends attoFIL from an address to another, and returns
+	// the message Cid.
+	SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error)
+	// Fund sends attoFIL from the master address to an address, and
+	// returns the message Cid.
+	Fund(ctx context.Context, to string, amount *big.Int) (cid.Cid, error)
+	// WaitMessage waits for a message to land on chain, and returns
+	// an error if its execution failed.
+	WaitMessage(ctx context.Context, mcid cid.Cid) error
+}
+
+// FundsKeeper ensures that wallet addresses of instances have enough
+// funds to pay for new deals.
+type FundsKeeper interface {
+	// EnsureFunds ensures that an address of an instance has at least an
+	// amount of attoFIL besides the funds already reserved, topping it up if
+	// possible. The amount is reserved until the returned func is called. It
+	// returns ErrInsufficientFunds if the balance isn't enough and can't be
+	// topped-up.
+	EnsureFunds(ctx context.Context, iid APIID, addr string, amount uint64) (func(), error)
 }
 
 var (
@@ -23,13 +44,25 @@ var (
 	// To retrieve the data, is necessary to call unfreeze by enabling the Enabled flag in
 	// the Hot Storage for that Cid.
 	ErrHotStorageDisabled = errors.New("cid disabled in hot storage")
+
+	// ErrInsufficientFunds returned when a wallet address doesn't have enough
+	// funds to pay for new deals, and can't be topped-up.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrColdStorageEmpty returned when trying to fetch a Cid from the Cold Storage
+	// without active deals.
+	ErrColdStorageEmpty = errors.New("cid without active deals in cold storage")
 )
 
 // Scheduler enforces a CidConfig orchestrating Hot and Cold storages.
 type Scheduler interface {
-	// PushConfig push a new or modified configuration for a Cid. It returns
+	// PushConfig push a new or modified configuration for a Cid with a
+	// priority relative to other Jobs of the same instance. It returns
 	// the JobID which tracks the current state of execution of that task.
-	PushConfig(APIID, string, CidConfig) (JobID, error)
+	PushConfig(APIID, string, CidConfig, int) (JobID, error)
+
+	// PlanConfig returns what pushing a configuration for a Cid by an
+	// instance would do, without creating a Job.
+	PlanConfig(context.Context, APIID, CidConfig) (StoragePlan, error)
 
 	// PushReplace push a new or modified configuration for a Cid, replacing
 	// an existing one. The replaced Cid will be unstored from the Hot Storage.
@@ -40,6 +73,11 @@ type Scheduler interface {
 	// from CidConfig which is the *desired* state.
 	GetCidInfo(cid.Cid) (CidInfo, error)
 
+	// GetCidFromCold returns a CAR stream with the Cid data retrieved from the
+	// Cold Storage using an account address. It doesn't change the Cid storage
+	// state. If the Cid hasn't active deals, it errors with ErrColdStorageEmpty.
+	GetCidFromCold(context.Context, cid.Cid, string) (io.ReadCloser, error)
+
 	// GetCidFromHot returns an Reader with the Cid data. If the data isn't in the Hot
 	// Storage, it errors with ErrHotStorageDisabled.
 	GetCidFromHot(context.Context, cid.Cid) (io.Reader, error)
@@ -47,6 +85,20 @@ type Scheduler interface {
 	// GetJob gets the a Job.
 	GetJob(JobID) (Job, error)
 
+	// CancelJob cancels a queued or in-progress Job.
+	CancelJob(JobID) error
+
+	// ListJobs returns Jobs selected by a filter, ordered by
+	// creation time.
+	ListJobs(JobFilter) ([]Job, error)
+
+	// ListSpends returns the costs of active deals selected by a
+	// filter, ordered by time.
+	ListSpends(SpendFilter) ([]SpendRecord, error)
+
+	// GetSpendTotals returns the running spend totals of an instance.
+	GetSpendTotals(APIID) (SpendTotals, error)
+
 	// WatchJobs is a blocking method that sends to a channel state updates
 	// for all Jobs created by an Instance. The ctx should be canceled when
 	// to stop receiving updates.
@@ -58,8 +110,8 @@ type Scheduler interface {
 	WatchLogs(context.Context, chan<- LogEntry) error
 
 	//Untrack marks a Cid to be untracked for any background processes such as
-	// deal renewal, or repairing.
-	Untrack(cid.Cid) error
+	// deal renewal, or repairing, for an instance.
+	Untrack(APIID, cid.Cid) error
 }
 
 // HotStorage is a fast storage layer for Cid data.
@@ -75,12 +127,19 @@ type HotStorage interface {
 
 	// Store stores a Cid. If the data wasn't previously Added,
 	// depending on the implementation it may use internal mechanisms
-	// for pulling the data, e.g: IPFS network
-	Store(context.Context, cid.Cid) (int, error)
+	// for pulling the data, e.g: IPFS network. It returns the size
+	// of the whole stored DAG.
+	Store(context.Context, cid.Cid) (DagStat, error)
 
 	// Replace replaces a stored Cid with a new one. It's mostly
-	// thought for mutating data doing this efficiently.
-	Replace(context.Context, cid.Cid, cid.Cid) (int, error)
+	// thought for mutating data doing this efficiently. It returns
+	// the size of the whole new stored DAG.
+	Replace(context.Context, cid.Cid, cid.Cid) (DagStat, error)
+
+	// Stat returns the size of the whole DAG of a Cid. If the Cid
+	// isn't stored, depending on the implementation it may use
+	// internal mechanisms for pulling the data.
+	Stat(context.Context, cid.Cid) (DagStat, error)
 
 	// Put adds a raw block.
 	Put(context.Context, blocks.Block) error
@@ -93,18 +152,40 @@ type HotStorage interface {
 // ColdStorage is slow/cheap storage for Cid data. It has
 // native support for Filecoin storage.
 type ColdStorage interface {
-	// Store stores a Cid using the provided configuration and
-	// account address.
-	Store(context.Context, cid.Cid, string, FilConfig) (FilInfo, error)
+	// Store makes deal proposals for a Cid using the provided configuration
+	// and account address. The size of the data in bytes, if known, avoids
+	// selecting miners that wouldn't accept it. It returns the proposals accepted
+	// by miners, which should be watched with WaitForDeals.
+	Store(context.Context, cid.Cid, int, string, FilConfig) ([]FilProposal, error)
+
+	// PlanDeals returns the miners that would be proposed deals by Store
+	// using the provided data size and configuration, without making any deal.
+	PlanDeals(context.Context, int, FilConfig) ([]MinerProposal, error)
+
+	// WaitForDeals blocks until deal proposals of a Cid become active on-chain
+	// or fail, using the provided deal duration. Failed proposals are replaced
+	// using their deal round, so it doesn't depend on state kept since Store.
+	// If it fails or gets canceled, the returned FilInfo contains the deals
+	// that became active anyway.
+	WaitForDeals(context.Context, cid.Cid, []FilProposal, int64) (FilInfo, error)
 
 	// Retrieve retrieves the data using an account address,
 	// and store it in a CAR store.
 	Retrieve(context.Context, cid.Cid, car.Store, string) (cid.Cid, error)
 
+	// RetrieveCAR retrieves the data of a Cid stored under a data Cid
+	// using an account address, and returns it as a CAR stream.
+	RetrieveCAR(context.Context, cid.Cid, cid.Cid, string) (io.ReadCloser, error)
+
 	// EnsureRenewals executes renewal logic for a Cid under a particular
 	// configuration.
 	EnsureRenewals(context.Context, cid.Cid, FilInfo, string, FilConfig) (FilInfo, error)
 
+	// PlanRenewals returns the miners that would be proposed deals by
+	// EnsureRenewals for a FilInfo under a particular configuration,
+	// without making any deal.
+	PlanRenewals(context.Context, FilInfo, FilConfig) ([]MinerProposal, error)
+
 	// IsFIlDealActive returns true if the proposal Cid is active on chain;
 	// returns false otherwise.
 	IsFilDealActive(context.Context, cid.Cid) (bool, error)
@@ -127,6 +208,22 @@ type MinerSelectorFilter struct {
 	// CountryCodes contains long-ISO country names that should be
 	// considered in selected miners. An empty list means no filtering.
 	CountryCodes []string
+	// TrustedMiners contains the only miner names that should be
+	// considered in returned results. An empty list means no filtering.
+	TrustedMiners []string
+	// MaxPrice is the maximum epoch price of selected miners storage
+	// asks. Zero means no filtering.
+	MaxPrice uint64
+	// MinScore is the minimum reputation score of selected miners.
+	// Zero means no filtering.
+	MinScore int
+	// PieceSize is the size in bytes of the data to be stored. Miners
+	// with a storage ask minimum piece size bigger than it shouldn't be
+	// selected. Zero means it's unknown, so no filtering.
+	PieceSize uint64
+	// Cheapest indicates that the cheapest miners that satisfy the
+	// filters should be selected, instead of the most desirable ones.
+	Cheapest bool
 }
 
 // MinerProposal contains a miners address and storage ask information
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
+	"github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/namespace"
 	logging "github.com/ipfs/go-log/v2"
@@ -37,18 +39,35 @@ type Manager struct {
 	auth      *auth.Auth
 	instances map[ffs.APIID]*api.API
 
-	closed bool
+	topUpLock sync.Mutex
+
+	fundsLock sync.Mutex
+	addrLocks map[string]chan struct{}
+	reserved  map[string]uint64
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+	closed   bool
 }
 
 // New returns a new Manager.
 func New(ds ds.Datastore, wm ffs.WalletManager, sched ffs.Scheduler) (*Manager, error) {
-	return &Manager{
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
 		auth:      auth.New(ds),
 		ds:        ds,
 		wm:        wm,
 		sched:     sched,
 		instances: make(map[ffs.APIID]*api.API),
-	}, nil
+		addrLocks: make(map[string]chan struct{}),
+		reserved:  make(map[string]uint64),
+		ctx:       ctx,
+		cancel:    cancel,
+		finished:  make(chan struct{}),
+	}
+	go m.runTopUpWatcher()
+	return m, nil
 }
 
 // Create creates a new Api instance and an auth-token mapped to it.
@@ -104,10 +123,61 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 		return nil, ErrAuthTokenNotFound
 	}
 
+	return m.getInstance(iid)
+}
+
+// SetQuotas sets the quotas of an existing instance.
+func (m *Manager) SetQuotas(iid ffs.APIID, q api.Quotas) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	i, err := m.getInstance(iid)
+	if err != nil {
+		return err
+	}
+	if err := i.SetQuotas(q); err != nil {
+		return fmt.Errorf("setting quotas of instance %s: %s", iid, err)
+	}
+	return nil
+}
+
+// Fund sends amount attoFIL from the master address to an address of an
+// existing instance, e.g: to top-up its balance. If addr is empty, the
+// instance default address is funded. It returns the message Cid.
+func (m *Manager) Fund(ctx context.Context, iid ffs.APIID, addr string, amount *big.Int) (cid.Cid, error) {
+	m.lock.Lock()
+	i, err := m.getInstance(iid)
+	m.lock.Unlock()
+	if err != nil {
+		return cid.Undef, err
+	}
+	if addr == "" {
+		addr = i.WalletAddr()
+	}
+	managed := false
+	for _, a := range i.Addrs() {
+		if a.Addr == addr {
+			managed = true
+			break
+		}
+	}
+	if !managed {
+		return cid.Undef, fmt.Errorf("%s isn't an address of instance %s", addr, iid)
+	}
+	mcid, err := m.wm.Fund(ctx, addr, amount)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("funding %s: %s", addr, err)
+	}
+	log.Infof("funded %s of instance %s with %s attoFIL in message %s", addr, iid, amount, mcid)
+	return mcid, nil
+}
+
+func (m *Manager) getInstance(iid ffs.APIID) (*api.API, error) {
 	i, ok := m.instances[iid]
 	if !ok {
 		log.Infof("loading uncached instance %s", iid)
 		is := istore.New(iid, namespace.Wrap(m.ds, istoreNamespace))
+		var err error
 		i, err = api.Load(iid, is, m.sched, m.wm)
 		if err != nil {
 			return nil, fmt.Errorf("loading instance %s: %s", iid, err)
@@ -116,22 +186,27 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 	} else {
 		log.Infof("using cached instance %s", iid)
 	}
-
 	return i, nil
 }
 
 // Close closes a Manager and consequently all loaded instances.
 func (m *Manager) Close() error {
 	m.lock.Lock()
-	defer m.lock.Unlock()
 	if m.closed {
+		m.lock.Unlock()
 		return nil
 	}
+	m.closed = true
+	m.lock.Unlock()
+	m.cancel()
+	<-m.finished
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
 	for _, i := range m.instances {
 		if err := i.Close(); err != nil {
 			log.Errorf("closing instance %s: %s", i.ID(), err)
 		}
 	}
-	m.closed = true
 	return nil
 }
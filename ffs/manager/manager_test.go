@@ -6,12 +6,14 @@ import (
 	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/api"
 	"github.com/textileio/powergate/tests"
 	"github.com/textileio/powergate/wallet"
 )
@@ -71,6 +73,139 @@ func TestGetByAuthToken(t *testing.T) {
 	})
 }
 
+func TestSetQuotas(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	ctx := context.Background()
+	m, cls := newManager(t, ds)
+	defer cls()
+	id, auth, err := m.Create(ctx)
+	require.Nil(t, err)
+
+	q := api.Quotas{
+		MaxHotBytes: 1000,
+		MaxCids:     10,
+		MaxSpend:    100,
+		SpendPeriod: time.Hour,
+	}
+	err = m.SetQuotas(id, q)
+	require.Nil(t, err)
+
+	t.Run("Hot", func(t *testing.T) {
+		i, err := m.GetByAuthToken(auth)
+		require.Nil(t, err)
+		info, err := i.Info(ctx)
+		require.Nil(t, err)
+		require.Equal(t, q, info.Quotas)
+	})
+	t.Run("Cold", func(t *testing.T) {
+		m, cls := newManager(t, ds)
+		defer cls()
+		i, err := m.GetByAuthToken(auth)
+		require.Nil(t, err)
+		info, err := i.Info(ctx)
+		require.Nil(t, err)
+		require.Equal(t, q, info.Quotas)
+	})
+	t.Run("Negative", func(t *testing.T) {
+		err := m.SetQuotas(id, api.Quotas{MaxCids: -1})
+		require.NotNil(t, err)
+	})
+}
+
+func TestFund(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	ctx := context.Background()
+	m, cls := newManager(t, ds)
+	defer cls()
+	id, auth, err := m.Create(ctx)
+	require.Nil(t, err)
+	i, err := m.GetByAuthToken(auth)
+	require.Nil(t, err)
+
+	amount := big.NewInt(1000)
+	mcid, err := m.Fund(ctx, id, "", amount)
+	require.Nil(t, err)
+	require.True(t, mcid.Defined())
+	err = i.WaitMessage(ctx, mcid)
+	require.Nil(t, err)
+	info, err := i.Info(ctx)
+	require.Nil(t, err)
+	require.GreaterOrEqual(t, info.Wallet.Balance, amount.Uint64())
+
+	t.Run("UnmanagedAddr", func(t *testing.T) {
+		_, err := m.Fund(ctx, id, "t3unknown", amount)
+		require.NotNil(t, err)
+	})
+}
+
+func TestTopUpPolicy(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	ctx := context.Background()
+	m, cls := newManager(t, ds)
+	defer cls()
+	id, auth, err := m.Create(ctx)
+	require.Nil(t, err)
+	i, err := m.GetByAuthToken(auth)
+	require.Nil(t, err)
+	addr := i.WalletAddr()
+	// Wait for the initial funds of the instance to land on chain.
+	var balance uint64
+	require.Eventually(t, func() bool {
+		info, err := i.Info(ctx)
+		require.Nil(t, err)
+		balance = info.Wallet.Balance
+		return balance > 0
+	}, time.Minute, time.Second)
+
+	t.Run("Disabled", func(t *testing.T) {
+		_, err := m.EnsureFunds(ctx, id, addr, balance+1000000)
+		require.Equal(t, ffs.ErrInsufficientFunds, err)
+	})
+	t.Run("EnoughBalance", func(t *testing.T) {
+		release, err := m.EnsureFunds(ctx, id, addr, 0)
+		require.Nil(t, err)
+		release()
+	})
+	t.Run("Reserved", func(t *testing.T) {
+		release, err := m.EnsureFunds(ctx, id, addr, balance)
+		require.Nil(t, err)
+		_, err = m.EnsureFunds(ctx, id, addr, 1)
+		require.Equal(t, ffs.ErrInsufficientFunds, err)
+		release()
+		release2, err := m.EnsureFunds(ctx, id, addr, 1)
+		require.Nil(t, err)
+		release2()
+	})
+
+	p := TopUpPolicy{Threshold: 100, Amount: 1000, DailyCap: 2000}
+	err = m.SetTopUpPolicy(id, p)
+	require.Nil(t, err)
+
+	t.Run("TopUp", func(t *testing.T) {
+		release, err := m.EnsureFunds(ctx, id, addr, balance+1000)
+		require.Nil(t, err)
+		defer release()
+		rp, topUps, err := m.GetTopUpPolicy(id)
+		require.Nil(t, err)
+		require.Equal(t, p, rp)
+		require.Len(t, topUps, 1)
+		require.Equal(t, addr, topUps[0].Addr)
+		require.Equal(t, uint64(1000), topUps[0].Amount)
+		require.True(t, topUps[0].MessageCid.Defined())
+	})
+	t.Run("DailyCap", func(t *testing.T) {
+		_, err := m.EnsureFunds(ctx, id, addr, balance+1000000)
+		require.Equal(t, ffs.ErrInsufficientFunds, err)
+	})
+	t.Run("NonExistant", func(t *testing.T) {
+		err := m.SetTopUpPolicy(ffs.NewAPIID(), p)
+		require.NotNil(t, err)
+	})
+}
+
 func newManager(t *testing.T, ds datastore.TxnDatastore) (*Manager, func()) {
 	client, addr, _ := tests.CreateLocalDevnet(t, 1)
 	wm, err := wallet.New(client, &addr, *big.NewInt(4000000000))
@@ -87,18 +222,36 @@ type mockSched struct{}
 
 var _ ffs.Scheduler = (*mockSched)(nil)
 
-func (ms *mockSched) PushConfig(_ ffs.APIID, _ string, _ ffs.CidConfig) (ffs.JobID, error) {
+func (ms *mockSched) PushConfig(_ ffs.APIID, _ string, _ ffs.CidConfig, _ int) (ffs.JobID, error) {
 	return ffs.NewJobID(), nil
 }
+func (ms *mockSched) PlanConfig(_ context.Context, _ ffs.APIID, _ ffs.CidConfig) (ffs.StoragePlan, error) {
+	return ffs.StoragePlan{}, nil
+}
 func (ms *mockSched) PushReplace(_ ffs.APIID, _ string, _ ffs.CidConfig, _ cid.Cid) (ffs.JobID, error) {
 	return ffs.NewJobID(), nil
 }
+func (ms *mockSched) GetCidFromCold(_ context.Context, _ cid.Cid, _ string) (io.ReadCloser, error) {
+	return nil, nil
+}
 func (ms *mockSched) GetCidFromHot(_ context.Context, _ cid.Cid) (io.Reader, error) {
 	return nil, nil
 }
 func (ms *mockSched) GetJob(_ ffs.JobID) (ffs.Job, error) {
 	return ffs.Job{}, nil
 }
+func (ms *mockSched) CancelJob(_ ffs.JobID) error {
+	return nil
+}
+func (ms *mockSched) ListJobs(_ ffs.JobFilter) ([]ffs.Job, error) {
+	return nil, nil
+}
+func (ms *mockSched) ListSpends(_ ffs.SpendFilter) ([]ffs.SpendRecord, error) {
+	return nil, nil
+}
+func (ms *mockSched) GetSpendTotals(_ ffs.APIID) (ffs.SpendTotals, error) {
+	return ffs.SpendTotals{}, nil
+}
 func (ms *mockSched) WatchJobs(_ context.Context, _ chan<- ffs.Job, _ ffs.APIID) error {
 	return nil
 }
@@ -108,6 +261,6 @@ func (ms *mockSched) WatchLogs(_ context.Context, _ chan<- ffs.LogEntry) error {
 func (ms *mockSched) GetCidInfo(_ cid.Cid) (ffs.CidInfo, error) {
 	return ffs.CidInfo{}, nil
 }
-func (ms *mockSched) Untrack(_ cid.Cid) error {
+func (ms *mockSched) Untrack(_ ffs.APIID, _ cid.Cid) error {
 	return nil
 }
@@ -0,0 +1,437 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/ffs"
+)
+
+var (
+	topUpNamespace = ds.NewKey("topup")
+
+	topUpCheckInterval = time.Minute
+	topUpCapPeriod     = time.Hour * 24
+	// topUpPendingWindow is the time after a top-up in which the address
+	// isn't checked again, so the top-up message can land on chain.
+	topUpPendingWindow = time.Minute * 5
+)
+
+var _ ffs.FundsKeeper = (*Manager)(nil)
+
+// TopUpPolicy configures the automatic top-up of the wallet addresses of an
+// instance from the master address.
+type TopUpPolicy struct {
+	// Threshold is the balance in attoFIL below which an address of the
+	// instance is topped-up. The default address is also topped-up while
+	// its balance is below the deal spend of the instance in the last 24
+	// hours, which is the expected spend of the next ones.
+	Threshold uint64
+	// Amount is the attoFIL sent in each top-up. Zero disables top-ups.
+	Amount uint64
+	// DailyCap is the maximum attoFIL sent to the instance addresses
+	// in the last 24 hours. Zero means there's no cap.
+	DailyCap uint64
+}
+
+// TopUp is a transfer from the master address to an instance address.
+type TopUp struct {
+	Addr       string
+	Amount     uint64
+	Time       time.Time
+	MessageCid cid.Cid
+}
+
+type topUpState struct {
+	Policy TopUpPolicy
+	TopUps []TopUp
+}
+
+// SetTopUpPolicy sets the automatic top-up policy of an existing instance.
+func (m *Manager) SetTopUpPolicy(iid ffs.APIID, p TopUpPolicy) error {
+	m.lock.Lock()
+	_, err := m.getInstance(iid)
+	m.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	m.topUpLock.Lock()
+	defer m.topUpLock.Unlock()
+	st, err := m.getTopUpState(iid)
+	if err != nil {
+		return err
+	}
+	st.Policy = p
+	if err := m.putTopUpState(iid, st); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTopUpPolicy returns the automatic top-up policy of an instance, and the
+// top-ups made in the last 24 hours.
+func (m *Manager) GetTopUpPolicy(iid ffs.APIID) (TopUpPolicy, []TopUp, error) {
+	m.topUpLock.Lock()
+	defer m.topUpLock.Unlock()
+	st, err := m.getTopUpState(iid)
+	if err != nil {
+		return TopUpPolicy{}, nil, err
+	}
+	return st.Policy, st.TopUps, nil
+}
+
+// EnsureFunds ensures that addr of instance iid has at least amount attoFIL
+// besides the funds reserved for deals in progress, topping it up from the
+// master address following the instance policy. It waits for the top-up to
+// land on chain. If the balance isn't enough and it can't be topped up, it
+// returns ffs.ErrInsufficientFunds. Otherwise, amount is reserved until the
+// returned release func is called, so concurrent calls for addr don't count
+// the same funds twice.
+func (m *Manager) EnsureFunds(ctx context.Context, iid ffs.APIID, addr string, amount uint64) (func(), error) {
+	if err := m.lockAddr(ctx, addr); err != nil {
+		return nil, err
+	}
+	defer m.unlockAddr(addr)
+
+	needed := m.reservedFunds(addr) + amount
+	mcid, err := m.topUpIfNeeded(ctx, iid, addr, needed)
+	if err != nil {
+		return nil, err
+	}
+	if mcid.Defined() {
+		if err := m.wm.WaitMessage(ctx, mcid); err != nil {
+			return nil, fmt.Errorf("waiting for top-up message: %s", err)
+		}
+	}
+
+	m.fundsLock.Lock()
+	m.reserved[addr] += amount
+	m.fundsLock.Unlock()
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			m.fundsLock.Lock()
+			defer m.fundsLock.Unlock()
+			m.reserved[addr] -= amount
+			if m.reserved[addr] == 0 {
+				delete(m.reserved, addr)
+			}
+		})
+	}
+	return release, nil
+}
+
+// topUpIfNeeded tops-up addr if its balance is below amount, and returns
+// the top-up message Cid. If no top-up is needed, the Cid is undefined.
+// It should be called while holding the addr lock.
+func (m *Manager) topUpIfNeeded(ctx context.Context, iid ffs.APIID, addr string, amount uint64) (cid.Cid, error) {
+	balance, err := m.wm.Balance(ctx, addr)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("getting balance of %s: %s", addr, err)
+	}
+	if balance >= amount {
+		return cid.Undef, nil
+	}
+	p, _, err := m.GetTopUpPolicy(iid)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if p.Amount == 0 {
+		log.Warnf("%s of instance %s has %d attoFIL of %d needed, and top-ups are disabled", addr, iid, balance, amount)
+		return cid.Undef, ffs.ErrInsufficientFunds
+	}
+	mcid, err := m.topUp(ctx, iid, addr, topUpAmount(p, balance, amount))
+	if err != nil {
+		log.Warnf("topping-up %s of instance %s: %s", addr, iid, err)
+		return cid.Undef, ffs.ErrInsufficientFunds
+	}
+	return mcid, nil
+}
+
+// runTopUpWatcher is a long running job that periodically tops-up the
+// instance addresses with a balance below their expected spend.
+func (m *Manager) runTopUpWatcher() {
+	defer close(m.finished)
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("graceful shutdown of top-up watcher")
+			return
+		case <-time.After(topUpCheckInterval):
+			if err := m.checkTopUps(m.ctx); err != nil {
+				log.Errorf("checking top-ups: %s", err)
+			}
+		}
+	}
+}
+
+func (m *Manager) checkTopUps(ctx context.Context) error {
+	q := query.Query{Prefix: topUpNamespace.String()}
+	res, err := m.ds.Query(q)
+	if err != nil {
+		return fmt.Errorf("querying top-up policies: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing top-up policies query: %s", err)
+		}
+	}()
+	var iids []ffs.APIID
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iterating top-up policies: %s", r.Error)
+		}
+		iids = append(iids, ffs.APIID(ds.RawKey(r.Key).BaseNamespace()))
+	}
+	for _, iid := range iids {
+		if err := m.checkInstanceTopUps(ctx, iid); err != nil {
+			log.Errorf("checking top-ups of instance %s: %s", iid, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) checkInstanceTopUps(ctx context.Context, iid ffs.APIID) error {
+	m.lock.Lock()
+	i, err := m.getInstance(iid)
+	m.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	p, topUps, err := m.GetTopUpPolicy(iid)
+	if err != nil {
+		return err
+	}
+	if p.Amount == 0 {
+		return nil
+	}
+	spend, err := m.expectedSpend(iid)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+AddrLoop:
+	for _, a := range i.Addrs() {
+		for _, t := range topUps {
+			if t.Addr == a.Addr && now.Sub(t.Time) < topUpPendingWindow {
+				continue AddrLoop
+			}
+		}
+		// Addresses being checked by EnsureFunds are
+		// topped-up there if needed.
+		if !m.tryLockAddr(a.Addr) {
+			continue
+		}
+		// Spends aren't recorded by address, so the expected spend is
+		// attributed to the default address, which pays for deals of
+		// Cids without a configured address.
+		threshold := p.Threshold
+		if a.Addr == i.WalletAddr() && spend > threshold {
+			threshold = spend
+		}
+		threshold += m.reservedFunds(a.Addr)
+		err := m.topUpBelowThreshold(ctx, iid, a.Addr, p, threshold)
+		m.unlockAddr(a.Addr)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// topUpBelowThreshold tops-up addr if its balance is below threshold. It
+// should be called while holding the addr lock.
+func (m *Manager) topUpBelowThreshold(ctx context.Context, iid ffs.APIID, addr string, p TopUpPolicy, threshold uint64) error {
+	balance, err := m.wm.Balance(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("getting balance of %s: %s", addr, err)
+	}
+	if balance >= threshold {
+		return nil
+	}
+	if _, err := m.topUp(ctx, iid, addr, topUpAmount(p, balance, threshold)); err != nil {
+		log.Warnf("topping-up %s of instance %s with balance %d: %s", addr, iid, balance, err)
+	}
+	return nil
+}
+
+// expectedSpend returns the attoFIL committed to deals by the instance in
+// the last top-up cap period, as the expected spend of the next one.
+func (m *Manager) expectedSpend(iid ffs.APIID) (uint64, error) {
+	spends, err := m.sched.ListSpends(ffs.SpendFilter{APIID: iid, From: time.Now().Add(-topUpCapPeriod)})
+	if err != nil {
+		return 0, fmt.Errorf("listing spends of instance %s: %s", iid, err)
+	}
+	var total uint64
+	for _, s := range spends {
+		total += s.Amount
+	}
+	return total, nil
+}
+
+// topUp sends amount attoFIL from the master address to addr, if the daily
+// cap of the instance allows it, and records the transfer. The transfer is
+// recorded before sending it, so concurrent top-ups respect the daily cap
+// without holding topUpLock while talking to the Lotus node.
+func (m *Manager) topUp(ctx context.Context, iid ffs.APIID, addr string, amount uint64) (cid.Cid, error) {
+	t, err := m.reserveTopUp(iid, addr, amount)
+	if err != nil {
+		return cid.Undef, err
+	}
+	mcid, err := m.wm.Fund(ctx, addr, new(big.Int).SetUint64(amount))
+	if err != nil {
+		if err := m.completeTopUp(iid, t, cid.Undef); err != nil {
+			log.Errorf("removing failed top-up of %s: %s", addr, err)
+		}
+		return cid.Undef, fmt.Errorf("funding from master address: %s", err)
+	}
+	log.Infof("topped-up %s of instance %s with %d attoFIL in message %s", addr, iid, amount, mcid)
+	if err := m.completeTopUp(iid, t, mcid); err != nil {
+		return cid.Undef, err
+	}
+	return mcid, nil
+}
+
+// reserveTopUp records a top-up of amount attoFIL to addr, if the daily cap
+// of the instance allows it. The recorded top-up doesn't have a message Cid
+// until completeTopUp is called.
+func (m *Manager) reserveTopUp(iid ffs.APIID, addr string, amount uint64) (TopUp, error) {
+	m.topUpLock.Lock()
+	defer m.topUpLock.Unlock()
+	st, err := m.getTopUpState(iid)
+	if err != nil {
+		return TopUp{}, err
+	}
+	now := time.Now()
+	var sent uint64
+	recent := make([]TopUp, 0, len(st.TopUps))
+	for _, t := range st.TopUps {
+		if now.Sub(t.Time) < topUpCapPeriod {
+			recent = append(recent, t)
+			sent += t.Amount
+		}
+	}
+	st.TopUps = recent
+	if st.Policy.DailyCap > 0 && sent+amount > st.Policy.DailyCap {
+		return TopUp{}, fmt.Errorf("daily cap of %d attoFIL reached, %d already sent", st.Policy.DailyCap, sent)
+	}
+	t := TopUp{Addr: addr, Amount: amount, Time: now}
+	st.TopUps = append(st.TopUps, t)
+	if err := m.putTopUpState(iid, st); err != nil {
+		return TopUp{}, err
+	}
+	return t, nil
+}
+
+// completeTopUp sets the message Cid of a top-up recorded with reserveTopUp.
+// If mcid is undefined, the top-up failed and its record is removed.
+func (m *Manager) completeTopUp(iid ffs.APIID, t TopUp, mcid cid.Cid) error {
+	m.topUpLock.Lock()
+	defer m.topUpLock.Unlock()
+	st, err := m.getTopUpState(iid)
+	if err != nil {
+		return err
+	}
+	for j, rt := range st.TopUps {
+		if rt.Addr != t.Addr || !rt.Time.Equal(t.Time) {
+			continue
+		}
+		if mcid.Defined() {
+			st.TopUps[j].MessageCid = mcid
+		} else {
+			st.TopUps = append(st.TopUps[:j], st.TopUps[j+1:]...)
+		}
+		break
+	}
+	return m.putTopUpState(iid, st)
+}
+
+// topUpAmount returns the attoFIL to send to an address with balance so it
+// has at least needed, considering the policy top-up amount.
+func topUpAmount(p TopUpPolicy, balance, needed uint64) uint64 {
+	if missing := needed - balance; missing > p.Amount {
+		return missing
+	}
+	return p.Amount
+}
+
+// lockAddr serializes funds checks and top-ups of addr. It waits until addr
+// is unlocked or ctx is canceled.
+func (m *Manager) lockAddr(ctx context.Context, addr string) error {
+	select {
+	case m.addrLock(addr) <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tryLockAddr locks addr if it isn't locked, and returns true if it did.
+func (m *Manager) tryLockAddr(addr string) bool {
+	select {
+	case m.addrLock(addr) <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m *Manager) unlockAddr(addr string) {
+	<-m.addrLock(addr)
+}
+
+func (m *Manager) addrLock(addr string) chan struct{} {
+	m.fundsLock.Lock()
+	defer m.fundsLock.Unlock()
+	l, ok := m.addrLocks[addr]
+	if !ok {
+		l = make(chan struct{}, 1)
+		m.addrLocks[addr] = l
+	}
+	return l
+}
+
+// reservedFunds returns the attoFIL of addr reserved for deals in progress.
+func (m *Manager) reservedFunds(addr string) uint64 {
+	m.fundsLock.Lock()
+	defer m.fundsLock.Unlock()
+	return m.reserved[addr]
+}
+
+func (m *Manager) getTopUpState(iid ffs.APIID) (topUpState, error) {
+	buf, err := m.ds.Get(makeTopUpKey(iid))
+	if err == ds.ErrNotFound {
+		return topUpState{}, nil
+	}
+	if err != nil {
+		return topUpState{}, fmt.Errorf("getting top-up state from datastore: %s", err)
+	}
+	var st topUpState
+	if err := json.Unmarshal(buf, &st); err != nil {
+		return topUpState{}, fmt.Errorf("unmarshaling top-up state: %s", err)
+	}
+	return st, nil
+}
+
+func (m *Manager) putTopUpState(iid ffs.APIID, st topUpState) error {
+	buf, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("marshaling top-up state: %s", err)
+	}
+	if err := m.ds.Put(makeTopUpKey(iid), buf); err != nil {
+		return fmt.Errorf("saving top-up state in datastore: %s", err)
+	}
+	return nil
+}
+
+func makeTopUpKey(iid ffs.APIID) ds.Key {
+	return topUpNamespace.ChildString(iid.String())
+}
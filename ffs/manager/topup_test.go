@@ -0,0 +1,132 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/tests"
+)
+
+func TestEnsureFundsReservations(t *testing.T) {
+	t.Parallel()
+	wm := newWalletManager()
+	m, err := New(tests.NewTxMapDatastore(), wm, nil)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, m.Close()) }()
+	iid := ffs.NewAPIID()
+	require.NoError(t, m.putTopUpState(iid, topUpState{Policy: TopUpPolicy{Amount: 100, DailyCap: 300}}))
+	wm.setBalance("t3addr", 1000)
+
+	// Both calls fit in the balance on their own, but not together,
+	// so the missing funds should be topped-up once.
+	var wg sync.WaitGroup
+	releases := make([]func(), 2)
+	errs := make([]error, 2)
+	for j := range releases {
+		j := j
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			releases[j], errs[j] = m.EnsureFunds(context.Background(), iid, "t3addr", 600)
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	balance, err := wm.Balance(context.Background(), "t3addr")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1200), balance)
+	_, topUps, err := m.GetTopUpPolicy(iid)
+	require.NoError(t, err)
+	require.Len(t, topUps, 1)
+	require.Equal(t, uint64(200), topUps[0].Amount)
+	require.True(t, topUps[0].MessageCid.Defined())
+
+	// The daily cap doesn't allow topping-up 200 attoFIL more.
+	_, err = m.EnsureFunds(context.Background(), iid, "t3addr", 200)
+	require.Equal(t, ffs.ErrInsufficientFunds, err)
+
+	// Released funds can be reserved again.
+	releases[0]()
+	releases[0]()
+	release, err := m.EnsureFunds(context.Background(), iid, "t3addr", 600)
+	require.NoError(t, err)
+	release()
+	releases[1]()
+	require.Zero(t, m.reservedFunds("t3addr"))
+}
+
+// walletManager is an in-memory ffs.WalletManager. Funds sent
+// are credited when their message is waited for.
+type walletManager struct {
+	lock     sync.Mutex
+	balances map[string]uint64
+	pending  map[cid.Cid]pendingTransfer
+	sent     int
+}
+
+type pendingTransfer struct {
+	to     string
+	amount uint64
+}
+
+var _ ffs.WalletManager = (*walletManager)(nil)
+
+func newWalletManager() *walletManager {
+	return &walletManager{
+		balances: make(map[string]uint64),
+		pending:  make(map[cid.Cid]pendingTransfer),
+	}
+}
+
+func (wm *walletManager) setBalance(addr string, balance uint64) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	wm.balances[addr] = balance
+}
+
+func (wm *walletManager) NewAddress(context.Context, string) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
+
+func (wm *walletManager) Balance(_ context.Context, addr string) (uint64, error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	return wm.balances[addr], nil
+}
+
+func (wm *walletManager) SendFil(context.Context, string, string, *big.Int) (cid.Cid, error) {
+	return cid.Undef, fmt.Errorf("not implemented")
+}
+
+func (wm *walletManager) Fund(_ context.Context, to string, amount *big.Int) (cid.Cid, error) {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	wm.sent++
+	h, err := multihash.Sum([]byte(fmt.Sprintf("%s-%d", to, wm.sent)), multihash.SHA2_256, -1)
+	if err != nil {
+		return cid.Undef, err
+	}
+	mcid := cid.NewCidV1(cid.Raw, h)
+	wm.pending[mcid] = pendingTransfer{to: to, amount: amount.Uint64()}
+	return mcid, nil
+}
+
+func (wm *walletManager) WaitMessage(_ context.Context, mcid cid.Cid) error {
+	wm.lock.Lock()
+	defer wm.lock.Unlock()
+	t, ok := wm.pending[mcid]
+	if !ok {
+		return fmt.Errorf("message %s not found", mcid)
+	}
+	wm.balances[t.to] += t.amount
+	delete(wm.pending, mcid)
+	return nil
+}
@@ -2,6 +2,7 @@ package fixed
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/textileio/powergate/ffs"
 )
@@ -14,9 +15,13 @@ type MinerSelector struct {
 
 // Miner contains miner information.
 type Miner struct {
-	Addr       string
-	Country    string
-	EpochPrice uint64
+	Addr         string
+	Country      string
+	EpochPrice   uint64
+	MinPieceSize uint64
+	// Score is the reputation score of the miner, considered
+	// for a minimum score filter.
+	Score int
 }
 
 var _ ffs.MinerSelector = (*MinerSelector)(nil)
@@ -57,15 +62,44 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 				continue
 			}
 		}
+		if len(f.TrustedMiners) != 0 {
+			skip := true
+			for _, t := range f.TrustedMiners {
+				if t == m.Addr {
+					skip = false
+					break
+				}
+			}
+			if skip {
+				continue
+			}
+		}
+		if f.MaxPrice > 0 && m.EpochPrice > f.MaxPrice {
+			continue
+		}
+		if m.Score < f.MinScore {
+			continue
+		}
+		if f.PieceSize > 0 && m.MinPieceSize > f.PieceSize {
+			continue
+		}
 		res = append(res, ffs.MinerProposal{
 			Addr:       m.Addr,
 			EpochPrice: m.EpochPrice,
 		})
 
-		if len(res) == n {
+		if !f.Cheapest && len(res) == n {
 			break
 		}
 	}
+	if f.Cheapest {
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].EpochPrice < res[j].EpochPrice
+		})
+		if len(res) > n {
+			res = res[:n]
+		}
+	}
 	if len(res) != n {
 		return nil, fmt.Errorf("not enough fixed miners to provide, want %d, got %d", n, len(res))
 	}
@@ -0,0 +1,51 @@
+package fixed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+)
+
+func TestGetMiners(t *testing.T) {
+	t.Parallel()
+	ms := New([]Miner{
+		{Addr: "t01000", Country: "China", EpochPrice: 300, Score: 90},
+		{Addr: "t01001", Country: "Uruguay", EpochPrice: 100, Score: 80},
+		{Addr: "t01002", Country: "China", EpochPrice: 200, Score: 70},
+		{Addr: "t01003", Country: "China", EpochPrice: 50, Score: 60, MinPieceSize: 1 << 30},
+	})
+
+	tests := []struct {
+		name   string
+		n      int
+		filter ffs.MinerSelectorFilter
+		want   []string
+	}{
+		{name: "InOrder", n: 2, want: []string{"t01000", "t01001"}},
+		{name: "Excluded", n: 2, filter: ffs.MinerSelectorFilter{ExcludedMiners: []string{"t01000"}}, want: []string{"t01001", "t01002"}},
+		{name: "Cheapest", n: 2, filter: ffs.MinerSelectorFilter{Cheapest: true}, want: []string{"t01003", "t01001"}},
+		{name: "CheapestPieceSize", n: 2, filter: ffs.MinerSelectorFilter{Cheapest: true, PieceSize: 1 << 20}, want: []string{"t01001", "t01002"}},
+		{name: "CheapestCountry", n: 1, filter: ffs.MinerSelectorFilter{Cheapest: true, CountryCodes: []string{"China"}, PieceSize: 1 << 20}, want: []string{"t01002"}},
+		{name: "CheapestExcluded", n: 1, filter: ffs.MinerSelectorFilter{Cheapest: true, ExcludedMiners: []string{"t01001", "t01003"}}, want: []string{"t01002"}},
+		{name: "CheapestMinScore", n: 1, filter: ffs.MinerSelectorFilter{Cheapest: true, MinScore: 85}, want: []string{"t01000"}},
+		{name: "CheapestNotEnough", n: 3, filter: ffs.MinerSelectorFilter{Cheapest: true, MaxPrice: 150}, want: nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := ms.GetMiners(tt.n, tt.filter)
+			if tt.want == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			addrs := make([]string, len(res))
+			for i, mp := range res {
+				addrs[i] = mp.Addr
+			}
+			require.Equal(t, tt.want, addrs)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package reptop
 
 import (
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/powergate/index/ask"
@@ -29,24 +31,64 @@ func New(rm *reputation.Module, ai *ask.Index) *RepTop {
 // GetMiners returns n miners using the configured Reputation Module and
 // Ask Index.
 func (rt *RepTop) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
-	ms, err := rt.rm.QueryMiners(n, f.ExcludedMiners, f.CountryCodes)
+	// All miners that satisfy the reputation constraints are queried,
+	// since the top ones might be discarded by their storage ask.
+	ms, err := rt.rm.QueryMiners(math.MaxInt32, f.ExcludedMiners, f.CountryCodes)
 	if err != nil {
-		return nil, fmt.Errorf("getting top %d miners from reputation module: %s", n, err)
+		return nil, fmt.Errorf("getting miners from reputation module: %s", err)
 	}
-	if len(ms) < n {
-		return nil, fmt.Errorf("not enough miners that satisfy the constraints")
-	}
-	aidx := rt.ai.Get()
-	res := make([]ffs.MinerProposal, 0, len(ms))
+	return selectMiners(n, f, ms, rt.ai.Get())
+}
+
+// selectMiners returns n miners from ms, sorted by score, whose storage asks in
+// aidx satisfy the filter. If the filter asks for the cheapest miners, they're
+// sorted by price instead.
+func selectMiners(n int, f ffs.MinerSelectorFilter, ms []reputation.MinerScore, aidx ask.IndexSnapshot) ([]ffs.MinerProposal, error) {
+	res := make([]ffs.MinerProposal, 0, n)
 	for _, m := range ms {
+		if m.Score < f.MinScore {
+			continue
+		}
+		if len(f.TrustedMiners) > 0 && !isTrusted(f.TrustedMiners, m.Addr) {
+			continue
+		}
 		sa, ok := aidx.Storage[m.Addr]
 		if !ok {
 			continue
 		}
+		if f.MaxPrice > 0 && sa.Price > f.MaxPrice {
+			continue
+		}
+		if f.PieceSize > 0 && sa.MinPieceSize > f.PieceSize {
+			continue
+		}
 		res = append(res, ffs.MinerProposal{
 			Addr:       sa.Miner,
 			EpochPrice: sa.Price,
 		})
+		if !f.Cheapest && len(res) == n {
+			break
+		}
+	}
+	if f.Cheapest {
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].EpochPrice < res[j].EpochPrice
+		})
+		if len(res) > n {
+			res = res[:n]
+		}
+	}
+	if len(res) < n {
+		return nil, fmt.Errorf("not enough miners that satisfy the constraints, want %d, got %d", n, len(res))
 	}
 	return res, nil
 }
+
+func isTrusted(trusted []string, addr string) bool {
+	for _, t := range trusted {
+		if t == addr {
+			return true
+		}
+	}
+	return false
+}
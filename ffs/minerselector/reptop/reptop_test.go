@@ -0,0 +1,61 @@
+package reptop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/index/ask"
+	"github.com/textileio/powergate/reputation"
+)
+
+func TestSelectMiners(t *testing.T) {
+	t.Parallel()
+	// Miners are sorted by score, as returned by the reputation module.
+	ms := []reputation.MinerScore{
+		{Addr: "t01000", Score: 90},
+		{Addr: "t01001", Score: 80},
+		{Addr: "t01002", Score: 70},
+		{Addr: "t01003", Score: 60},
+	}
+	aidx := ask.IndexSnapshot{
+		Storage: map[string]ask.StorageAsk{
+			"t01000": {Miner: "t01000", Price: 300},
+			"t01001": {Miner: "t01001", Price: 100},
+			"t01002": {Miner: "t01002", Price: 200},
+			"t01003": {Miner: "t01003", Price: 50, MinPieceSize: 1 << 30},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		n      int
+		filter ffs.MinerSelectorFilter
+		want   []string
+	}{
+		{name: "TopScore", n: 2, want: []string{"t01000", "t01001"}},
+		{name: "Cheapest", n: 2, filter: ffs.MinerSelectorFilter{Cheapest: true}, want: []string{"t01003", "t01001"}},
+		{name: "CheapestPieceSize", n: 2, filter: ffs.MinerSelectorFilter{Cheapest: true, PieceSize: 1 << 20}, want: []string{"t01001", "t01002"}},
+		{name: "CheapestMaxPrice", n: 1, filter: ffs.MinerSelectorFilter{Cheapest: true, MaxPrice: 150, PieceSize: 1 << 20}, want: []string{"t01001"}},
+		{name: "CheapestMinScore", n: 1, filter: ffs.MinerSelectorFilter{Cheapest: true, MinScore: 85}, want: []string{"t01000"}},
+		{name: "CheapestTrusted", n: 1, filter: ffs.MinerSelectorFilter{Cheapest: true, TrustedMiners: []string{"t01000", "t01002"}}, want: []string{"t01002"}},
+		{name: "CheapestNotEnough", n: 3, filter: ffs.MinerSelectorFilter{Cheapest: true, MaxPrice: 150}, want: nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := selectMiners(tt.n, tt.filter, ms, aidx)
+			if tt.want == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			addrs := make([]string, len(res))
+			for i, mp := range res {
+				addrs[i] = mp.Addr
+			}
+			require.Equal(t, tt.want, addrs)
+		})
+	}
+}
@@ -24,6 +24,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type RenewStrategy int32
+
+const (
+	RenewStrategy_RenewNewMiner  RenewStrategy = 0
+	RenewStrategy_RenewSameMiner RenewStrategy = 1
+	RenewStrategy_RenewCheapest  RenewStrategy = 2
+)
+
+var RenewStrategy_name = map[int32]string{
+	0: "RenewNewMiner",
+	1: "RenewSameMiner",
+	2: "RenewCheapest",
+}
+
+var RenewStrategy_value = map[string]int32{
+	"RenewNewMiner":  0,
+	"RenewSameMiner": 1,
+	"RenewCheapest":  2,
+}
+
+func (x RenewStrategy) String() string {
+	return proto.EnumName(RenewStrategy_name, int32(x))
+}
+
+func (RenewStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{0}
+}
+
+type FailureClass int32
+
+const (
+	FailureClass_FailureInternal    FailureClass = 0
+	FailureClass_FailureHotStorage  FailureClass = 1
+	FailureClass_FailureUnfreeze    FailureClass = 2
+	FailureClass_FailureColdStorage FailureClass = 3
+)
+
+var FailureClass_name = map[int32]string{
+	0: "FailureInternal",
+	1: "FailureHotStorage",
+	2: "FailureUnfreeze",
+	3: "FailureColdStorage",
+}
+
+var FailureClass_value = map[string]int32{
+	"FailureInternal":    0,
+	"FailureHotStorage":  1,
+	"FailureUnfreeze":    2,
+	"FailureColdStorage": 3,
+}
+
+func (x FailureClass) String() string {
+	return proto.EnumName(FailureClass_name, int32(x))
+}
+
+func (FailureClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{1}
+}
+
 type JobStatus int32
 
 const (
@@ -55,7 +114,38 @@ func (x JobStatus) String() string {
 }
 
 func (JobStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{0}
+	return fileDescriptor_e75cb675eef135e5, []int{2}
+}
+
+type HotAction int32
+
+const (
+	HotAction_HotActionNone   HotAction = 0
+	HotAction_HotActionPin    HotAction = 1
+	HotAction_HotActionRemove HotAction = 2
+	HotAction_HotActionUnref  HotAction = 3
+)
+
+var HotAction_name = map[int32]string{
+	0: "HotActionNone",
+	1: "HotActionPin",
+	2: "HotActionRemove",
+	3: "HotActionUnref",
+}
+
+var HotAction_value = map[string]int32{
+	"HotActionNone":   0,
+	"HotActionPin":    1,
+	"HotActionRemove": 2,
+	"HotActionUnref":  3,
+}
+
+func (x HotAction) String() string {
+	return proto.EnumName(HotAction_name, int32(x))
+}
+
+func (HotAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{3}
 }
 
 type IpfsConfig struct {
@@ -153,11 +243,14 @@ func (m *HotConfig) GetIpfs() *IpfsConfig {
 }
 
 type FilRenew struct {
-	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Threshold            int64    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Enabled              bool          `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Threshold            int64         `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Strategy             RenewStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=rpc.RenewStrategy" json:"strategy,omitempty"`
+	MaxPrice             uint64        `protobuf:"varint,4,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	MaxPriceIncrease     int64         `protobuf:"varint,5,opt,name=maxPriceIncrease,proto3" json:"maxPriceIncrease,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *FilRenew) Reset()         { *m = FilRenew{} }
@@ -199,22 +292,87 @@ func (m *FilRenew) GetThreshold() int64 {
 	return 0
 }
 
+func (m *FilRenew) GetStrategy() RenewStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return RenewStrategy_RenewNewMiner
+}
+
+func (m *FilRenew) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *FilRenew) GetMaxPriceIncrease() int64 {
+	if m != nil {
+		return m.MaxPriceIncrease
+	}
+	return 0
+}
+
+type FilRepair struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilRepair) Reset()         { *m = FilRepair{} }
+func (m *FilRepair) String() string { return proto.CompactTextString(m) }
+func (*FilRepair) ProtoMessage()    {}
+func (*FilRepair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{3}
+}
+
+func (m *FilRepair) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilRepair.Unmarshal(m, b)
+}
+func (m *FilRepair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilRepair.Marshal(b, m, deterministic)
+}
+func (m *FilRepair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilRepair.Merge(m, src)
+}
+func (m *FilRepair) XXX_Size() int {
+	return xxx_messageInfo_FilRepair.Size(m)
+}
+func (m *FilRepair) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilRepair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilRepair proto.InternalMessageInfo
+
+func (m *FilRepair) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 type FilConfig struct {
-	RepFactor            int64     `protobuf:"varint,1,opt,name=repFactor,proto3" json:"repFactor,omitempty"`
-	DealDuration         int64     `protobuf:"varint,2,opt,name=dealDuration,proto3" json:"dealDuration,omitempty"`
-	ExcludedMiners       []string  `protobuf:"bytes,3,rep,name=excludedMiners,proto3" json:"excludedMiners,omitempty"`
-	CountryCodes         []string  `protobuf:"bytes,4,rep,name=countryCodes,proto3" json:"countryCodes,omitempty"`
-	Renew                *FilRenew `protobuf:"bytes,5,opt,name=renew,proto3" json:"renew,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	RepFactor            int64      `protobuf:"varint,1,opt,name=repFactor,proto3" json:"repFactor,omitempty"`
+	DealDuration         int64      `protobuf:"varint,2,opt,name=dealDuration,proto3" json:"dealDuration,omitempty"`
+	ExcludedMiners       []string   `protobuf:"bytes,3,rep,name=excludedMiners,proto3" json:"excludedMiners,omitempty"`
+	CountryCodes         []string   `protobuf:"bytes,4,rep,name=countryCodes,proto3" json:"countryCodes,omitempty"`
+	Renew                *FilRenew  `protobuf:"bytes,5,opt,name=renew,proto3" json:"renew,omitempty"`
+	Repair               *FilRepair `protobuf:"bytes,6,opt,name=repair,proto3" json:"repair,omitempty"`
+	TrustedMiners        []string   `protobuf:"bytes,7,rep,name=trustedMiners,proto3" json:"trustedMiners,omitempty"`
+	MaxPrice             uint64     `protobuf:"varint,8,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	MinScore             int64      `protobuf:"varint,9,opt,name=minScore,proto3" json:"minScore,omitempty"`
+	Addr                 string     `protobuf:"bytes,10,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *FilConfig) Reset()         { *m = FilConfig{} }
 func (m *FilConfig) String() string { return proto.CompactTextString(m) }
 func (*FilConfig) ProtoMessage()    {}
 func (*FilConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{3}
+	return fileDescriptor_e75cb675eef135e5, []int{4}
 }
 
 func (m *FilConfig) XXX_Unmarshal(b []byte) error {
@@ -270,6 +428,41 @@ func (m *FilConfig) GetRenew() *FilRenew {
 	return nil
 }
 
+func (m *FilConfig) GetRepair() *FilRepair {
+	if m != nil {
+		return m.Repair
+	}
+	return nil
+}
+
+func (m *FilConfig) GetTrustedMiners() []string {
+	if m != nil {
+		return m.TrustedMiners
+	}
+	return nil
+}
+
+func (m *FilConfig) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *FilConfig) GetMinScore() int64 {
+	if m != nil {
+		return m.MinScore
+	}
+	return 0
+}
+
+func (m *FilConfig) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
 type ColdConfig struct {
 	Enabled              bool       `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	Filecoin             *FilConfig `protobuf:"bytes,2,opt,name=filecoin,proto3" json:"filecoin,omitempty"`
@@ -282,7 +475,7 @@ func (m *ColdConfig) Reset()         { *m = ColdConfig{} }
 func (m *ColdConfig) String() string { return proto.CompactTextString(m) }
 func (*ColdConfig) ProtoMessage()    {}
 func (*ColdConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{4}
+	return fileDescriptor_e75cb675eef135e5, []int{5}
 }
 
 func (m *ColdConfig) XXX_Unmarshal(b []byte) error {
@@ -317,20 +510,84 @@ func (m *ColdConfig) GetFilecoin() *FilConfig {
 	return nil
 }
 
+type RetryConfig struct {
+	MaxAttempts          int64          `protobuf:"varint,1,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	Backoff              int64          `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	MaxBackoff           int64          `protobuf:"varint,3,opt,name=maxBackoff,proto3" json:"maxBackoff,omitempty"`
+	FailureClasses       []FailureClass `protobuf:"varint,4,rep,packed,name=failureClasses,proto3,enum=rpc.FailureClass" json:"failureClasses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RetryConfig) Reset()         { *m = RetryConfig{} }
+func (m *RetryConfig) String() string { return proto.CompactTextString(m) }
+func (*RetryConfig) ProtoMessage()    {}
+func (*RetryConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{6}
+}
+
+func (m *RetryConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryConfig.Unmarshal(m, b)
+}
+func (m *RetryConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryConfig.Marshal(b, m, deterministic)
+}
+func (m *RetryConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryConfig.Merge(m, src)
+}
+func (m *RetryConfig) XXX_Size() int {
+	return xxx_messageInfo_RetryConfig.Size(m)
+}
+func (m *RetryConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryConfig proto.InternalMessageInfo
+
+func (m *RetryConfig) GetMaxAttempts() int64 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryConfig) GetBackoff() int64 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *RetryConfig) GetMaxBackoff() int64 {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return 0
+}
+
+func (m *RetryConfig) GetFailureClasses() []FailureClass {
+	if m != nil {
+		return m.FailureClasses
+	}
+	return nil
+}
+
 type CidConfig struct {
-	Cid                  string      `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Hot                  *HotConfig  `protobuf:"bytes,2,opt,name=hot,proto3" json:"hot,omitempty"`
-	Cold                 *ColdConfig `protobuf:"bytes,3,opt,name=cold,proto3" json:"cold,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Cid                  string       `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hot                  *HotConfig   `protobuf:"bytes,2,opt,name=hot,proto3" json:"hot,omitempty"`
+	Cold                 *ColdConfig  `protobuf:"bytes,3,opt,name=cold,proto3" json:"cold,omitempty"`
+	Retry                *RetryConfig `protobuf:"bytes,4,opt,name=retry,proto3" json:"retry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *CidConfig) Reset()         { *m = CidConfig{} }
 func (m *CidConfig) String() string { return proto.CompactTextString(m) }
 func (*CidConfig) ProtoMessage()    {}
 func (*CidConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{5}
+	return fileDescriptor_e75cb675eef135e5, []int{7}
 }
 
 func (m *CidConfig) XXX_Unmarshal(b []byte) error {
@@ -372,19 +629,27 @@ func (m *CidConfig) GetCold() *ColdConfig {
 	return nil
 }
 
+func (m *CidConfig) GetRetry() *RetryConfig {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 type DefaultCidConfig struct {
-	Hot                  *HotConfig  `protobuf:"bytes,1,opt,name=hot,proto3" json:"hot,omitempty"`
-	Cold                 *ColdConfig `protobuf:"bytes,2,opt,name=cold,proto3" json:"cold,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Hot                  *HotConfig   `protobuf:"bytes,1,opt,name=hot,proto3" json:"hot,omitempty"`
+	Cold                 *ColdConfig  `protobuf:"bytes,2,opt,name=cold,proto3" json:"cold,omitempty"`
+	Retry                *RetryConfig `protobuf:"bytes,3,opt,name=retry,proto3" json:"retry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *DefaultCidConfig) Reset()         { *m = DefaultCidConfig{} }
 func (m *DefaultCidConfig) String() string { return proto.CompactTextString(m) }
 func (*DefaultCidConfig) ProtoMessage()    {}
 func (*DefaultCidConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{6}
+	return fileDescriptor_e75cb675eef135e5, []int{8}
 }
 
 func (m *DefaultCidConfig) XXX_Unmarshal(b []byte) error {
@@ -419,6 +684,13 @@ func (m *DefaultCidConfig) GetCold() *ColdConfig {
 	return nil
 }
 
+func (m *DefaultCidConfig) GetRetry() *RetryConfig {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 type IpfsHotInfo struct {
 	Created              int64    `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -430,7 +702,7 @@ func (m *IpfsHotInfo) Reset()         { *m = IpfsHotInfo{} }
 func (m *IpfsHotInfo) String() string { return proto.CompactTextString(m) }
 func (*IpfsHotInfo) ProtoMessage()    {}
 func (*IpfsHotInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{7}
+	return fileDescriptor_e75cb675eef135e5, []int{9}
 }
 
 func (m *IpfsHotInfo) XXX_Unmarshal(b []byte) error {
@@ -462,6 +734,7 @@ type HotInfo struct {
 	Enabled              bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	Size                 int64        `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	Ipfs                 *IpfsHotInfo `protobuf:"bytes,3,opt,name=ipfs,proto3" json:"ipfs,omitempty"`
+	NumBlocks            int64        `protobuf:"varint,4,opt,name=numBlocks,proto3" json:"numBlocks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -471,7 +744,7 @@ func (m *HotInfo) Reset()         { *m = HotInfo{} }
 func (m *HotInfo) String() string { return proto.CompactTextString(m) }
 func (*HotInfo) ProtoMessage()    {}
 func (*HotInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{8}
+	return fileDescriptor_e75cb675eef135e5, []int{10}
 }
 
 func (m *HotInfo) XXX_Unmarshal(b []byte) error {
@@ -513,12 +786,22 @@ func (m *HotInfo) GetIpfs() *IpfsHotInfo {
 	return nil
 }
 
+func (m *HotInfo) GetNumBlocks() int64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
 type FilStorage struct {
 	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
 	Renewed              bool     `protobuf:"varint,2,opt,name=renewed,proto3" json:"renewed,omitempty"`
 	Duration             int64    `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
 	ActivationEpoch      int64    `protobuf:"varint,4,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
 	Miner                string   `protobuf:"bytes,5,opt,name=miner,proto3" json:"miner,omitempty"`
+	EpochPrice           uint64   `protobuf:"varint,6,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
+	PieceSize            uint64   `protobuf:"varint,7,opt,name=pieceSize,proto3" json:"pieceSize,omitempty"`
+	TotalCost            uint64   `protobuf:"varint,8,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -528,7 +811,7 @@ func (m *FilStorage) Reset()         { *m = FilStorage{} }
 func (m *FilStorage) String() string { return proto.CompactTextString(m) }
 func (*FilStorage) ProtoMessage()    {}
 func (*FilStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{9}
+	return fileDescriptor_e75cb675eef135e5, []int{11}
 }
 
 func (m *FilStorage) XXX_Unmarshal(b []byte) error {
@@ -584,9 +867,31 @@ func (m *FilStorage) GetMiner() string {
 	return ""
 }
 
+func (m *FilStorage) GetEpochPrice() uint64 {
+	if m != nil {
+		return m.EpochPrice
+	}
+	return 0
+}
+
+func (m *FilStorage) GetPieceSize() uint64 {
+	if m != nil {
+		return m.PieceSize
+	}
+	return 0
+}
+
+func (m *FilStorage) GetTotalCost() uint64 {
+	if m != nil {
+		return m.TotalCost
+	}
+	return 0
+}
+
 type FilInfo struct {
 	DataCid              string        `protobuf:"bytes,1,opt,name=dataCid,proto3" json:"dataCid,omitempty"`
 	Proposals            []*FilStorage `protobuf:"bytes,2,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Size                 int64         `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -596,7 +901,7 @@ func (m *FilInfo) Reset()         { *m = FilInfo{} }
 func (m *FilInfo) String() string { return proto.CompactTextString(m) }
 func (*FilInfo) ProtoMessage()    {}
 func (*FilInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{10}
+	return fileDescriptor_e75cb675eef135e5, []int{12}
 }
 
 func (m *FilInfo) XXX_Unmarshal(b []byte) error {
@@ -631,6 +936,13 @@ func (m *FilInfo) GetProposals() []*FilStorage {
 	return nil
 }
 
+func (m *FilInfo) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 type ColdInfo struct {
 	Filecoin             *FilInfo `protobuf:"bytes,1,opt,name=filecoin,proto3" json:"filecoin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -642,7 +954,7 @@ func (m *ColdInfo) Reset()         { *m = ColdInfo{} }
 func (m *ColdInfo) String() string { return proto.CompactTextString(m) }
 func (*ColdInfo) ProtoMessage()    {}
 func (*ColdInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{11}
+	return fileDescriptor_e75cb675eef135e5, []int{13}
 }
 
 func (m *ColdInfo) XXX_Unmarshal(b []byte) error {
@@ -670,12 +982,60 @@ func (m *ColdInfo) GetFilecoin() *FilInfo {
 	return nil
 }
 
+type CidRefs struct {
+	Hot                  []string `protobuf:"bytes,1,rep,name=hot,proto3" json:"hot,omitempty"`
+	Cold                 []string `protobuf:"bytes,2,rep,name=cold,proto3" json:"cold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CidRefs) Reset()         { *m = CidRefs{} }
+func (m *CidRefs) String() string { return proto.CompactTextString(m) }
+func (*CidRefs) ProtoMessage()    {}
+func (*CidRefs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{14}
+}
+
+func (m *CidRefs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CidRefs.Unmarshal(m, b)
+}
+func (m *CidRefs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CidRefs.Marshal(b, m, deterministic)
+}
+func (m *CidRefs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CidRefs.Merge(m, src)
+}
+func (m *CidRefs) XXX_Size() int {
+	return xxx_messageInfo_CidRefs.Size(m)
+}
+func (m *CidRefs) XXX_DiscardUnknown() {
+	xxx_messageInfo_CidRefs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CidRefs proto.InternalMessageInfo
+
+func (m *CidRefs) GetHot() []string {
+	if m != nil {
+		return m.Hot
+	}
+	return nil
+}
+
+func (m *CidRefs) GetCold() []string {
+	if m != nil {
+		return m.Cold
+	}
+	return nil
+}
+
 type CidInfo struct {
 	JobID                string    `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
 	Cid                  string    `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
 	Created              int64     `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
 	Hot                  *HotInfo  `protobuf:"bytes,4,opt,name=hot,proto3" json:"hot,omitempty"`
 	Cold                 *ColdInfo `protobuf:"bytes,5,opt,name=cold,proto3" json:"cold,omitempty"`
+	Refs                 *CidRefs  `protobuf:"bytes,6,opt,name=refs,proto3" json:"refs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -685,7 +1045,7 @@ func (m *CidInfo) Reset()         { *m = CidInfo{} }
 func (m *CidInfo) String() string { return proto.CompactTextString(m) }
 func (*CidInfo) ProtoMessage()    {}
 func (*CidInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{12}
+	return fileDescriptor_e75cb675eef135e5, []int{15}
 }
 
 func (m *CidInfo) XXX_Unmarshal(b []byte) error {
@@ -741,6 +1101,13 @@ func (m *CidInfo) GetCold() *ColdInfo {
 	return nil
 }
 
+func (m *CidInfo) GetRefs() *CidRefs {
+	if m != nil {
+		return m.Refs
+	}
+	return nil
+}
+
 type WalletInfo struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Balance              uint64   `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
@@ -753,7 +1120,7 @@ func (m *WalletInfo) Reset()         { *m = WalletInfo{} }
 func (m *WalletInfo) String() string { return proto.CompactTextString(m) }
 func (*WalletInfo) ProtoMessage()    {}
 func (*WalletInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{13}
+	return fileDescriptor_e75cb675eef135e5, []int{16}
 }
 
 func (m *WalletInfo) XXX_Unmarshal(b []byte) error {
@@ -788,11 +1155,197 @@ func (m *WalletInfo) GetBalance() uint64 {
 	return 0
 }
 
-type InstanceInfo struct {
-	ID                   string            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	DefaultCidConfig     *DefaultCidConfig `protobuf:"bytes,2,opt,name=defaultCidConfig,proto3" json:"defaultCidConfig,omitempty"`
-	Wallet               *WalletInfo       `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	Pins                 []string          `protobuf:"bytes,4,rep,name=pins,proto3" json:"pins,omitempty"`
+type HotStorageInfo struct {
+	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	NumBlocks            int64    `protobuf:"varint,2,opt,name=numBlocks,proto3" json:"numBlocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HotStorageInfo) Reset()         { *m = HotStorageInfo{} }
+func (m *HotStorageInfo) String() string { return proto.CompactTextString(m) }
+func (*HotStorageInfo) ProtoMessage()    {}
+func (*HotStorageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{17}
+}
+
+func (m *HotStorageInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HotStorageInfo.Unmarshal(m, b)
+}
+func (m *HotStorageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HotStorageInfo.Marshal(b, m, deterministic)
+}
+func (m *HotStorageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotStorageInfo.Merge(m, src)
+}
+func (m *HotStorageInfo) XXX_Size() int {
+	return xxx_messageInfo_HotStorageInfo.Size(m)
+}
+func (m *HotStorageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotStorageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotStorageInfo proto.InternalMessageInfo
+
+func (m *HotStorageInfo) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *HotStorageInfo) GetNumBlocks() int64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+type Quotas struct {
+	MaxHotBytes          int64    `protobuf:"varint,1,opt,name=maxHotBytes,proto3" json:"maxHotBytes,omitempty"`
+	MaxColdBytes         int64    `protobuf:"varint,2,opt,name=maxColdBytes,proto3" json:"maxColdBytes,omitempty"`
+	MaxCids              int64    `protobuf:"varint,3,opt,name=maxCids,proto3" json:"maxCids,omitempty"`
+	MaxSpend             uint64   `protobuf:"varint,4,opt,name=maxSpend,proto3" json:"maxSpend,omitempty"`
+	SpendPeriod          int64    `protobuf:"varint,5,opt,name=spendPeriod,proto3" json:"spendPeriod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quotas) Reset()         { *m = Quotas{} }
+func (m *Quotas) String() string { return proto.CompactTextString(m) }
+func (*Quotas) ProtoMessage()    {}
+func (*Quotas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{18}
+}
+
+func (m *Quotas) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quotas.Unmarshal(m, b)
+}
+func (m *Quotas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quotas.Marshal(b, m, deterministic)
+}
+func (m *Quotas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quotas.Merge(m, src)
+}
+func (m *Quotas) XXX_Size() int {
+	return xxx_messageInfo_Quotas.Size(m)
+}
+func (m *Quotas) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quotas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quotas proto.InternalMessageInfo
+
+func (m *Quotas) GetMaxHotBytes() int64 {
+	if m != nil {
+		return m.MaxHotBytes
+	}
+	return 0
+}
+
+func (m *Quotas) GetMaxColdBytes() int64 {
+	if m != nil {
+		return m.MaxColdBytes
+	}
+	return 0
+}
+
+func (m *Quotas) GetMaxCids() int64 {
+	if m != nil {
+		return m.MaxCids
+	}
+	return 0
+}
+
+func (m *Quotas) GetMaxSpend() uint64 {
+	if m != nil {
+		return m.MaxSpend
+	}
+	return 0
+}
+
+func (m *Quotas) GetSpendPeriod() int64 {
+	if m != nil {
+		return m.SpendPeriod
+	}
+	return 0
+}
+
+type QuotasUsage struct {
+	HotBytes             int64    `protobuf:"varint,1,opt,name=hotBytes,proto3" json:"hotBytes,omitempty"`
+	ColdBytes            int64    `protobuf:"varint,2,opt,name=coldBytes,proto3" json:"coldBytes,omitempty"`
+	Cids                 int64    `protobuf:"varint,3,opt,name=cids,proto3" json:"cids,omitempty"`
+	Spent                uint64   `protobuf:"varint,4,opt,name=spent,proto3" json:"spent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotasUsage) Reset()         { *m = QuotasUsage{} }
+func (m *QuotasUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotasUsage) ProtoMessage()    {}
+func (*QuotasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{19}
+}
+
+func (m *QuotasUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotasUsage.Unmarshal(m, b)
+}
+func (m *QuotasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotasUsage.Marshal(b, m, deterministic)
+}
+func (m *QuotasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotasUsage.Merge(m, src)
+}
+func (m *QuotasUsage) XXX_Size() int {
+	return xxx_messageInfo_QuotasUsage.Size(m)
+}
+func (m *QuotasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotasUsage proto.InternalMessageInfo
+
+func (m *QuotasUsage) GetHotBytes() int64 {
+	if m != nil {
+		return m.HotBytes
+	}
+	return 0
+}
+
+func (m *QuotasUsage) GetColdBytes() int64 {
+	if m != nil {
+		return m.ColdBytes
+	}
+	return 0
+}
+
+func (m *QuotasUsage) GetCids() int64 {
+	if m != nil {
+		return m.Cids
+	}
+	return 0
+}
+
+func (m *QuotasUsage) GetSpent() uint64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+type InstanceInfo struct {
+	ID                   string            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	DefaultCidConfig     *DefaultCidConfig `protobuf:"bytes,2,opt,name=defaultCidConfig,proto3" json:"defaultCidConfig,omitempty"`
+	Wallet               *WalletInfo       `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Pins                 []string          `protobuf:"bytes,4,rep,name=pins,proto3" json:"pins,omitempty"`
+	QueuedJobs           int64             `protobuf:"varint,5,opt,name=queuedJobs,proto3" json:"queuedJobs,omitempty"`
+	HotStorage           *HotStorageInfo   `protobuf:"bytes,6,opt,name=hotStorage,proto3" json:"hotStorage,omitempty"`
+	Quotas               *Quotas           `protobuf:"bytes,7,opt,name=quotas,proto3" json:"quotas,omitempty"`
+	Usage                *QuotasUsage      `protobuf:"bytes,8,opt,name=usage,proto3" json:"usage,omitempty"`
+	TotalSpend           uint64            `protobuf:"varint,9,opt,name=totalSpend,proto3" json:"totalSpend,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -802,7 +1355,7 @@ func (m *InstanceInfo) Reset()         { *m = InstanceInfo{} }
 func (m *InstanceInfo) String() string { return proto.CompactTextString(m) }
 func (*InstanceInfo) ProtoMessage()    {}
 func (*InstanceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{14}
+	return fileDescriptor_e75cb675eef135e5, []int{20}
 }
 
 func (m *InstanceInfo) XXX_Unmarshal(b []byte) error {
@@ -851,1137 +1404,2803 @@ func (m *InstanceInfo) GetPins() []string {
 	return nil
 }
 
-type Job struct {
-	ID                   string    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	ApiID                string    `protobuf:"bytes,2,opt,name=apiID,proto3" json:"apiID,omitempty"`
-	Status               JobStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rpc.JobStatus" json:"status,omitempty"`
-	ErrCause             string    `protobuf:"bytes,4,opt,name=errCause,proto3" json:"errCause,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *Job) Reset()         { *m = Job{} }
-func (m *Job) String() string { return proto.CompactTextString(m) }
-func (*Job) ProtoMessage()    {}
-func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{15}
-}
-
-func (m *Job) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Job.Unmarshal(m, b)
-}
-func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
-}
-func (m *Job) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Job.Merge(m, src)
-}
-func (m *Job) XXX_Size() int {
-	return xxx_messageInfo_Job.Size(m)
-}
-func (m *Job) XXX_DiscardUnknown() {
-	xxx_messageInfo_Job.DiscardUnknown(m)
+func (m *InstanceInfo) GetQueuedJobs() int64 {
+	if m != nil {
+		return m.QueuedJobs
+	}
+	return 0
 }
 
-var xxx_messageInfo_Job proto.InternalMessageInfo
-
-func (m *Job) GetID() string {
+func (m *InstanceInfo) GetHotStorage() *HotStorageInfo {
 	if m != nil {
-		return m.ID
+		return m.HotStorage
 	}
-	return ""
+	return nil
 }
 
-func (m *Job) GetApiID() string {
+func (m *InstanceInfo) GetQuotas() *Quotas {
 	if m != nil {
-		return m.ApiID
+		return m.Quotas
 	}
-	return ""
+	return nil
 }
 
-func (m *Job) GetStatus() JobStatus {
+func (m *InstanceInfo) GetUsage() *QuotasUsage {
 	if m != nil {
-		return m.Status
+		return m.Usage
 	}
-	return JobStatus_Queued
+	return nil
 }
 
-func (m *Job) GetErrCause() string {
+func (m *InstanceInfo) GetTotalSpend() uint64 {
 	if m != nil {
-		return m.ErrCause
+		return m.TotalSpend
 	}
-	return ""
+	return 0
 }
 
-type CreateRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type HotPlan struct {
+	Action               HotAction `protobuf:"varint,1,opt,name=action,proto3,enum=rpc.HotAction" json:"action,omitempty"`
+	CanUnfreeze          bool      `protobuf:"varint,2,opt,name=canUnfreeze,proto3" json:"canUnfreeze,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
-func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateRequest) ProtoMessage()    {}
-func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{16}
+func (m *HotPlan) Reset()         { *m = HotPlan{} }
+func (m *HotPlan) String() string { return proto.CompactTextString(m) }
+func (*HotPlan) ProtoMessage()    {}
+func (*HotPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{21}
 }
 
-func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
+func (m *HotPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HotPlan.Unmarshal(m, b)
 }
-func (m *CreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateRequest.Marshal(b, m, deterministic)
+func (m *HotPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HotPlan.Marshal(b, m, deterministic)
 }
-func (m *CreateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateRequest.Merge(m, src)
+func (m *HotPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotPlan.Merge(m, src)
 }
-func (m *CreateRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateRequest.Size(m)
+func (m *HotPlan) XXX_Size() int {
+	return xxx_messageInfo_HotPlan.Size(m)
 }
-func (m *CreateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateRequest.DiscardUnknown(m)
+func (m *HotPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateRequest proto.InternalMessageInfo
+var xxx_messageInfo_HotPlan proto.InternalMessageInfo
 
-type CreateReply struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+func (m *HotPlan) GetAction() HotAction {
+	if m != nil {
+		return m.Action
+	}
+	return HotAction_HotActionNone
+}
+
+func (m *HotPlan) GetCanUnfreeze() bool {
+	if m != nil {
+		return m.CanUnfreeze
+	}
+	return false
+}
+
+type MinerProposal struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	EpochPrice           uint64   `protobuf:"varint,2,opt,name=epochPrice,proto3" json:"epochPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateReply) Reset()         { *m = CreateReply{} }
-func (m *CreateReply) String() string { return proto.CompactTextString(m) }
-func (*CreateReply) ProtoMessage()    {}
-func (*CreateReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{17}
+func (m *MinerProposal) Reset()         { *m = MinerProposal{} }
+func (m *MinerProposal) String() string { return proto.CompactTextString(m) }
+func (*MinerProposal) ProtoMessage()    {}
+func (*MinerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{22}
 }
 
-func (m *CreateReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateReply.Unmarshal(m, b)
+func (m *MinerProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinerProposal.Unmarshal(m, b)
 }
-func (m *CreateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateReply.Marshal(b, m, deterministic)
+func (m *MinerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinerProposal.Marshal(b, m, deterministic)
 }
-func (m *CreateReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReply.Merge(m, src)
+func (m *MinerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerProposal.Merge(m, src)
 }
-func (m *CreateReply) XXX_Size() int {
-	return xxx_messageInfo_CreateReply.Size(m)
+func (m *MinerProposal) XXX_Size() int {
+	return xxx_messageInfo_MinerProposal.Size(m)
 }
-func (m *CreateReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReply.DiscardUnknown(m)
+func (m *MinerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateReply proto.InternalMessageInfo
+var xxx_messageInfo_MinerProposal proto.InternalMessageInfo
 
-func (m *CreateReply) GetID() string {
+func (m *MinerProposal) GetAddr() string {
 	if m != nil {
-		return m.ID
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *CreateReply) GetToken() string {
+func (m *MinerProposal) GetEpochPrice() uint64 {
 	if m != nil {
-		return m.Token
+		return m.EpochPrice
 	}
-	return ""
+	return 0
 }
 
-type IDRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ColdPlan struct {
+	NewDeals             int64            `protobuf:"varint,1,opt,name=newDeals,proto3" json:"newDeals,omitempty"`
+	Miners               []*MinerProposal `protobuf:"bytes,2,rep,name=miners,proto3" json:"miners,omitempty"`
+	DealDuration         int64            `protobuf:"varint,3,opt,name=dealDuration,proto3" json:"dealDuration,omitempty"`
+	EstimatedCost        uint64           `protobuf:"varint,4,opt,name=estimatedCost,proto3" json:"estimatedCost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *IDRequest) Reset()         { *m = IDRequest{} }
-func (m *IDRequest) String() string { return proto.CompactTextString(m) }
-func (*IDRequest) ProtoMessage()    {}
-func (*IDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{18}
+func (m *ColdPlan) Reset()         { *m = ColdPlan{} }
+func (m *ColdPlan) String() string { return proto.CompactTextString(m) }
+func (*ColdPlan) ProtoMessage()    {}
+func (*ColdPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{23}
 }
 
-func (m *IDRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IDRequest.Unmarshal(m, b)
+func (m *ColdPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ColdPlan.Unmarshal(m, b)
 }
-func (m *IDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IDRequest.Marshal(b, m, deterministic)
+func (m *ColdPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ColdPlan.Marshal(b, m, deterministic)
 }
-func (m *IDRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IDRequest.Merge(m, src)
+func (m *ColdPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColdPlan.Merge(m, src)
 }
-func (m *IDRequest) XXX_Size() int {
-	return xxx_messageInfo_IDRequest.Size(m)
+func (m *ColdPlan) XXX_Size() int {
+	return xxx_messageInfo_ColdPlan.Size(m)
 }
-func (m *IDRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_IDRequest.DiscardUnknown(m)
+func (m *ColdPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColdPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IDRequest proto.InternalMessageInfo
+var xxx_messageInfo_ColdPlan proto.InternalMessageInfo
 
-type IDReply struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ColdPlan) GetNewDeals() int64 {
+	if m != nil {
+		return m.NewDeals
+	}
+	return 0
 }
 
-func (m *IDReply) Reset()         { *m = IDReply{} }
-func (m *IDReply) String() string { return proto.CompactTextString(m) }
-func (*IDReply) ProtoMessage()    {}
-func (*IDReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{19}
-}
+func (m *ColdPlan) GetMiners() []*MinerProposal {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+func (m *ColdPlan) GetDealDuration() int64 {
+	if m != nil {
+		return m.DealDuration
+	}
+	return 0
+}
+
+func (m *ColdPlan) GetEstimatedCost() uint64 {
+	if m != nil {
+		return m.EstimatedCost
+	}
+	return 0
+}
+
+type StoragePlan struct {
+	Cid                  string    `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hot                  *HotPlan  `protobuf:"bytes,2,opt,name=hot,proto3" json:"hot,omitempty"`
+	Cold                 *ColdPlan `protobuf:"bytes,3,opt,name=cold,proto3" json:"cold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *StoragePlan) Reset()         { *m = StoragePlan{} }
+func (m *StoragePlan) String() string { return proto.CompactTextString(m) }
+func (*StoragePlan) ProtoMessage()    {}
+func (*StoragePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{24}
+}
+
+func (m *StoragePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoragePlan.Unmarshal(m, b)
+}
+func (m *StoragePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoragePlan.Marshal(b, m, deterministic)
+}
+func (m *StoragePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoragePlan.Merge(m, src)
+}
+func (m *StoragePlan) XXX_Size() int {
+	return xxx_messageInfo_StoragePlan.Size(m)
+}
+func (m *StoragePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoragePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoragePlan proto.InternalMessageInfo
+
+func (m *StoragePlan) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *StoragePlan) GetHot() *HotPlan {
+	if m != nil {
+		return m.Hot
+	}
+	return nil
+}
+
+func (m *StoragePlan) GetCold() *ColdPlan {
+	if m != nil {
+		return m.Cold
+	}
+	return nil
+}
+
+type Job struct {
+	ID                   string    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ApiID                string    `protobuf:"bytes,2,opt,name=apiID,proto3" json:"apiID,omitempty"`
+	Status               JobStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rpc.JobStatus" json:"status,omitempty"`
+	ErrCause             string    `protobuf:"bytes,4,opt,name=errCause,proto3" json:"errCause,omitempty"`
+	Attempts             int64     `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextRetry            int64     `protobuf:"varint,6,opt,name=nextRetry,proto3" json:"nextRetry,omitempty"`
+	Cid                  string    `protobuf:"bytes,7,opt,name=cid,proto3" json:"cid,omitempty"`
+	Created              int64     `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"`
+	Started              int64     `protobuf:"varint,9,opt,name=started,proto3" json:"started,omitempty"`
+	Finished             int64     `protobuf:"varint,10,opt,name=finished,proto3" json:"finished,omitempty"`
+	Priority             int64     `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{25}
+}
+
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Job.Unmarshal(m, b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return xxx_messageInfo_Job.Size(m)
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Job) GetApiID() string {
+	if m != nil {
+		return m.ApiID
+	}
+	return ""
+}
+
+func (m *Job) GetStatus() JobStatus {
+	if m != nil {
+		return m.Status
+	}
+	return JobStatus_Queued
+}
+
+func (m *Job) GetErrCause() string {
+	if m != nil {
+		return m.ErrCause
+	}
+	return ""
+}
+
+func (m *Job) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Job) GetNextRetry() int64 {
+	if m != nil {
+		return m.NextRetry
+	}
+	return 0
+}
+
+func (m *Job) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *Job) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Job) GetStarted() int64 {
+	if m != nil {
+		return m.Started
+	}
+	return 0
+}
+
+func (m *Job) GetFinished() int64 {
+	if m != nil {
+		return m.Finished
+	}
+	return 0
+}
+
+func (m *Job) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type CreateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
+func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRequest) ProtoMessage()    {}
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{26}
+}
+
+func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
+}
+func (m *CreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRequest.Merge(m, src)
+}
+func (m *CreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRequest.Size(m)
+}
+func (m *CreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRequest proto.InternalMessageInfo
+
+type CreateReply struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateReply) Reset()         { *m = CreateReply{} }
+func (m *CreateReply) String() string { return proto.CompactTextString(m) }
+func (*CreateReply) ProtoMessage()    {}
+func (*CreateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{27}
+}
+
+func (m *CreateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReply.Unmarshal(m, b)
+}
+func (m *CreateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReply.Marshal(b, m, deterministic)
+}
+func (m *CreateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReply.Merge(m, src)
+}
+func (m *CreateReply) XXX_Size() int {
+	return xxx_messageInfo_CreateReply.Size(m)
+}
+func (m *CreateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReply proto.InternalMessageInfo
+
+func (m *CreateReply) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *CreateReply) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type IDRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDRequest) Reset()         { *m = IDRequest{} }
+func (m *IDRequest) String() string { return proto.CompactTextString(m) }
+func (*IDRequest) ProtoMessage()    {}
+func (*IDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{28}
+}
+
+func (m *IDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDRequest.Unmarshal(m, b)
+}
+func (m *IDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDRequest.Marshal(b, m, deterministic)
+}
+func (m *IDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDRequest.Merge(m, src)
+}
+func (m *IDRequest) XXX_Size() int {
+	return xxx_messageInfo_IDRequest.Size(m)
+}
+func (m *IDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDRequest proto.InternalMessageInfo
+
+type IDReply struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDReply) Reset()         { *m = IDReply{} }
+func (m *IDReply) String() string { return proto.CompactTextString(m) }
+func (*IDReply) ProtoMessage()    {}
+func (*IDReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{29}
+}
+
+func (m *IDReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDReply.Unmarshal(m, b)
+}
+func (m *IDReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDReply.Marshal(b, m, deterministic)
+}
+func (m *IDReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDReply.Merge(m, src)
+}
+func (m *IDReply) XXX_Size() int {
+	return xxx_messageInfo_IDReply.Size(m)
+}
+func (m *IDReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDReply proto.InternalMessageInfo
+
+func (m *IDReply) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type WalletAddrRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletAddrRequest) Reset()         { *m = WalletAddrRequest{} }
+func (m *WalletAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletAddrRequest) ProtoMessage()    {}
+func (*WalletAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{30}
+}
+
+func (m *WalletAddrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletAddrRequest.Unmarshal(m, b)
+}
+func (m *WalletAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletAddrRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletAddrRequest.Merge(m, src)
+}
+func (m *WalletAddrRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletAddrRequest.Size(m)
+}
+func (m *WalletAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletAddrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletAddrRequest proto.InternalMessageInfo
+
+type WalletAddrReply struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletAddrReply) Reset()         { *m = WalletAddrReply{} }
+func (m *WalletAddrReply) String() string { return proto.CompactTextString(m) }
+func (*WalletAddrReply) ProtoMessage()    {}
+func (*WalletAddrReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{31}
+}
+
+func (m *WalletAddrReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletAddrReply.Unmarshal(m, b)
+}
+func (m *WalletAddrReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletAddrReply.Marshal(b, m, deterministic)
+}
+func (m *WalletAddrReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletAddrReply.Merge(m, src)
+}
+func (m *WalletAddrReply) XXX_Size() int {
+	return xxx_messageInfo_WalletAddrReply.Size(m)
+}
+func (m *WalletAddrReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletAddrReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletAddrReply proto.InternalMessageInfo
+
+func (m *WalletAddrReply) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type AddrInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddrInfo) Reset()         { *m = AddrInfo{} }
+func (m *AddrInfo) String() string { return proto.CompactTextString(m) }
+func (*AddrInfo) ProtoMessage()    {}
+func (*AddrInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{32}
+}
+
+func (m *AddrInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddrInfo.Unmarshal(m, b)
+}
+func (m *AddrInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddrInfo.Marshal(b, m, deterministic)
+}
+func (m *AddrInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddrInfo.Merge(m, src)
+}
+func (m *AddrInfo) XXX_Size() int {
+	return xxx_messageInfo_AddrInfo.Size(m)
+}
+func (m *AddrInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddrInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddrInfo proto.InternalMessageInfo
+
+func (m *AddrInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddrInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *AddrInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type AddrsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddrsRequest) Reset()         { *m = AddrsRequest{} }
+func (m *AddrsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddrsRequest) ProtoMessage()    {}
+func (*AddrsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{33}
+}
+
+func (m *AddrsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddrsRequest.Unmarshal(m, b)
+}
+func (m *AddrsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddrsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddrsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddrsRequest.Merge(m, src)
+}
+func (m *AddrsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddrsRequest.Size(m)
+}
+func (m *AddrsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddrsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddrsRequest proto.InternalMessageInfo
+
+type AddrsReply struct {
+	Addrs                []*AddrInfo `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	DefaultAddr          string      `protobuf:"bytes,2,opt,name=defaultAddr,proto3" json:"defaultAddr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AddrsReply) Reset()         { *m = AddrsReply{} }
+func (m *AddrsReply) String() string { return proto.CompactTextString(m) }
+func (*AddrsReply) ProtoMessage()    {}
+func (*AddrsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{34}
+}
+
+func (m *AddrsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddrsReply.Unmarshal(m, b)
+}
+func (m *AddrsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddrsReply.Marshal(b, m, deterministic)
+}
+func (m *AddrsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddrsReply.Merge(m, src)
+}
+func (m *AddrsReply) XXX_Size() int {
+	return xxx_messageInfo_AddrsReply.Size(m)
+}
+func (m *AddrsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddrsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddrsReply proto.InternalMessageInfo
+
+func (m *AddrsReply) GetAddrs() []*AddrInfo {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *AddrsReply) GetDefaultAddr() string {
+	if m != nil {
+		return m.DefaultAddr
+	}
+	return ""
+}
+
+type NewAddrRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AddressType          string   `protobuf:"bytes,2,opt,name=addressType,proto3" json:"addressType,omitempty"`
+	MakeDefault          bool     `protobuf:"varint,3,opt,name=makeDefault,proto3" json:"makeDefault,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NewAddrRequest) Reset()         { *m = NewAddrRequest{} }
+func (m *NewAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*NewAddrRequest) ProtoMessage()    {}
+func (*NewAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{35}
+}
+
+func (m *NewAddrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewAddrRequest.Unmarshal(m, b)
+}
+func (m *NewAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewAddrRequest.Marshal(b, m, deterministic)
+}
+func (m *NewAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAddrRequest.Merge(m, src)
+}
+func (m *NewAddrRequest) XXX_Size() int {
+	return xxx_messageInfo_NewAddrRequest.Size(m)
+}
+func (m *NewAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAddrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewAddrRequest proto.InternalMessageInfo
+
+func (m *NewAddrRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NewAddrRequest) GetAddressType() string {
+	if m != nil {
+		return m.AddressType
+	}
+	return ""
+}
+
+func (m *NewAddrRequest) GetMakeDefault() bool {
+	if m != nil {
+		return m.MakeDefault
+	}
+	return false
+}
+
+type NewAddrReply struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NewAddrReply) Reset()         { *m = NewAddrReply{} }
+func (m *NewAddrReply) String() string { return proto.CompactTextString(m) }
+func (*NewAddrReply) ProtoMessage()    {}
+func (*NewAddrReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{36}
+}
+
+func (m *NewAddrReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NewAddrReply.Unmarshal(m, b)
+}
+func (m *NewAddrReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NewAddrReply.Marshal(b, m, deterministic)
+}
+func (m *NewAddrReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewAddrReply.Merge(m, src)
+}
+func (m *NewAddrReply) XXX_Size() int {
+	return xxx_messageInfo_NewAddrReply.Size(m)
+}
+func (m *NewAddrReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewAddrReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewAddrReply proto.InternalMessageInfo
+
+func (m *NewAddrReply) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type SetDefaultAddrRequest struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDefaultAddrRequest) Reset()         { *m = SetDefaultAddrRequest{} }
+func (m *SetDefaultAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultAddrRequest) ProtoMessage()    {}
+func (*SetDefaultAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{37}
+}
+
+func (m *SetDefaultAddrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDefaultAddrRequest.Unmarshal(m, b)
+}
+func (m *SetDefaultAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDefaultAddrRequest.Marshal(b, m, deterministic)
+}
+func (m *SetDefaultAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultAddrRequest.Merge(m, src)
+}
+func (m *SetDefaultAddrRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDefaultAddrRequest.Size(m)
+}
+func (m *SetDefaultAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultAddrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDefaultAddrRequest proto.InternalMessageInfo
+
+func (m *SetDefaultAddrRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type SetDefaultAddrReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDefaultAddrReply) Reset()         { *m = SetDefaultAddrReply{} }
+func (m *SetDefaultAddrReply) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultAddrReply) ProtoMessage()    {}
+func (*SetDefaultAddrReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{38}
+}
+
+func (m *SetDefaultAddrReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDefaultAddrReply.Unmarshal(m, b)
+}
+func (m *SetDefaultAddrReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDefaultAddrReply.Marshal(b, m, deterministic)
+}
+func (m *SetDefaultAddrReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultAddrReply.Merge(m, src)
+}
+func (m *SetDefaultAddrReply) XXX_Size() int {
+	return xxx_messageInfo_SetDefaultAddrReply.Size(m)
+}
+func (m *SetDefaultAddrReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultAddrReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDefaultAddrReply proto.InternalMessageInfo
+
+type SendFilRequest struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendFilRequest) Reset()         { *m = SendFilRequest{} }
+func (m *SendFilRequest) String() string { return proto.CompactTextString(m) }
+func (*SendFilRequest) ProtoMessage()    {}
+func (*SendFilRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{39}
+}
+
+func (m *SendFilRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendFilRequest.Unmarshal(m, b)
+}
+func (m *SendFilRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendFilRequest.Marshal(b, m, deterministic)
+}
+func (m *SendFilRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendFilRequest.Merge(m, src)
+}
+func (m *SendFilRequest) XXX_Size() int {
+	return xxx_messageInfo_SendFilRequest.Size(m)
+}
+func (m *SendFilRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendFilRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendFilRequest proto.InternalMessageInfo
+
+func (m *SendFilRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *SendFilRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *SendFilRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type SendFilReply struct {
+	MessageCid           string   `protobuf:"bytes,1,opt,name=messageCid,proto3" json:"messageCid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendFilReply) Reset()         { *m = SendFilReply{} }
+func (m *SendFilReply) String() string { return proto.CompactTextString(m) }
+func (*SendFilReply) ProtoMessage()    {}
+func (*SendFilReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{40}
+}
+
+func (m *SendFilReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendFilReply.Unmarshal(m, b)
+}
+func (m *SendFilReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendFilReply.Marshal(b, m, deterministic)
+}
+func (m *SendFilReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendFilReply.Merge(m, src)
+}
+func (m *SendFilReply) XXX_Size() int {
+	return xxx_messageInfo_SendFilReply.Size(m)
+}
+func (m *SendFilReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendFilReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendFilReply proto.InternalMessageInfo
+
+func (m *SendFilReply) GetMessageCid() string {
+	if m != nil {
+		return m.MessageCid
+	}
+	return ""
+}
+
+type WaitMessageRequest struct {
+	MessageCid           string   `protobuf:"bytes,1,opt,name=messageCid,proto3" json:"messageCid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitMessageRequest) Reset()         { *m = WaitMessageRequest{} }
+func (m *WaitMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitMessageRequest) ProtoMessage()    {}
+func (*WaitMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{41}
+}
+
+func (m *WaitMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitMessageRequest.Unmarshal(m, b)
+}
+func (m *WaitMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *WaitMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitMessageRequest.Merge(m, src)
+}
+func (m *WaitMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_WaitMessageRequest.Size(m)
+}
+func (m *WaitMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitMessageRequest proto.InternalMessageInfo
+
+func (m *WaitMessageRequest) GetMessageCid() string {
+	if m != nil {
+		return m.MessageCid
+	}
+	return ""
+}
+
+type WaitMessageReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitMessageReply) Reset()         { *m = WaitMessageReply{} }
+func (m *WaitMessageReply) String() string { return proto.CompactTextString(m) }
+func (*WaitMessageReply) ProtoMessage()    {}
+func (*WaitMessageReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{42}
+}
+
+func (m *WaitMessageReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WaitMessageReply.Unmarshal(m, b)
+}
+func (m *WaitMessageReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WaitMessageReply.Marshal(b, m, deterministic)
+}
+func (m *WaitMessageReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitMessageReply.Merge(m, src)
+}
+func (m *WaitMessageReply) XXX_Size() int {
+	return xxx_messageInfo_WaitMessageReply.Size(m)
+}
+func (m *WaitMessageReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitMessageReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitMessageReply proto.InternalMessageInfo
+
+type GetDefaultCidConfigRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDefaultCidConfigRequest) Reset()         { *m = GetDefaultCidConfigRequest{} }
+func (m *GetDefaultCidConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDefaultCidConfigRequest) ProtoMessage()    {}
+func (*GetDefaultCidConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{43}
+}
+
+func (m *GetDefaultCidConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDefaultCidConfigRequest.Unmarshal(m, b)
+}
+func (m *GetDefaultCidConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDefaultCidConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDefaultCidConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDefaultCidConfigRequest.Merge(m, src)
+}
+func (m *GetDefaultCidConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDefaultCidConfigRequest.Size(m)
+}
+func (m *GetDefaultCidConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDefaultCidConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDefaultCidConfigRequest proto.InternalMessageInfo
+
+func (m *GetDefaultCidConfigRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type GetDefaultCidConfigReply struct {
+	Config               *CidConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetDefaultCidConfigReply) Reset()         { *m = GetDefaultCidConfigReply{} }
+func (m *GetDefaultCidConfigReply) String() string { return proto.CompactTextString(m) }
+func (*GetDefaultCidConfigReply) ProtoMessage()    {}
+func (*GetDefaultCidConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{44}
+}
+
+func (m *GetDefaultCidConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDefaultCidConfigReply.Unmarshal(m, b)
+}
+func (m *GetDefaultCidConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDefaultCidConfigReply.Marshal(b, m, deterministic)
+}
+func (m *GetDefaultCidConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDefaultCidConfigReply.Merge(m, src)
+}
+func (m *GetDefaultCidConfigReply) XXX_Size() int {
+	return xxx_messageInfo_GetDefaultCidConfigReply.Size(m)
+}
+func (m *GetDefaultCidConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDefaultCidConfigReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDefaultCidConfigReply proto.InternalMessageInfo
+
+func (m *GetDefaultCidConfigReply) GetConfig() *CidConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type GetCidConfigRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCidConfigRequest) Reset()         { *m = GetCidConfigRequest{} }
+func (m *GetCidConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCidConfigRequest) ProtoMessage()    {}
+func (*GetCidConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{45}
+}
+
+func (m *GetCidConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCidConfigRequest.Unmarshal(m, b)
+}
+func (m *GetCidConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCidConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCidConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCidConfigRequest.Merge(m, src)
+}
+func (m *GetCidConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCidConfigRequest.Size(m)
+}
+func (m *GetCidConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCidConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCidConfigRequest proto.InternalMessageInfo
+
+func (m *GetCidConfigRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type GetCidConfigReply struct {
+	Config               *CidConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetCidConfigReply) Reset()         { *m = GetCidConfigReply{} }
+func (m *GetCidConfigReply) String() string { return proto.CompactTextString(m) }
+func (*GetCidConfigReply) ProtoMessage()    {}
+func (*GetCidConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{46}
+}
+
+func (m *GetCidConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCidConfigReply.Unmarshal(m, b)
+}
+func (m *GetCidConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCidConfigReply.Marshal(b, m, deterministic)
+}
+func (m *GetCidConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCidConfigReply.Merge(m, src)
+}
+func (m *GetCidConfigReply) XXX_Size() int {
+	return xxx_messageInfo_GetCidConfigReply.Size(m)
+}
+func (m *GetCidConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCidConfigReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCidConfigReply proto.InternalMessageInfo
+
+func (m *GetCidConfigReply) GetConfig() *CidConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetDefaultCidConfigRequest struct {
+	Config               *DefaultCidConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetDefaultCidConfigRequest) Reset()         { *m = SetDefaultCidConfigRequest{} }
+func (m *SetDefaultCidConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultCidConfigRequest) ProtoMessage()    {}
+func (*SetDefaultCidConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{47}
+}
+
+func (m *SetDefaultCidConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDefaultCidConfigRequest.Unmarshal(m, b)
+}
+func (m *SetDefaultCidConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDefaultCidConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *SetDefaultCidConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultCidConfigRequest.Merge(m, src)
+}
+func (m *SetDefaultCidConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDefaultCidConfigRequest.Size(m)
+}
+func (m *SetDefaultCidConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultCidConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDefaultCidConfigRequest proto.InternalMessageInfo
+
+func (m *SetDefaultCidConfigRequest) GetConfig() *DefaultCidConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetDefaultCidConfigReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDefaultCidConfigReply) Reset()         { *m = SetDefaultCidConfigReply{} }
+func (m *SetDefaultCidConfigReply) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultCidConfigReply) ProtoMessage()    {}
+func (*SetDefaultCidConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{48}
+}
+
+func (m *SetDefaultCidConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDefaultCidConfigReply.Unmarshal(m, b)
+}
+func (m *SetDefaultCidConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDefaultCidConfigReply.Marshal(b, m, deterministic)
+}
+func (m *SetDefaultCidConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultCidConfigReply.Merge(m, src)
+}
+func (m *SetDefaultCidConfigReply) XXX_Size() int {
+	return xxx_messageInfo_SetDefaultCidConfigReply.Size(m)
+}
+func (m *SetDefaultCidConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultCidConfigReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDefaultCidConfigReply proto.InternalMessageInfo
+
+type ShowRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowRequest) Reset()         { *m = ShowRequest{} }
+func (m *ShowRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowRequest) ProtoMessage()    {}
+func (*ShowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{49}
+}
+
+func (m *ShowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowRequest.Unmarshal(m, b)
+}
+func (m *ShowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowRequest.Merge(m, src)
+}
+func (m *ShowRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowRequest.Size(m)
+}
+func (m *ShowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowRequest proto.InternalMessageInfo
+
+func (m *ShowRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type ShowReply struct {
+	CidInfo              *CidInfo `protobuf:"bytes,1,opt,name=cidInfo,proto3" json:"cidInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShowReply) Reset()         { *m = ShowReply{} }
+func (m *ShowReply) String() string { return proto.CompactTextString(m) }
+func (*ShowReply) ProtoMessage()    {}
+func (*ShowReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{50}
+}
+
+func (m *ShowReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowReply.Unmarshal(m, b)
+}
+func (m *ShowReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowReply.Marshal(b, m, deterministic)
+}
+func (m *ShowReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowReply.Merge(m, src)
+}
+func (m *ShowReply) XXX_Size() int {
+	return xxx_messageInfo_ShowReply.Size(m)
+}
+func (m *ShowReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowReply proto.InternalMessageInfo
+
+func (m *ShowReply) GetCidInfo() *CidInfo {
+	if m != nil {
+		return m.CidInfo
+	}
+	return nil
+}
+
+type InfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfoRequest) Reset()         { *m = InfoRequest{} }
+func (m *InfoRequest) String() string { return proto.CompactTextString(m) }
+func (*InfoRequest) ProtoMessage()    {}
+func (*InfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{51}
+}
+
+func (m *InfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfoRequest.Unmarshal(m, b)
+}
+func (m *InfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfoRequest.Marshal(b, m, deterministic)
+}
+func (m *InfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfoRequest.Merge(m, src)
+}
+func (m *InfoRequest) XXX_Size() int {
+	return xxx_messageInfo_InfoRequest.Size(m)
+}
+func (m *InfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfoRequest proto.InternalMessageInfo
+
+type InfoReply struct {
+	Info                 *InstanceInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *InfoReply) Reset()         { *m = InfoReply{} }
+func (m *InfoReply) String() string { return proto.CompactTextString(m) }
+func (*InfoReply) ProtoMessage()    {}
+func (*InfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{52}
+}
+
+func (m *InfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfoReply.Unmarshal(m, b)
+}
+func (m *InfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfoReply.Marshal(b, m, deterministic)
+}
+func (m *InfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfoReply.Merge(m, src)
+}
+func (m *InfoReply) XXX_Size() int {
+	return xxx_messageInfo_InfoReply.Size(m)
+}
+func (m *InfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfoReply proto.InternalMessageInfo
+
+func (m *InfoReply) GetInfo() *InstanceInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type WatchJobsRequest struct {
+	Jids                 []string `protobuf:"bytes,1,rep,name=jids,proto3" json:"jids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchJobsRequest) Reset()         { *m = WatchJobsRequest{} }
+func (m *WatchJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobsRequest) ProtoMessage()    {}
+func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{53}
+}
+
+func (m *WatchJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobsRequest.Unmarshal(m, b)
+}
+func (m *WatchJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobsRequest.Merge(m, src)
+}
+func (m *WatchJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchJobsRequest.Size(m)
+}
+func (m *WatchJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobsRequest proto.InternalMessageInfo
+
+func (m *WatchJobsRequest) GetJids() []string {
+	if m != nil {
+		return m.Jids
+	}
+	return nil
+}
+
+type WatchJobsReply struct {
+	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchJobsReply) Reset()         { *m = WatchJobsReply{} }
+func (m *WatchJobsReply) String() string { return proto.CompactTextString(m) }
+func (*WatchJobsReply) ProtoMessage()    {}
+func (*WatchJobsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{54}
+}
+
+func (m *WatchJobsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobsReply.Unmarshal(m, b)
+}
+func (m *WatchJobsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobsReply.Marshal(b, m, deterministic)
+}
+func (m *WatchJobsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobsReply.Merge(m, src)
+}
+func (m *WatchJobsReply) XXX_Size() int {
+	return xxx_messageInfo_WatchJobsReply.Size(m)
+}
+func (m *WatchJobsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobsReply proto.InternalMessageInfo
+
+func (m *WatchJobsReply) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type WatchLogsRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Jid                  string   `protobuf:"bytes,2,opt,name=jid,proto3" json:"jid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchLogsRequest) Reset()         { *m = WatchLogsRequest{} }
+func (m *WatchLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchLogsRequest) ProtoMessage()    {}
+func (*WatchLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{55}
+}
+
+func (m *WatchLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchLogsRequest.Unmarshal(m, b)
+}
+func (m *WatchLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchLogsRequest.Merge(m, src)
+}
+func (m *WatchLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchLogsRequest.Size(m)
+}
+func (m *WatchLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchLogsRequest proto.InternalMessageInfo
+
+func (m *WatchLogsRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *WatchLogsRequest) GetJid() string {
+	if m != nil {
+		return m.Jid
+	}
+	return ""
+}
+
+type WatchLogsReply struct {
+	LogEntry             *LogEntry `protobuf:"bytes,1,opt,name=logEntry,proto3" json:"logEntry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *WatchLogsReply) Reset()         { *m = WatchLogsReply{} }
+func (m *WatchLogsReply) String() string { return proto.CompactTextString(m) }
+func (*WatchLogsReply) ProtoMessage()    {}
+func (*WatchLogsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{56}
+}
+
+func (m *WatchLogsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchLogsReply.Unmarshal(m, b)
+}
+func (m *WatchLogsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchLogsReply.Marshal(b, m, deterministic)
+}
+func (m *WatchLogsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchLogsReply.Merge(m, src)
+}
+func (m *WatchLogsReply) XXX_Size() int {
+	return xxx_messageInfo_WatchLogsReply.Size(m)
+}
+func (m *WatchLogsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchLogsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchLogsReply proto.InternalMessageInfo
+
+func (m *WatchLogsReply) GetLogEntry() *LogEntry {
+	if m != nil {
+		return m.LogEntry
+	}
+	return nil
+}
+
+type LogEntry struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Jid                  string   `protobuf:"bytes,2,opt,name=jid,proto3" json:"jid,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Msg                  string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Event                string   `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogEntry) Reset()         { *m = LogEntry{} }
+func (m *LogEntry) String() string { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()    {}
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{57}
+}
 
-func (m *IDReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IDReply.Unmarshal(m, b)
+func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogEntry.Unmarshal(m, b)
 }
-func (m *IDReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IDReply.Marshal(b, m, deterministic)
+func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
 }
-func (m *IDReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IDReply.Merge(m, src)
+func (m *LogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntry.Merge(m, src)
 }
-func (m *IDReply) XXX_Size() int {
-	return xxx_messageInfo_IDReply.Size(m)
+func (m *LogEntry) XXX_Size() int {
+	return xxx_messageInfo_LogEntry.Size(m)
 }
-func (m *IDReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_IDReply.DiscardUnknown(m)
+func (m *LogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IDReply proto.InternalMessageInfo
+var xxx_messageInfo_LogEntry proto.InternalMessageInfo
 
-func (m *IDReply) GetID() string {
+func (m *LogEntry) GetCid() string {
 	if m != nil {
-		return m.ID
+		return m.Cid
 	}
 	return ""
 }
 
-type WalletAddrRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LogEntry) GetJid() string {
+	if m != nil {
+		return m.Jid
+	}
+	return ""
 }
 
-func (m *WalletAddrRequest) Reset()         { *m = WalletAddrRequest{} }
-func (m *WalletAddrRequest) String() string { return proto.CompactTextString(m) }
-func (*WalletAddrRequest) ProtoMessage()    {}
-func (*WalletAddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{20}
+func (m *LogEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-func (m *WalletAddrRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletAddrRequest.Unmarshal(m, b)
-}
-func (m *WalletAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletAddrRequest.Marshal(b, m, deterministic)
-}
-func (m *WalletAddrRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletAddrRequest.Merge(m, src)
-}
-func (m *WalletAddrRequest) XXX_Size() int {
-	return xxx_messageInfo_WalletAddrRequest.Size(m)
-}
-func (m *WalletAddrRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletAddrRequest.DiscardUnknown(m)
+func (m *LogEntry) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-var xxx_messageInfo_WalletAddrRequest proto.InternalMessageInfo
+func (m *LogEntry) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
 
-type WalletAddrReply struct {
-	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PushConfigRequest struct {
+	Cid                  string     `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Config               *CidConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	HasConfig            bool       `protobuf:"varint,3,opt,name=hasConfig,proto3" json:"hasConfig,omitempty"`
+	OverrideConfig       bool       `protobuf:"varint,4,opt,name=overrideConfig,proto3" json:"overrideConfig,omitempty"`
+	HasOverrideConfig    bool       `protobuf:"varint,5,opt,name=hasOverrideConfig,proto3" json:"hasOverrideConfig,omitempty"`
+	Priority             int64      `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *WalletAddrReply) Reset()         { *m = WalletAddrReply{} }
-func (m *WalletAddrReply) String() string { return proto.CompactTextString(m) }
-func (*WalletAddrReply) ProtoMessage()    {}
-func (*WalletAddrReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{21}
+func (m *PushConfigRequest) Reset()         { *m = PushConfigRequest{} }
+func (m *PushConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*PushConfigRequest) ProtoMessage()    {}
+func (*PushConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{58}
 }
 
-func (m *WalletAddrReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletAddrReply.Unmarshal(m, b)
+func (m *PushConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushConfigRequest.Unmarshal(m, b)
 }
-func (m *WalletAddrReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletAddrReply.Marshal(b, m, deterministic)
+func (m *PushConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushConfigRequest.Marshal(b, m, deterministic)
 }
-func (m *WalletAddrReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletAddrReply.Merge(m, src)
+func (m *PushConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushConfigRequest.Merge(m, src)
 }
-func (m *WalletAddrReply) XXX_Size() int {
-	return xxx_messageInfo_WalletAddrReply.Size(m)
+func (m *PushConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_PushConfigRequest.Size(m)
 }
-func (m *WalletAddrReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletAddrReply.DiscardUnknown(m)
+func (m *PushConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushConfigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalletAddrReply proto.InternalMessageInfo
+var xxx_messageInfo_PushConfigRequest proto.InternalMessageInfo
 
-func (m *WalletAddrReply) GetAddr() string {
+func (m *PushConfigRequest) GetCid() string {
 	if m != nil {
-		return m.Addr
+		return m.Cid
 	}
 	return ""
 }
 
-type GetDefaultCidConfigRequest struct {
-	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+func (m *PushConfigRequest) GetConfig() *CidConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PushConfigRequest) GetHasConfig() bool {
+	if m != nil {
+		return m.HasConfig
+	}
+	return false
+}
+
+func (m *PushConfigRequest) GetOverrideConfig() bool {
+	if m != nil {
+		return m.OverrideConfig
+	}
+	return false
+}
+
+func (m *PushConfigRequest) GetHasOverrideConfig() bool {
+	if m != nil {
+		return m.HasOverrideConfig
+	}
+	return false
+}
+
+func (m *PushConfigRequest) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type PushConfigReply struct {
+	JobID                string   `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDefaultCidConfigRequest) Reset()         { *m = GetDefaultCidConfigRequest{} }
-func (m *GetDefaultCidConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDefaultCidConfigRequest) ProtoMessage()    {}
-func (*GetDefaultCidConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{22}
+func (m *PushConfigReply) Reset()         { *m = PushConfigReply{} }
+func (m *PushConfigReply) String() string { return proto.CompactTextString(m) }
+func (*PushConfigReply) ProtoMessage()    {}
+func (*PushConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{59}
 }
 
-func (m *GetDefaultCidConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDefaultCidConfigRequest.Unmarshal(m, b)
+func (m *PushConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushConfigReply.Unmarshal(m, b)
 }
-func (m *GetDefaultCidConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDefaultCidConfigRequest.Marshal(b, m, deterministic)
+func (m *PushConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushConfigReply.Marshal(b, m, deterministic)
 }
-func (m *GetDefaultCidConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDefaultCidConfigRequest.Merge(m, src)
+func (m *PushConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushConfigReply.Merge(m, src)
 }
-func (m *GetDefaultCidConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_GetDefaultCidConfigRequest.Size(m)
+func (m *PushConfigReply) XXX_Size() int {
+	return xxx_messageInfo_PushConfigReply.Size(m)
 }
-func (m *GetDefaultCidConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDefaultCidConfigRequest.DiscardUnknown(m)
+func (m *PushConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushConfigReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDefaultCidConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_PushConfigReply proto.InternalMessageInfo
 
-func (m *GetDefaultCidConfigRequest) GetCid() string {
+func (m *PushConfigReply) GetJobID() string {
 	if m != nil {
-		return m.Cid
+		return m.JobID
 	}
 	return ""
 }
 
-type GetDefaultCidConfigReply struct {
-	Config               *CidConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+type PlanConfigRequest struct {
+	Cid                  string     `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Config               *CidConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	HasConfig            bool       `protobuf:"varint,3,opt,name=hasConfig,proto3" json:"hasConfig,omitempty"`
+	OverrideConfig       bool       `protobuf:"varint,4,opt,name=overrideConfig,proto3" json:"overrideConfig,omitempty"`
+	HasOverrideConfig    bool       `protobuf:"varint,5,opt,name=hasOverrideConfig,proto3" json:"hasOverrideConfig,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetDefaultCidConfigReply) Reset()         { *m = GetDefaultCidConfigReply{} }
-func (m *GetDefaultCidConfigReply) String() string { return proto.CompactTextString(m) }
-func (*GetDefaultCidConfigReply) ProtoMessage()    {}
-func (*GetDefaultCidConfigReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{23}
+func (m *PlanConfigRequest) Reset()         { *m = PlanConfigRequest{} }
+func (m *PlanConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanConfigRequest) ProtoMessage()    {}
+func (*PlanConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{60}
 }
 
-func (m *GetDefaultCidConfigReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDefaultCidConfigReply.Unmarshal(m, b)
+func (m *PlanConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanConfigRequest.Unmarshal(m, b)
 }
-func (m *GetDefaultCidConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDefaultCidConfigReply.Marshal(b, m, deterministic)
+func (m *PlanConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanConfigRequest.Marshal(b, m, deterministic)
 }
-func (m *GetDefaultCidConfigReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDefaultCidConfigReply.Merge(m, src)
+func (m *PlanConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanConfigRequest.Merge(m, src)
 }
-func (m *GetDefaultCidConfigReply) XXX_Size() int {
-	return xxx_messageInfo_GetDefaultCidConfigReply.Size(m)
+func (m *PlanConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_PlanConfigRequest.Size(m)
 }
-func (m *GetDefaultCidConfigReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDefaultCidConfigReply.DiscardUnknown(m)
+func (m *PlanConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanConfigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDefaultCidConfigReply proto.InternalMessageInfo
+var xxx_messageInfo_PlanConfigRequest proto.InternalMessageInfo
 
-func (m *GetDefaultCidConfigReply) GetConfig() *CidConfig {
+func (m *PlanConfigRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *PlanConfigRequest) GetConfig() *CidConfig {
 	if m != nil {
 		return m.Config
 	}
 	return nil
 }
 
-type GetCidConfigRequest struct {
-	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PlanConfigRequest) GetHasConfig() bool {
+	if m != nil {
+		return m.HasConfig
+	}
+	return false
 }
 
-func (m *GetCidConfigRequest) Reset()         { *m = GetCidConfigRequest{} }
-func (m *GetCidConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCidConfigRequest) ProtoMessage()    {}
-func (*GetCidConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{24}
+func (m *PlanConfigRequest) GetOverrideConfig() bool {
+	if m != nil {
+		return m.OverrideConfig
+	}
+	return false
 }
 
-func (m *GetCidConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCidConfigRequest.Unmarshal(m, b)
+func (m *PlanConfigRequest) GetHasOverrideConfig() bool {
+	if m != nil {
+		return m.HasOverrideConfig
+	}
+	return false
 }
-func (m *GetCidConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCidConfigRequest.Marshal(b, m, deterministic)
+
+type PlanConfigReply struct {
+	Plan                 *StoragePlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PlanConfigReply) Reset()         { *m = PlanConfigReply{} }
+func (m *PlanConfigReply) String() string { return proto.CompactTextString(m) }
+func (*PlanConfigReply) ProtoMessage()    {}
+func (*PlanConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{61}
+}
+
+func (m *PlanConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanConfigReply.Unmarshal(m, b)
 }
-func (m *GetCidConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCidConfigRequest.Merge(m, src)
+func (m *PlanConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanConfigReply.Marshal(b, m, deterministic)
 }
-func (m *GetCidConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCidConfigRequest.Size(m)
+func (m *PlanConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanConfigReply.Merge(m, src)
 }
-func (m *GetCidConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCidConfigRequest.DiscardUnknown(m)
+func (m *PlanConfigReply) XXX_Size() int {
+	return xxx_messageInfo_PlanConfigReply.Size(m)
+}
+func (m *PlanConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanConfigReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCidConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_PlanConfigReply proto.InternalMessageInfo
 
-func (m *GetCidConfigRequest) GetCid() string {
+func (m *PlanConfigReply) GetPlan() *StoragePlan {
 	if m != nil {
-		return m.Cid
+		return m.Plan
 	}
-	return ""
+	return nil
 }
 
-type GetCidConfigReply struct {
-	Config               *CidConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCidConfigReply) Reset()         { *m = GetCidConfigReply{} }
-func (m *GetCidConfigReply) String() string { return proto.CompactTextString(m) }
-func (*GetCidConfigReply) ProtoMessage()    {}
-func (*GetCidConfigReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{25}
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{62}
 }
 
-func (m *GetCidConfigReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCidConfigReply.Unmarshal(m, b)
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
 }
-func (m *GetCidConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCidConfigReply.Marshal(b, m, deterministic)
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCidConfigReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCidConfigReply.Merge(m, src)
+func (m *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(m, src)
 }
-func (m *GetCidConfigReply) XXX_Size() int {
-	return xxx_messageInfo_GetCidConfigReply.Size(m)
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
 }
-func (m *GetCidConfigReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCidConfigReply.DiscardUnknown(m)
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCidConfigReply proto.InternalMessageInfo
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
 
-func (m *GetCidConfigReply) GetConfig() *CidConfig {
+func (m *GetRequest) GetCid() string {
 	if m != nil {
-		return m.Config
+		return m.Cid
 	}
-	return nil
+	return ""
 }
 
-type SetDefaultCidConfigRequest struct {
-	Config               *DefaultCidConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type GetReply struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetDefaultCidConfigRequest) Reset()         { *m = SetDefaultCidConfigRequest{} }
-func (m *SetDefaultCidConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*SetDefaultCidConfigRequest) ProtoMessage()    {}
-func (*SetDefaultCidConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{26}
+func (m *GetReply) Reset()         { *m = GetReply{} }
+func (m *GetReply) String() string { return proto.CompactTextString(m) }
+func (*GetReply) ProtoMessage()    {}
+func (*GetReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{63}
 }
 
-func (m *SetDefaultCidConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetDefaultCidConfigRequest.Unmarshal(m, b)
+func (m *GetReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReply.Unmarshal(m, b)
 }
-func (m *SetDefaultCidConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetDefaultCidConfigRequest.Marshal(b, m, deterministic)
+func (m *GetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReply.Marshal(b, m, deterministic)
 }
-func (m *SetDefaultCidConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetDefaultCidConfigRequest.Merge(m, src)
+func (m *GetReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReply.Merge(m, src)
 }
-func (m *SetDefaultCidConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_SetDefaultCidConfigRequest.Size(m)
+func (m *GetReply) XXX_Size() int {
+	return xxx_messageInfo_GetReply.Size(m)
 }
-func (m *SetDefaultCidConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetDefaultCidConfigRequest.DiscardUnknown(m)
+func (m *GetReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetDefaultCidConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetReply proto.InternalMessageInfo
 
-func (m *SetDefaultCidConfigRequest) GetConfig() *DefaultCidConfig {
+func (m *GetReply) GetChunk() []byte {
 	if m != nil {
-		return m.Config
+		return m.Chunk
 	}
 	return nil
 }
 
-type SetDefaultCidConfigReply struct {
+type GetColdRequest struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetDefaultCidConfigReply) Reset()         { *m = SetDefaultCidConfigReply{} }
-func (m *SetDefaultCidConfigReply) String() string { return proto.CompactTextString(m) }
-func (*SetDefaultCidConfigReply) ProtoMessage()    {}
-func (*SetDefaultCidConfigReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{27}
+func (m *GetColdRequest) Reset()         { *m = GetColdRequest{} }
+func (m *GetColdRequest) String() string { return proto.CompactTextString(m) }
+func (*GetColdRequest) ProtoMessage()    {}
+func (*GetColdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{64}
 }
 
-func (m *SetDefaultCidConfigReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetDefaultCidConfigReply.Unmarshal(m, b)
+func (m *GetColdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetColdRequest.Unmarshal(m, b)
 }
-func (m *SetDefaultCidConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetDefaultCidConfigReply.Marshal(b, m, deterministic)
+func (m *GetColdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetColdRequest.Marshal(b, m, deterministic)
 }
-func (m *SetDefaultCidConfigReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetDefaultCidConfigReply.Merge(m, src)
+func (m *GetColdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetColdRequest.Merge(m, src)
 }
-func (m *SetDefaultCidConfigReply) XXX_Size() int {
-	return xxx_messageInfo_SetDefaultCidConfigReply.Size(m)
+func (m *GetColdRequest) XXX_Size() int {
+	return xxx_messageInfo_GetColdRequest.Size(m)
 }
-func (m *SetDefaultCidConfigReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetDefaultCidConfigReply.DiscardUnknown(m)
+func (m *GetColdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetColdRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetDefaultCidConfigReply proto.InternalMessageInfo
+var xxx_messageInfo_GetColdRequest proto.InternalMessageInfo
 
-type ShowRequest struct {
-	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+func (m *GetColdRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type GetColdReply struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowRequest) Reset()         { *m = ShowRequest{} }
-func (m *ShowRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowRequest) ProtoMessage()    {}
-func (*ShowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{28}
+func (m *GetColdReply) Reset()         { *m = GetColdReply{} }
+func (m *GetColdReply) String() string { return proto.CompactTextString(m) }
+func (*GetColdReply) ProtoMessage()    {}
+func (*GetColdReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{65}
 }
 
-func (m *ShowRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowRequest.Unmarshal(m, b)
+func (m *GetColdReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetColdReply.Unmarshal(m, b)
 }
-func (m *ShowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowRequest.Marshal(b, m, deterministic)
+func (m *GetColdReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetColdReply.Marshal(b, m, deterministic)
 }
-func (m *ShowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowRequest.Merge(m, src)
+func (m *GetColdReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetColdReply.Merge(m, src)
 }
-func (m *ShowRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowRequest.Size(m)
+func (m *GetColdReply) XXX_Size() int {
+	return xxx_messageInfo_GetColdReply.Size(m)
 }
-func (m *ShowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowRequest.DiscardUnknown(m)
+func (m *GetColdReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetColdReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetColdReply proto.InternalMessageInfo
 
-func (m *ShowRequest) GetCid() string {
+func (m *GetColdReply) GetChunk() []byte {
 	if m != nil {
-		return m.Cid
+		return m.Chunk
 	}
-	return ""
+	return nil
 }
 
-type ShowReply struct {
-	CidInfo              *CidInfo `protobuf:"bytes,1,opt,name=cidInfo,proto3" json:"cidInfo,omitempty"`
+type CancelJobRequest struct {
+	Jid                  string   `protobuf:"bytes,1,opt,name=jid,proto3" json:"jid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowReply) Reset()         { *m = ShowReply{} }
-func (m *ShowReply) String() string { return proto.CompactTextString(m) }
-func (*ShowReply) ProtoMessage()    {}
-func (*ShowReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{29}
+func (m *CancelJobRequest) Reset()         { *m = CancelJobRequest{} }
+func (m *CancelJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelJobRequest) ProtoMessage()    {}
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{66}
 }
 
-func (m *ShowReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowReply.Unmarshal(m, b)
+func (m *CancelJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelJobRequest.Unmarshal(m, b)
 }
-func (m *ShowReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowReply.Marshal(b, m, deterministic)
+func (m *CancelJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelJobRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowReply.Merge(m, src)
+func (m *CancelJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobRequest.Merge(m, src)
 }
-func (m *ShowReply) XXX_Size() int {
-	return xxx_messageInfo_ShowReply.Size(m)
+func (m *CancelJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelJobRequest.Size(m)
 }
-func (m *ShowReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowReply.DiscardUnknown(m)
+func (m *CancelJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowReply proto.InternalMessageInfo
+var xxx_messageInfo_CancelJobRequest proto.InternalMessageInfo
 
-func (m *ShowReply) GetCidInfo() *CidInfo {
+func (m *CancelJobRequest) GetJid() string {
 	if m != nil {
-		return m.CidInfo
+		return m.Jid
 	}
-	return nil
+	return ""
 }
 
-type InfoRequest struct {
+type CancelJobReply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InfoRequest) Reset()         { *m = InfoRequest{} }
-func (m *InfoRequest) String() string { return proto.CompactTextString(m) }
-func (*InfoRequest) ProtoMessage()    {}
-func (*InfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{30}
+func (m *CancelJobReply) Reset()         { *m = CancelJobReply{} }
+func (m *CancelJobReply) String() string { return proto.CompactTextString(m) }
+func (*CancelJobReply) ProtoMessage()    {}
+func (*CancelJobReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{67}
 }
 
-func (m *InfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InfoRequest.Unmarshal(m, b)
+func (m *CancelJobReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelJobReply.Unmarshal(m, b)
 }
-func (m *InfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InfoRequest.Marshal(b, m, deterministic)
+func (m *CancelJobReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelJobReply.Marshal(b, m, deterministic)
 }
-func (m *InfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InfoRequest.Merge(m, src)
+func (m *CancelJobReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelJobReply.Merge(m, src)
 }
-func (m *InfoRequest) XXX_Size() int {
-	return xxx_messageInfo_InfoRequest.Size(m)
+func (m *CancelJobReply) XXX_Size() int {
+	return xxx_messageInfo_CancelJobReply.Size(m)
 }
-func (m *InfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InfoRequest.DiscardUnknown(m)
+func (m *CancelJobReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelJobReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_CancelJobReply proto.InternalMessageInfo
 
-type InfoReply struct {
-	Info                 *InstanceInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type ListJobsRequest struct {
+	Cid                  string      `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Statuses             []JobStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=rpc.JobStatus" json:"statuses,omitempty"`
+	CreatedFrom          int64       `protobuf:"varint,3,opt,name=createdFrom,proto3" json:"createdFrom,omitempty"`
+	CreatedTo            int64       `protobuf:"varint,4,opt,name=createdTo,proto3" json:"createdTo,omitempty"`
+	Offset               int64       `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int64       `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *InfoReply) Reset()         { *m = InfoReply{} }
-func (m *InfoReply) String() string { return proto.CompactTextString(m) }
-func (*InfoReply) ProtoMessage()    {}
-func (*InfoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{31}
+func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()    {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{68}
 }
 
-func (m *InfoReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InfoReply.Unmarshal(m, b)
+func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsRequest.Unmarshal(m, b)
 }
-func (m *InfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InfoReply.Marshal(b, m, deterministic)
+func (m *ListJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsRequest.Marshal(b, m, deterministic)
 }
-func (m *InfoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InfoReply.Merge(m, src)
+func (m *ListJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRequest.Merge(m, src)
 }
-func (m *InfoReply) XXX_Size() int {
-	return xxx_messageInfo_InfoReply.Size(m)
+func (m *ListJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJobsRequest.Size(m)
 }
-func (m *InfoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_InfoReply.DiscardUnknown(m)
+func (m *ListJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InfoReply proto.InternalMessageInfo
+var xxx_messageInfo_ListJobsRequest proto.InternalMessageInfo
 
-func (m *InfoReply) GetInfo() *InstanceInfo {
+func (m *ListJobsRequest) GetCid() string {
 	if m != nil {
-		return m.Info
+		return m.Cid
 	}
-	return nil
+	return ""
 }
 
-type WatchJobsRequest struct {
-	Jids                 []string `protobuf:"bytes,1,rep,name=jids,proto3" json:"jids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListJobsRequest) GetStatuses() []JobStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
 }
 
-func (m *WatchJobsRequest) Reset()         { *m = WatchJobsRequest{} }
-func (m *WatchJobsRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchJobsRequest) ProtoMessage()    {}
-func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{32}
+func (m *ListJobsRequest) GetCreatedFrom() int64 {
+	if m != nil {
+		return m.CreatedFrom
+	}
+	return 0
 }
 
-func (m *WatchJobsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchJobsRequest.Unmarshal(m, b)
-}
-func (m *WatchJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchJobsRequest.Marshal(b, m, deterministic)
-}
-func (m *WatchJobsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchJobsRequest.Merge(m, src)
-}
-func (m *WatchJobsRequest) XXX_Size() int {
-	return xxx_messageInfo_WatchJobsRequest.Size(m)
+func (m *ListJobsRequest) GetCreatedTo() int64 {
+	if m != nil {
+		return m.CreatedTo
+	}
+	return 0
 }
-func (m *WatchJobsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchJobsRequest.DiscardUnknown(m)
+
+func (m *ListJobsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-var xxx_messageInfo_WatchJobsRequest proto.InternalMessageInfo
-
-func (m *WatchJobsRequest) GetJids() []string {
+func (m *ListJobsRequest) GetLimit() int64 {
 	if m != nil {
-		return m.Jids
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-type WatchJobsReply struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+type ListJobsReply struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchJobsReply) Reset()         { *m = WatchJobsReply{} }
-func (m *WatchJobsReply) String() string { return proto.CompactTextString(m) }
-func (*WatchJobsReply) ProtoMessage()    {}
-func (*WatchJobsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{33}
+func (m *ListJobsReply) Reset()         { *m = ListJobsReply{} }
+func (m *ListJobsReply) String() string { return proto.CompactTextString(m) }
+func (*ListJobsReply) ProtoMessage()    {}
+func (*ListJobsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{69}
 }
 
-func (m *WatchJobsReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchJobsReply.Unmarshal(m, b)
+func (m *ListJobsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsReply.Unmarshal(m, b)
 }
-func (m *WatchJobsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchJobsReply.Marshal(b, m, deterministic)
+func (m *ListJobsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsReply.Marshal(b, m, deterministic)
 }
-func (m *WatchJobsReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchJobsReply.Merge(m, src)
+func (m *ListJobsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsReply.Merge(m, src)
 }
-func (m *WatchJobsReply) XXX_Size() int {
-	return xxx_messageInfo_WatchJobsReply.Size(m)
+func (m *ListJobsReply) XXX_Size() int {
+	return xxx_messageInfo_ListJobsReply.Size(m)
 }
-func (m *WatchJobsReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchJobsReply.DiscardUnknown(m)
+func (m *ListJobsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchJobsReply proto.InternalMessageInfo
+var xxx_messageInfo_ListJobsReply proto.InternalMessageInfo
 
-func (m *WatchJobsReply) GetJob() *Job {
+func (m *ListJobsReply) GetJobs() []*Job {
 	if m != nil {
-		return m.Job
+		return m.Jobs
 	}
 	return nil
 }
 
-type WatchLogsRequest struct {
+type SpendRecord struct {
 	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Jid                  string   `protobuf:"bytes,2,opt,name=jid,proto3" json:"jid,omitempty"`
+	ProposalCid          string   `protobuf:"bytes,2,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	Miner                string   `protobuf:"bytes,3,opt,name=miner,proto3" json:"miner,omitempty"`
+	Amount               uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Time                 int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchLogsRequest) Reset()         { *m = WatchLogsRequest{} }
-func (m *WatchLogsRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchLogsRequest) ProtoMessage()    {}
-func (*WatchLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{34}
+func (m *SpendRecord) Reset()         { *m = SpendRecord{} }
+func (m *SpendRecord) String() string { return proto.CompactTextString(m) }
+func (*SpendRecord) ProtoMessage()    {}
+func (*SpendRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{70}
 }
 
-func (m *WatchLogsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchLogsRequest.Unmarshal(m, b)
+func (m *SpendRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendRecord.Unmarshal(m, b)
 }
-func (m *WatchLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchLogsRequest.Marshal(b, m, deterministic)
+func (m *SpendRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendRecord.Marshal(b, m, deterministic)
 }
-func (m *WatchLogsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchLogsRequest.Merge(m, src)
+func (m *SpendRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendRecord.Merge(m, src)
 }
-func (m *WatchLogsRequest) XXX_Size() int {
-	return xxx_messageInfo_WatchLogsRequest.Size(m)
+func (m *SpendRecord) XXX_Size() int {
+	return xxx_messageInfo_SpendRecord.Size(m)
 }
-func (m *WatchLogsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchLogsRequest.DiscardUnknown(m)
+func (m *SpendRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchLogsRequest proto.InternalMessageInfo
+var xxx_messageInfo_SpendRecord proto.InternalMessageInfo
 
-func (m *WatchLogsRequest) GetCid() string {
+func (m *SpendRecord) GetCid() string {
 	if m != nil {
 		return m.Cid
 	}
 	return ""
 }
 
-func (m *WatchLogsRequest) GetJid() string {
+func (m *SpendRecord) GetProposalCid() string {
 	if m != nil {
-		return m.Jid
+		return m.ProposalCid
 	}
 	return ""
 }
 
-type WatchLogsReply struct {
-	LogEntry             *LogEntry `protobuf:"bytes,1,opt,name=logEntry,proto3" json:"logEntry,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *WatchLogsReply) Reset()         { *m = WatchLogsReply{} }
-func (m *WatchLogsReply) String() string { return proto.CompactTextString(m) }
-func (*WatchLogsReply) ProtoMessage()    {}
-func (*WatchLogsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{35}
+func (m *SpendRecord) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
 }
 
-func (m *WatchLogsReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WatchLogsReply.Unmarshal(m, b)
-}
-func (m *WatchLogsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WatchLogsReply.Marshal(b, m, deterministic)
-}
-func (m *WatchLogsReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchLogsReply.Merge(m, src)
-}
-func (m *WatchLogsReply) XXX_Size() int {
-	return xxx_messageInfo_WatchLogsReply.Size(m)
-}
-func (m *WatchLogsReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchLogsReply.DiscardUnknown(m)
+func (m *SpendRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-var xxx_messageInfo_WatchLogsReply proto.InternalMessageInfo
-
-func (m *WatchLogsReply) GetLogEntry() *LogEntry {
+func (m *SpendRecord) GetTime() int64 {
 	if m != nil {
-		return m.LogEntry
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-type LogEntry struct {
+type SpendRequest struct {
 	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Jid                  string   `protobuf:"bytes,2,opt,name=jid,proto3" json:"jid,omitempty"`
-	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
-	Msg                  string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Miner                string   `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	From                 int64    `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LogEntry) Reset()         { *m = LogEntry{} }
-func (m *LogEntry) String() string { return proto.CompactTextString(m) }
-func (*LogEntry) ProtoMessage()    {}
-func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{36}
+func (m *SpendRequest) Reset()         { *m = SpendRequest{} }
+func (m *SpendRequest) String() string { return proto.CompactTextString(m) }
+func (*SpendRequest) ProtoMessage()    {}
+func (*SpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{71}
 }
 
-func (m *LogEntry) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LogEntry.Unmarshal(m, b)
+func (m *SpendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendRequest.Unmarshal(m, b)
 }
-func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
+func (m *SpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendRequest.Marshal(b, m, deterministic)
 }
-func (m *LogEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogEntry.Merge(m, src)
+func (m *SpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendRequest.Merge(m, src)
 }
-func (m *LogEntry) XXX_Size() int {
-	return xxx_messageInfo_LogEntry.Size(m)
+func (m *SpendRequest) XXX_Size() int {
+	return xxx_messageInfo_SpendRequest.Size(m)
 }
-func (m *LogEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogEntry.DiscardUnknown(m)
+func (m *SpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+var xxx_messageInfo_SpendRequest proto.InternalMessageInfo
 
-func (m *LogEntry) GetCid() string {
+func (m *SpendRequest) GetCid() string {
 	if m != nil {
 		return m.Cid
 	}
 	return ""
 }
 
-func (m *LogEntry) GetJid() string {
+func (m *SpendRequest) GetMiner() string {
 	if m != nil {
-		return m.Jid
+		return m.Miner
 	}
 	return ""
 }
 
-func (m *LogEntry) GetTime() int64 {
+func (m *SpendRequest) GetFrom() int64 {
 	if m != nil {
-		return m.Time
+		return m.From
 	}
 	return 0
 }
 
-func (m *LogEntry) GetMsg() string {
+func (m *SpendRequest) GetTo() int64 {
 	if m != nil {
-		return m.Msg
+		return m.To
 	}
-	return ""
+	return 0
 }
 
-type PushConfigRequest struct {
-	Cid                  string     `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Config               *CidConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	HasConfig            bool       `protobuf:"varint,3,opt,name=hasConfig,proto3" json:"hasConfig,omitempty"`
-	OverrideConfig       bool       `protobuf:"varint,4,opt,name=overrideConfig,proto3" json:"overrideConfig,omitempty"`
-	HasOverrideConfig    bool       `protobuf:"varint,5,opt,name=hasOverrideConfig,proto3" json:"hasOverrideConfig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type SpendReply struct {
+	Total                uint64            `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	ByCid                map[string]uint64 `protobuf:"bytes,2,rep,name=byCid,proto3" json:"byCid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ByMiner              map[string]uint64 `protobuf:"bytes,3,rep,name=byMiner,proto3" json:"byMiner,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ByDay                map[string]uint64 `protobuf:"bytes,4,rep,name=byDay,proto3" json:"byDay,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Records              []*SpendRecord    `protobuf:"bytes,5,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PushConfigRequest) Reset()         { *m = PushConfigRequest{} }
-func (m *PushConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*PushConfigRequest) ProtoMessage()    {}
-func (*PushConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{37}
+func (m *SpendReply) Reset()         { *m = SpendReply{} }
+func (m *SpendReply) String() string { return proto.CompactTextString(m) }
+func (*SpendReply) ProtoMessage()    {}
+func (*SpendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{72}
 }
 
-func (m *PushConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PushConfigRequest.Unmarshal(m, b)
+func (m *SpendReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendReply.Unmarshal(m, b)
 }
-func (m *PushConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PushConfigRequest.Marshal(b, m, deterministic)
+func (m *SpendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendReply.Marshal(b, m, deterministic)
 }
-func (m *PushConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushConfigRequest.Merge(m, src)
+func (m *SpendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendReply.Merge(m, src)
 }
-func (m *PushConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_PushConfigRequest.Size(m)
+func (m *SpendReply) XXX_Size() int {
+	return xxx_messageInfo_SpendReply.Size(m)
 }
-func (m *PushConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushConfigRequest.DiscardUnknown(m)
+func (m *SpendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_SpendReply proto.InternalMessageInfo
 
-func (m *PushConfigRequest) GetCid() string {
+func (m *SpendReply) GetTotal() uint64 {
 	if m != nil {
-		return m.Cid
+		return m.Total
 	}
-	return ""
+	return 0
 }
 
-func (m *PushConfigRequest) GetConfig() *CidConfig {
+func (m *SpendReply) GetByCid() map[string]uint64 {
 	if m != nil {
-		return m.Config
+		return m.ByCid
 	}
 	return nil
 }
 
-func (m *PushConfigRequest) GetHasConfig() bool {
+func (m *SpendReply) GetByMiner() map[string]uint64 {
 	if m != nil {
-		return m.HasConfig
+		return m.ByMiner
 	}
-	return false
+	return nil
+}
+
+func (m *SpendReply) GetByDay() map[string]uint64 {
+	if m != nil {
+		return m.ByDay
+	}
+	return nil
+}
+
+func (m *SpendReply) GetRecords() []*SpendRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type CloseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
+func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseRequest) ProtoMessage()    {}
+func (*CloseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{73}
+}
+
+func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseRequest.Unmarshal(m, b)
+}
+func (m *CloseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseRequest.Merge(m, src)
+}
+func (m *CloseRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseRequest.Size(m)
+}
+func (m *CloseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseRequest proto.InternalMessageInfo
+
+type CloseReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseReply) Reset()         { *m = CloseReply{} }
+func (m *CloseReply) String() string { return proto.CompactTextString(m) }
+func (*CloseReply) ProtoMessage()    {}
+func (*CloseReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{74}
+}
+
+func (m *CloseReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseReply.Unmarshal(m, b)
+}
+func (m *CloseReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseReply.Marshal(b, m, deterministic)
+}
+func (m *CloseReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseReply.Merge(m, src)
+}
+func (m *CloseReply) XXX_Size() int {
+	return xxx_messageInfo_CloseReply.Size(m)
+}
+func (m *CloseReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseReply proto.InternalMessageInfo
+
+type SetQuotasRequest struct {
+	InstanceID           string   `protobuf:"bytes,1,opt,name=instanceID,proto3" json:"instanceID,omitempty"`
+	Quotas               *Quotas  `protobuf:"bytes,2,opt,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetQuotasRequest) Reset()         { *m = SetQuotasRequest{} }
+func (m *SetQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*SetQuotasRequest) ProtoMessage()    {}
+func (*SetQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{75}
+}
+
+func (m *SetQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetQuotasRequest.Unmarshal(m, b)
+}
+func (m *SetQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetQuotasRequest.Marshal(b, m, deterministic)
+}
+func (m *SetQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotasRequest.Merge(m, src)
+}
+func (m *SetQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_SetQuotasRequest.Size(m)
+}
+func (m *SetQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotasRequest proto.InternalMessageInfo
+
+func (m *SetQuotasRequest) GetInstanceID() string {
+	if m != nil {
+		return m.InstanceID
+	}
+	return ""
+}
+
+func (m *SetQuotasRequest) GetQuotas() *Quotas {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+type SetQuotasReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PushConfigRequest) GetOverrideConfig() bool {
-	if m != nil {
-		return m.OverrideConfig
-	}
-	return false
+func (m *SetQuotasReply) Reset()         { *m = SetQuotasReply{} }
+func (m *SetQuotasReply) String() string { return proto.CompactTextString(m) }
+func (*SetQuotasReply) ProtoMessage()    {}
+func (*SetQuotasReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{76}
 }
 
-func (m *PushConfigRequest) GetHasOverrideConfig() bool {
-	if m != nil {
-		return m.HasOverrideConfig
-	}
-	return false
+func (m *SetQuotasReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetQuotasReply.Unmarshal(m, b)
+}
+func (m *SetQuotasReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetQuotasReply.Marshal(b, m, deterministic)
+}
+func (m *SetQuotasReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotasReply.Merge(m, src)
+}
+func (m *SetQuotasReply) XXX_Size() int {
+	return xxx_messageInfo_SetQuotasReply.Size(m)
+}
+func (m *SetQuotasReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotasReply.DiscardUnknown(m)
 }
 
-type PushConfigReply struct {
-	JobID                string   `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+var xxx_messageInfo_SetQuotasReply proto.InternalMessageInfo
+
+type TopUpPolicy struct {
+	Threshold            uint64   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	DailyCap             uint64   `protobuf:"varint,3,opt,name=dailyCap,proto3" json:"dailyCap,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PushConfigReply) Reset()         { *m = PushConfigReply{} }
-func (m *PushConfigReply) String() string { return proto.CompactTextString(m) }
-func (*PushConfigReply) ProtoMessage()    {}
-func (*PushConfigReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{38}
+func (m *TopUpPolicy) Reset()         { *m = TopUpPolicy{} }
+func (m *TopUpPolicy) String() string { return proto.CompactTextString(m) }
+func (*TopUpPolicy) ProtoMessage()    {}
+func (*TopUpPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{77}
 }
 
-func (m *PushConfigReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PushConfigReply.Unmarshal(m, b)
+func (m *TopUpPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopUpPolicy.Unmarshal(m, b)
 }
-func (m *PushConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PushConfigReply.Marshal(b, m, deterministic)
+func (m *TopUpPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopUpPolicy.Marshal(b, m, deterministic)
 }
-func (m *PushConfigReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushConfigReply.Merge(m, src)
+func (m *TopUpPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpPolicy.Merge(m, src)
 }
-func (m *PushConfigReply) XXX_Size() int {
-	return xxx_messageInfo_PushConfigReply.Size(m)
+func (m *TopUpPolicy) XXX_Size() int {
+	return xxx_messageInfo_TopUpPolicy.Size(m)
 }
-func (m *PushConfigReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushConfigReply.DiscardUnknown(m)
+func (m *TopUpPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushConfigReply proto.InternalMessageInfo
+var xxx_messageInfo_TopUpPolicy proto.InternalMessageInfo
 
-func (m *PushConfigReply) GetJobID() string {
+func (m *TopUpPolicy) GetThreshold() uint64 {
 	if m != nil {
-		return m.JobID
+		return m.Threshold
 	}
-	return ""
+	return 0
 }
 
-type GetRequest struct {
-	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *TopUpPolicy) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *GetRequest) Reset()         { *m = GetRequest{} }
-func (m *GetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()    {}
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{39}
+func (m *TopUpPolicy) GetDailyCap() uint64 {
+	if m != nil {
+		return m.DailyCap
+	}
+	return 0
 }
 
-func (m *GetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+type SetTopUpPolicyRequest struct {
+	InstanceID           string       `protobuf:"bytes,1,opt,name=instanceID,proto3" json:"instanceID,omitempty"`
+	Policy               *TopUpPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+
+func (m *SetTopUpPolicyRequest) Reset()         { *m = SetTopUpPolicyRequest{} }
+func (m *SetTopUpPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTopUpPolicyRequest) ProtoMessage()    {}
+func (*SetTopUpPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{78}
 }
-func (m *GetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRequest.Merge(m, src)
+
+func (m *SetTopUpPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTopUpPolicyRequest.Unmarshal(m, b)
 }
-func (m *GetRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRequest.Size(m)
+func (m *SetTopUpPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTopUpPolicyRequest.Marshal(b, m, deterministic)
 }
-func (m *GetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+func (m *SetTopUpPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTopUpPolicyRequest.Merge(m, src)
+}
+func (m *SetTopUpPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTopUpPolicyRequest.Size(m)
+}
+func (m *SetTopUpPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTopUpPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetTopUpPolicyRequest proto.InternalMessageInfo
 
-func (m *GetRequest) GetCid() string {
+func (m *SetTopUpPolicyRequest) GetInstanceID() string {
 	if m != nil {
-		return m.Cid
+		return m.InstanceID
 	}
 	return ""
 }
 
-type GetReply struct {
-	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+func (m *SetTopUpPolicyRequest) GetPolicy() *TopUpPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type SetTopUpPolicyReply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReply) Reset()         { *m = GetReply{} }
-func (m *GetReply) String() string { return proto.CompactTextString(m) }
-func (*GetReply) ProtoMessage()    {}
-func (*GetReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{40}
+func (m *SetTopUpPolicyReply) Reset()         { *m = SetTopUpPolicyReply{} }
+func (m *SetTopUpPolicyReply) String() string { return proto.CompactTextString(m) }
+func (*SetTopUpPolicyReply) ProtoMessage()    {}
+func (*SetTopUpPolicyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{79}
 }
 
-func (m *GetReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetReply.Unmarshal(m, b)
+func (m *SetTopUpPolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTopUpPolicyReply.Unmarshal(m, b)
 }
-func (m *GetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetReply.Marshal(b, m, deterministic)
+func (m *SetTopUpPolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTopUpPolicyReply.Marshal(b, m, deterministic)
 }
-func (m *GetReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetReply.Merge(m, src)
+func (m *SetTopUpPolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTopUpPolicyReply.Merge(m, src)
 }
-func (m *GetReply) XXX_Size() int {
-	return xxx_messageInfo_GetReply.Size(m)
+func (m *SetTopUpPolicyReply) XXX_Size() int {
+	return xxx_messageInfo_SetTopUpPolicyReply.Size(m)
 }
-func (m *GetReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetReply.DiscardUnknown(m)
+func (m *SetTopUpPolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTopUpPolicyReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetReply proto.InternalMessageInfo
-
-func (m *GetReply) GetChunk() []byte {
-	if m != nil {
-		return m.Chunk
-	}
-	return nil
-}
+var xxx_messageInfo_SetTopUpPolicyReply proto.InternalMessageInfo
 
-type CloseRequest struct {
+type FundRequest struct {
+	InstanceID           string   `protobuf:"bytes,1,opt,name=instanceID,proto3" json:"instanceID,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseRequest) Reset()         { *m = CloseRequest{} }
-func (m *CloseRequest) String() string { return proto.CompactTextString(m) }
-func (*CloseRequest) ProtoMessage()    {}
-func (*CloseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{41}
+func (m *FundRequest) Reset()         { *m = FundRequest{} }
+func (m *FundRequest) String() string { return proto.CompactTextString(m) }
+func (*FundRequest) ProtoMessage()    {}
+func (*FundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{80}
 }
 
-func (m *CloseRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseRequest.Unmarshal(m, b)
+func (m *FundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundRequest.Unmarshal(m, b)
 }
-func (m *CloseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseRequest.Marshal(b, m, deterministic)
+func (m *FundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundRequest.Marshal(b, m, deterministic)
 }
-func (m *CloseRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseRequest.Merge(m, src)
+func (m *FundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundRequest.Merge(m, src)
 }
-func (m *CloseRequest) XXX_Size() int {
-	return xxx_messageInfo_CloseRequest.Size(m)
+func (m *FundRequest) XXX_Size() int {
+	return xxx_messageInfo_FundRequest.Size(m)
 }
-func (m *CloseRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseRequest.DiscardUnknown(m)
+func (m *FundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseRequest proto.InternalMessageInfo
+var xxx_messageInfo_FundRequest proto.InternalMessageInfo
 
-type CloseReply struct {
+func (m *FundRequest) GetInstanceID() string {
+	if m != nil {
+		return m.InstanceID
+	}
+	return ""
+}
+
+func (m *FundRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *FundRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type FundReply struct {
+	MessageCid           string   `protobuf:"bytes,1,opt,name=messageCid,proto3" json:"messageCid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseReply) Reset()         { *m = CloseReply{} }
-func (m *CloseReply) String() string { return proto.CompactTextString(m) }
-func (*CloseReply) ProtoMessage()    {}
-func (*CloseReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{42}
+func (m *FundReply) Reset()         { *m = FundReply{} }
+func (m *FundReply) String() string { return proto.CompactTextString(m) }
+func (*FundReply) ProtoMessage()    {}
+func (*FundReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e75cb675eef135e5, []int{81}
 }
 
-func (m *CloseReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseReply.Unmarshal(m, b)
+func (m *FundReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundReply.Unmarshal(m, b)
 }
-func (m *CloseReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseReply.Marshal(b, m, deterministic)
+func (m *FundReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundReply.Marshal(b, m, deterministic)
 }
-func (m *CloseReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseReply.Merge(m, src)
+func (m *FundReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundReply.Merge(m, src)
 }
-func (m *CloseReply) XXX_Size() int {
-	return xxx_messageInfo_CloseReply.Size(m)
+func (m *FundReply) XXX_Size() int {
+	return xxx_messageInfo_FundReply.Size(m)
 }
-func (m *CloseReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseReply.DiscardUnknown(m)
+func (m *FundReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseReply proto.InternalMessageInfo
+var xxx_messageInfo_FundReply proto.InternalMessageInfo
+
+func (m *FundReply) GetMessageCid() string {
+	if m != nil {
+		return m.MessageCid
+	}
+	return ""
+}
 
 type AddToHotRequest struct {
 	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
@@ -1994,7 +4213,7 @@ func (m *AddToHotRequest) Reset()         { *m = AddToHotRequest{} }
 func (m *AddToHotRequest) String() string { return proto.CompactTextString(m) }
 func (*AddToHotRequest) ProtoMessage()    {}
 func (*AddToHotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{43}
+	return fileDescriptor_e75cb675eef135e5, []int{82}
 }
 
 func (m *AddToHotRequest) XXX_Unmarshal(b []byte) error {
@@ -2033,7 +4252,7 @@ func (m *AddToHotReply) Reset()         { *m = AddToHotReply{} }
 func (m *AddToHotReply) String() string { return proto.CompactTextString(m) }
 func (*AddToHotReply) ProtoMessage()    {}
 func (*AddToHotReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e75cb675eef135e5, []int{44}
+	return fileDescriptor_e75cb675eef135e5, []int{83}
 }
 
 func (m *AddToHotReply) XXX_Unmarshal(b []byte) error {
@@ -2062,12 +4281,17 @@ func (m *AddToHotReply) GetCid() string {
 }
 
 func init() {
+	proto.RegisterEnum("rpc.RenewStrategy", RenewStrategy_name, RenewStrategy_value)
+	proto.RegisterEnum("rpc.FailureClass", FailureClass_name, FailureClass_value)
 	proto.RegisterEnum("rpc.JobStatus", JobStatus_name, JobStatus_value)
+	proto.RegisterEnum("rpc.HotAction", HotAction_name, HotAction_value)
 	proto.RegisterType((*IpfsConfig)(nil), "rpc.IpfsConfig")
 	proto.RegisterType((*HotConfig)(nil), "rpc.HotConfig")
 	proto.RegisterType((*FilRenew)(nil), "rpc.FilRenew")
+	proto.RegisterType((*FilRepair)(nil), "rpc.FilRepair")
 	proto.RegisterType((*FilConfig)(nil), "rpc.FilConfig")
 	proto.RegisterType((*ColdConfig)(nil), "rpc.ColdConfig")
+	proto.RegisterType((*RetryConfig)(nil), "rpc.RetryConfig")
 	proto.RegisterType((*CidConfig)(nil), "rpc.CidConfig")
 	proto.RegisterType((*DefaultCidConfig)(nil), "rpc.DefaultCidConfig")
 	proto.RegisterType((*IpfsHotInfo)(nil), "rpc.IpfsHotInfo")
@@ -2075,9 +4299,17 @@ func init() {
 	proto.RegisterType((*FilStorage)(nil), "rpc.FilStorage")
 	proto.RegisterType((*FilInfo)(nil), "rpc.FilInfo")
 	proto.RegisterType((*ColdInfo)(nil), "rpc.ColdInfo")
+	proto.RegisterType((*CidRefs)(nil), "rpc.CidRefs")
 	proto.RegisterType((*CidInfo)(nil), "rpc.CidInfo")
 	proto.RegisterType((*WalletInfo)(nil), "rpc.WalletInfo")
+	proto.RegisterType((*HotStorageInfo)(nil), "rpc.HotStorageInfo")
+	proto.RegisterType((*Quotas)(nil), "rpc.Quotas")
+	proto.RegisterType((*QuotasUsage)(nil), "rpc.QuotasUsage")
 	proto.RegisterType((*InstanceInfo)(nil), "rpc.InstanceInfo")
+	proto.RegisterType((*HotPlan)(nil), "rpc.HotPlan")
+	proto.RegisterType((*MinerProposal)(nil), "rpc.MinerProposal")
+	proto.RegisterType((*ColdPlan)(nil), "rpc.ColdPlan")
+	proto.RegisterType((*StoragePlan)(nil), "rpc.StoragePlan")
 	proto.RegisterType((*Job)(nil), "rpc.Job")
 	proto.RegisterType((*CreateRequest)(nil), "rpc.CreateRequest")
 	proto.RegisterType((*CreateReply)(nil), "rpc.CreateReply")
@@ -2085,6 +4317,17 @@ func init() {
 	proto.RegisterType((*IDReply)(nil), "rpc.IDReply")
 	proto.RegisterType((*WalletAddrRequest)(nil), "rpc.WalletAddrRequest")
 	proto.RegisterType((*WalletAddrReply)(nil), "rpc.WalletAddrReply")
+	proto.RegisterType((*AddrInfo)(nil), "rpc.AddrInfo")
+	proto.RegisterType((*AddrsRequest)(nil), "rpc.AddrsRequest")
+	proto.RegisterType((*AddrsReply)(nil), "rpc.AddrsReply")
+	proto.RegisterType((*NewAddrRequest)(nil), "rpc.NewAddrRequest")
+	proto.RegisterType((*NewAddrReply)(nil), "rpc.NewAddrReply")
+	proto.RegisterType((*SetDefaultAddrRequest)(nil), "rpc.SetDefaultAddrRequest")
+	proto.RegisterType((*SetDefaultAddrReply)(nil), "rpc.SetDefaultAddrReply")
+	proto.RegisterType((*SendFilRequest)(nil), "rpc.SendFilRequest")
+	proto.RegisterType((*SendFilReply)(nil), "rpc.SendFilReply")
+	proto.RegisterType((*WaitMessageRequest)(nil), "rpc.WaitMessageRequest")
+	proto.RegisterType((*WaitMessageReply)(nil), "rpc.WaitMessageReply")
 	proto.RegisterType((*GetDefaultCidConfigRequest)(nil), "rpc.GetDefaultCidConfigRequest")
 	proto.RegisterType((*GetDefaultCidConfigReply)(nil), "rpc.GetDefaultCidConfigReply")
 	proto.RegisterType((*GetCidConfigRequest)(nil), "rpc.GetCidConfigRequest")
@@ -2102,10 +4345,31 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "rpc.LogEntry")
 	proto.RegisterType((*PushConfigRequest)(nil), "rpc.PushConfigRequest")
 	proto.RegisterType((*PushConfigReply)(nil), "rpc.PushConfigReply")
+	proto.RegisterType((*PlanConfigRequest)(nil), "rpc.PlanConfigRequest")
+	proto.RegisterType((*PlanConfigReply)(nil), "rpc.PlanConfigReply")
 	proto.RegisterType((*GetRequest)(nil), "rpc.GetRequest")
 	proto.RegisterType((*GetReply)(nil), "rpc.GetReply")
+	proto.RegisterType((*GetColdRequest)(nil), "rpc.GetColdRequest")
+	proto.RegisterType((*GetColdReply)(nil), "rpc.GetColdReply")
+	proto.RegisterType((*CancelJobRequest)(nil), "rpc.CancelJobRequest")
+	proto.RegisterType((*CancelJobReply)(nil), "rpc.CancelJobReply")
+	proto.RegisterType((*ListJobsRequest)(nil), "rpc.ListJobsRequest")
+	proto.RegisterType((*ListJobsReply)(nil), "rpc.ListJobsReply")
+	proto.RegisterType((*SpendRecord)(nil), "rpc.SpendRecord")
+	proto.RegisterType((*SpendRequest)(nil), "rpc.SpendRequest")
+	proto.RegisterType((*SpendReply)(nil), "rpc.SpendReply")
+	proto.RegisterMapType((map[string]uint64)(nil), "rpc.SpendReply.ByCidEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "rpc.SpendReply.ByDayEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "rpc.SpendReply.ByMinerEntry")
 	proto.RegisterType((*CloseRequest)(nil), "rpc.CloseRequest")
 	proto.RegisterType((*CloseReply)(nil), "rpc.CloseReply")
+	proto.RegisterType((*SetQuotasRequest)(nil), "rpc.SetQuotasRequest")
+	proto.RegisterType((*SetQuotasReply)(nil), "rpc.SetQuotasReply")
+	proto.RegisterType((*TopUpPolicy)(nil), "rpc.TopUpPolicy")
+	proto.RegisterType((*SetTopUpPolicyRequest)(nil), "rpc.SetTopUpPolicyRequest")
+	proto.RegisterType((*SetTopUpPolicyReply)(nil), "rpc.SetTopUpPolicyReply")
+	proto.RegisterType((*FundRequest)(nil), "rpc.FundRequest")
+	proto.RegisterType((*FundReply)(nil), "rpc.FundReply")
 	proto.RegisterType((*AddToHotRequest)(nil), "rpc.AddToHotRequest")
 	proto.RegisterType((*AddToHotReply)(nil), "rpc.AddToHotReply")
 }
@@ -2115,99 +4379,205 @@ func init() {
 }
 
 var fileDescriptor_e75cb675eef135e5 = []byte{
-	// 1465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x72, 0xd3, 0x46,
-	0x14, 0x8e, 0x2d, 0xff, 0xe9, 0xd8, 0xb1, 0x9d, 0x25, 0x30, 0xae, 0x86, 0x42, 0x58, 0x20, 0x09,
-	0x0c, 0x78, 0x68, 0xe8, 0x74, 0x3a, 0x03, 0x17, 0x4d, 0x1c, 0x1c, 0x4c, 0x69, 0x9b, 0xae, 0xd3,
-	0x61, 0x7a, 0xd1, 0x0b, 0x59, 0x5a, 0xc7, 0x0a, 0x8a, 0xd6, 0x95, 0x64, 0x02, 0x7d, 0x88, 0x3e,
-	0x42, 0x2f, 0x3a, 0x7d, 0x88, 0x5e, 0x75, 0xa6, 0x6f, 0xd6, 0xd9, 0x3f, 0x69, 0x6d, 0xc7, 0x84,
-	0xde, 0xe9, 0xfc, 0xec, 0x39, 0x67, 0xcf, 0xf9, 0xce, 0xe7, 0x35, 0xd8, 0xe3, 0x71, 0xd2, 0x9d,
-	0xc6, 0x2c, 0x65, 0xc8, 0x8a, 0xa7, 0x1e, 0x7e, 0x04, 0x30, 0x98, 0x8e, 0x93, 0x1e, 0x8b, 0xc6,
-	0xc1, 0x29, 0xba, 0x05, 0xe0, 0xfa, 0xfe, 0x49, 0x70, 0x4e, 0xd9, 0x2c, 0xed, 0x14, 0xb6, 0x0a,
-	0xbb, 0x16, 0x31, 0x34, 0x78, 0x0a, 0xf6, 0x4b, 0x96, 0x2a, 0xe7, 0x0e, 0x54, 0x69, 0xe4, 0x8e,
-	0x42, 0xea, 0x0b, 0xcf, 0x1a, 0xd1, 0x22, 0xba, 0x07, 0xeb, 0x6e, 0x18, 0xb2, 0x8b, 0x9f, 0xa2,
-	0x71, 0x4c, 0xe9, 0x6f, 0xb4, 0x53, 0x14, 0xf6, 0x79, 0x25, 0xba, 0x0b, 0xa5, 0x60, 0x3a, 0x4e,
-	0x3a, 0xd6, 0x56, 0x61, 0xb7, 0xbe, 0xd7, 0xea, 0xc6, 0x53, 0xaf, 0x9b, 0xd7, 0x42, 0x84, 0x11,
-	0x1f, 0x40, 0xad, 0x1f, 0x84, 0x84, 0x46, 0xf4, 0xe2, 0x23, 0x09, 0x6f, 0x82, 0x9d, 0x4e, 0x62,
-	0x9a, 0x4c, 0x58, 0xe8, 0x8b, 0x64, 0x16, 0xc9, 0x15, 0xf8, 0x9f, 0x02, 0xd8, 0xfd, 0x20, 0x54,
-	0x65, 0xdf, 0x04, 0x3b, 0xa6, 0xd3, 0xbe, 0xeb, 0xa5, 0x2c, 0x56, 0x57, 0xcc, 0x15, 0x08, 0x43,
-	0xc3, 0xa7, 0x6e, 0x78, 0x38, 0x8b, 0xdd, 0x34, 0x60, 0x91, 0x0a, 0x36, 0xa7, 0x43, 0xdb, 0xd0,
-	0xa4, 0xef, 0xbd, 0x70, 0xe6, 0x53, 0xff, 0xbb, 0x20, 0xa2, 0x31, 0xbf, 0x82, 0xb5, 0x6b, 0x93,
-	0x05, 0x2d, 0x8f, 0xe5, 0xb1, 0x59, 0x94, 0xc6, 0x1f, 0x7a, 0xcc, 0xa7, 0x49, 0xa7, 0x24, 0xbc,
-	0xe6, 0x74, 0xe8, 0x2e, 0x94, 0x63, 0x7e, 0xb9, 0x4e, 0x59, 0x74, 0x61, 0x5d, 0x74, 0x41, 0xdf,
-	0x98, 0x48, 0x1b, 0x26, 0x00, 0x3d, 0x16, 0xfa, 0x57, 0xf6, 0xfd, 0x21, 0xd4, 0xc6, 0x41, 0x48,
-	0x3d, 0x16, 0xc8, 0xc2, 0xeb, 0x7b, 0x4d, 0x1d, 0x4f, 0x35, 0x35, 0xb3, 0x63, 0x1f, 0xec, 0x5e,
-	0xa0, 0x43, 0xb6, 0xc1, 0xf2, 0x02, 0x19, 0xce, 0x26, 0xfc, 0x13, 0x6d, 0x81, 0x35, 0x61, 0xe9,
-	0x5c, 0x94, 0x6c, 0xf2, 0x84, 0x9b, 0xf8, 0xf8, 0x3c, 0xde, 0x6e, 0x73, 0x7c, 0x79, 0x95, 0x44,
-	0x18, 0xf1, 0xcf, 0xd0, 0x3e, 0xa4, 0x63, 0x77, 0x16, 0xa6, 0x79, 0x32, 0x15, 0xba, 0x70, 0x75,
-	0xe8, 0xe2, 0xc7, 0x42, 0xef, 0x40, 0x9d, 0xa3, 0xe5, 0x25, 0x4b, 0x07, 0xd1, 0x98, 0xf1, 0xae,
-	0x78, 0x31, 0x75, 0x53, 0xd5, 0x15, 0x8b, 0x68, 0x11, 0xff, 0x02, 0x55, 0xc3, 0x69, 0x45, 0xeb,
-	0x10, 0x94, 0x92, 0x40, 0x21, 0xd5, 0x22, 0xe2, 0x1b, 0xdd, 0x9b, 0x03, 0x68, 0x3b, 0x03, 0xa8,
-	0x8a, 0xa6, 0x10, 0xfa, 0x57, 0x01, 0xa0, 0x1f, 0x84, 0xc3, 0x94, 0xc5, 0xee, 0x29, 0x45, 0x5b,
-	0x50, 0x9f, 0xc6, 0x6c, 0xca, 0x12, 0x37, 0xec, 0x65, 0x2d, 0x35, 0x55, 0xbc, 0x08, 0x31, 0x56,
-	0xea, 0xab, 0xbd, 0xd0, 0x22, 0x72, 0xa0, 0xe6, 0x6b, 0xe0, 0x59, 0xa2, 0x90, 0x4c, 0x46, 0xbb,
-	0xd0, 0x72, 0xbd, 0x34, 0x78, 0x27, 0xa4, 0x17, 0x53, 0xe6, 0x4d, 0x3a, 0x25, 0xe1, 0xb2, 0xa8,
-	0x46, 0x9b, 0x50, 0x3e, 0xe7, 0x00, 0x14, 0x90, 0xb2, 0x89, 0x14, 0x30, 0x81, 0x6a, 0x3f, 0x08,
-	0x75, 0x17, 0x7c, 0x37, 0x75, 0xf3, 0xf2, 0xb4, 0x88, 0x1e, 0x83, 0xad, 0x2b, 0x4d, 0x3a, 0xc5,
-	0x2d, 0x2b, 0xeb, 0x7e, 0x7e, 0x41, 0x92, 0x7b, 0xe0, 0x2f, 0xa1, 0xc6, 0xc7, 0x22, 0x82, 0xee,
-	0x1a, 0xd8, 0x93, 0xa3, 0x6d, 0xe8, 0x93, 0xa2, 0x59, 0x39, 0xf2, 0x7e, 0x2f, 0x40, 0xb5, 0x17,
-	0xc8, 0x53, 0x9b, 0x50, 0x3e, 0x63, 0xa3, 0xc1, 0xa1, 0x2a, 0x44, 0x0a, 0x1a, 0x8e, 0xc5, 0x1c,
-	0x8e, 0xc6, 0x74, 0xad, 0xb9, 0xe9, 0xa2, 0x5b, 0x12, 0x4d, 0x25, 0x23, 0xa5, 0x9e, 0x8f, 0xc0,
-	0xd2, 0x1d, 0x85, 0x25, 0x73, 0xbf, 0x74, 0xd1, 0x0a, 0x49, 0xdf, 0x00, 0xbc, 0x71, 0xc3, 0x90,
-	0x66, 0x18, 0x71, 0x7d, 0x3f, 0xa6, 0x49, 0xa2, 0xbb, 0xa3, 0x44, 0x6e, 0x19, 0xb9, 0xa1, 0x1b,
-	0x79, 0x12, 0x26, 0x25, 0xa2, 0x45, 0xfc, 0x47, 0x01, 0x1a, 0x83, 0x28, 0x49, 0xb9, 0x20, 0x82,
-	0x34, 0xa1, 0x98, 0x5d, 0xaa, 0x38, 0x38, 0x44, 0xfb, 0xd0, 0xf6, 0x17, 0xf6, 0x40, 0xa1, 0xfb,
-	0xba, 0xa8, 0x68, 0x71, 0x49, 0xc8, 0x92, 0x3b, 0xda, 0x81, 0xca, 0x85, 0xa8, 0x72, 0x6e, 0xe3,
-	0xf2, 0xc2, 0x89, 0x32, 0x73, 0x28, 0x4f, 0x83, 0x48, 0xd3, 0x8d, 0xf8, 0xc6, 0x0c, 0xac, 0x57,
-	0x6c, 0xb4, 0x54, 0xd6, 0x26, 0x94, 0xdd, 0x69, 0x30, 0x38, 0x54, 0xad, 0x96, 0x02, 0xda, 0x86,
-	0x4a, 0x92, 0xba, 0xe9, 0x4c, 0x22, 0xbf, 0xa9, 0x76, 0xf4, 0x15, 0x1b, 0x0d, 0x85, 0x96, 0x28,
-	0x2b, 0x87, 0x2b, 0x8d, 0xe3, 0x9e, 0x3b, 0x4b, 0xa8, 0xe8, 0xbf, 0x4d, 0x32, 0x19, 0xb7, 0x60,
-	0xbd, 0x27, 0x26, 0x44, 0xe8, 0xaf, 0x33, 0x9a, 0xa4, 0xf8, 0x29, 0xd4, 0xb5, 0x62, 0x1a, 0x7e,
-	0xb8, 0xac, 0x92, 0x94, 0xbd, 0xa5, 0x91, 0xae, 0x44, 0x08, 0xb8, 0x0e, 0xf6, 0xe0, 0x50, 0x47,
-	0xf8, 0x0c, 0xaa, 0x5c, 0xb8, 0xe4, 0x34, 0xbe, 0x06, 0x1b, 0xb2, 0x11, 0xfb, 0xbe, 0x1f, 0x6b,
-	0xff, 0xfb, 0xd0, 0x32, 0x95, 0xfc, 0x1c, 0x82, 0x12, 0x1f, 0xa6, 0x3a, 0x29, 0xbe, 0x71, 0x17,
-	0x9c, 0x23, 0x9a, 0x2e, 0x0d, 0x40, 0x06, 0x59, 0x66, 0x46, 0x7c, 0x00, 0x9d, 0x4b, 0xfd, 0x79,
-	0xfc, 0x6d, 0xa8, 0x78, 0x72, 0xb8, 0x26, 0xbb, 0xe5, 0x4e, 0xca, 0x8a, 0x77, 0xe0, 0xda, 0x11,
-	0xfd, 0x94, 0x64, 0xcf, 0x60, 0x63, 0xde, 0xf1, 0xff, 0x64, 0xf9, 0x16, 0x9c, 0xe1, 0xea, 0x9b,
-	0x3d, 0x5e, 0x88, 0xb2, 0x02, 0x88, 0x3a, 0x98, 0x03, 0x9d, 0xe1, 0x8a, 0x6b, 0xe3, 0xdb, 0x50,
-	0x1f, 0x4e, 0xd8, 0xc5, 0xea, 0x6b, 0x3c, 0x05, 0x5b, 0x3a, 0xc8, 0xf2, 0xab, 0x9e, 0x5c, 0xff,
-	0x39, 0xa2, 0x50, 0x94, 0x40, 0xb4, 0x11, 0xaf, 0x43, 0x5d, 0x28, 0xd4, 0x38, 0xf7, 0xc0, 0x96,
-	0x22, 0x8f, 0x71, 0x1f, 0x4a, 0x41, 0x1e, 0x60, 0x43, 0x52, 0xb3, 0xb1, 0x80, 0x44, 0x98, 0xf1,
-	0x36, 0xb4, 0xdf, 0xb8, 0xa9, 0x37, 0x79, 0xc5, 0x46, 0x89, 0xae, 0x0e, 0x41, 0xe9, 0x2c, 0xf0,
-	0xf9, 0x72, 0x8b, 0xf5, 0xe0, 0xdf, 0xf8, 0x11, 0x34, 0x0d, 0x3f, 0x9e, 0xc0, 0x01, 0xeb, 0x8c,
-	0x8d, 0x54, 0xfc, 0x9a, 0x5e, 0x00, 0xc2, 0x95, 0xf8, 0x2b, 0x15, 0xf5, 0x35, 0x3b, 0x4d, 0x56,
-	0xde, 0x99, 0x6b, 0xce, 0x72, 0x12, 0x3b, 0x13, 0xc3, 0x6c, 0x1a, 0xe7, 0x78, 0x96, 0x07, 0x50,
-	0x0b, 0xd9, 0xe9, 0x0b, 0xfe, 0x1c, 0x50, 0xa9, 0x24, 0x41, 0xbd, 0x56, 0x4a, 0x92, 0x99, 0xf1,
-	0x09, 0xd4, 0xb4, 0xf6, 0x53, 0x92, 0xf1, 0x6b, 0xa6, 0xc1, 0x39, 0x55, 0x74, 0x29, 0xbe, 0xb9,
-	0xd7, 0x79, 0x72, 0xaa, 0x76, 0x95, 0x7f, 0xe2, 0x7f, 0x0b, 0xb0, 0x71, 0x3c, 0x4b, 0x26, 0x57,
-	0xe0, 0xd0, 0x80, 0x5c, 0xf1, 0x63, 0x90, 0xe3, 0x8f, 0xab, 0x89, 0xab, 0x5e, 0x70, 0x22, 0x75,
-	0x8d, 0xe4, 0x0a, 0xfe, 0x70, 0x62, 0xef, 0x68, 0x1c, 0x07, 0x3e, 0x55, 0x2e, 0x25, 0xe1, 0xb2,
-	0xa0, 0x45, 0x8f, 0x60, 0x63, 0xe2, 0x26, 0x3f, 0xcc, 0xbb, 0x96, 0x85, 0xeb, 0xb2, 0x01, 0xef,
-	0x40, 0xcb, 0xbc, 0x02, 0xef, 0xeb, 0xa5, 0x3f, 0x2b, 0xf8, 0x16, 0xc0, 0x11, 0x4d, 0x57, 0xa3,
-	0x74, 0x0b, 0x6a, 0xc2, 0xae, 0x22, 0x78, 0x93, 0x59, 0xf4, 0x56, 0xd8, 0x1b, 0x44, 0x0a, 0xb8,
-	0x09, 0x8d, 0x5e, 0xc8, 0x92, 0x8c, 0xd4, 0x1a, 0x00, 0x4a, 0xe6, 0x6b, 0xb0, 0x03, 0xad, 0x7d,
-	0xdf, 0x3f, 0x61, 0x2f, 0x59, 0x96, 0xe4, 0xf2, 0x30, 0x77, 0x60, 0x3d, 0x77, 0xe4, 0xd9, 0x96,
-	0x6a, 0x79, 0xf8, 0x3d, 0xd8, 0x19, 0xe1, 0x22, 0x80, 0xca, 0x8f, 0x33, 0x3a, 0xa3, 0x7e, 0x7b,
-	0x0d, 0x35, 0x01, 0x06, 0xd1, 0x71, 0xcc, 0x4e, 0xf9, 0x4f, 0x52, 0xbb, 0xc0, 0x6d, 0x7d, 0x37,
-	0x08, 0xa9, 0xdf, 0x2e, 0xa2, 0x06, 0xd4, 0x7a, 0x7c, 0x03, 0xb8, 0x64, 0xa1, 0x3a, 0x54, 0x87,
-	0x33, 0xcf, 0xe3, 0x6e, 0xa5, 0xbd, 0xbf, 0x2b, 0x50, 0xe9, 0xf7, 0x87, 0xfb, 0xc7, 0x03, 0xf4,
-	0x04, 0x2a, 0x92, 0x89, 0x11, 0x92, 0x53, 0x34, 0x79, 0xda, 0x69, 0xcf, 0xe9, 0xf8, 0xb5, 0xd6,
-	0xd0, 0x3d, 0x4e, 0xb7, 0x48, 0xce, 0x3c, 0xe3, 0x63, 0xa7, 0x91, 0xc9, 0xd2, 0xeb, 0xb9, 0xfe,
-	0x19, 0xe5, 0x7c, 0x8b, 0x6e, 0x18, 0x3f, 0x4f, 0x06, 0x2b, 0x3b, 0x9b, 0x4b, 0x7a, 0x79, 0xfa,
-	0x8d, 0xa0, 0xc4, 0xa5, 0xc7, 0xe2, 0x6d, 0xe1, 0xbe, 0x9a, 0xa0, 0x9d, 0xcf, 0x57, 0x3b, 0xc8,
-	0xc0, 0x07, 0xd0, 0x30, 0x29, 0x14, 0x75, 0xf4, 0x81, 0xa5, 0x50, 0x37, 0x2e, 0xb1, 0x64, 0xc5,
-	0x0d, 0x57, 0x16, 0x37, 0xbc, 0xaa, 0xb8, 0xe1, 0xea, 0xe2, 0x1e, 0x42, 0x89, 0x13, 0x23, 0x92,
-	0x5d, 0x37, 0x48, 0xd4, 0x69, 0x1a, 0x9a, 0xcc, 0x57, 0xbc, 0x2d, 0xd4, 0x43, 0x34, 0xa7, 0x46,
-	0xe5, 0x9b, 0xb1, 0x23, 0x5e, 0x43, 0xcf, 0xc0, 0xce, 0x08, 0x0d, 0x5d, 0x57, 0x2d, 0x9f, 0x27,
-	0x42, 0xe7, 0xda, 0xa2, 0x5a, 0x1c, 0x7d, 0x52, 0xc8, 0x0e, 0x73, 0x9e, 0x32, 0x0f, 0x1b, 0x7c,
-	0x67, 0x1e, 0xce, 0xe8, 0x4c, 0x1c, 0x7e, 0x0e, 0x90, 0x6f, 0xa3, 0x42, 0xc1, 0x12, 0xc3, 0x28,
-	0x14, 0x2c, 0xac, 0x2d, 0x5e, 0x43, 0x0f, 0xc0, 0x3a, 0xa2, 0x29, 0x6a, 0xe9, 0x49, 0x68, 0xff,
-	0xf5, 0x5c, 0xa1, 0x13, 0x3d, 0x86, 0xb2, 0xd8, 0x3d, 0x24, 0xd9, 0xdf, 0xdc, 0x4b, 0xa7, 0x65,
-	0xaa, 0x64, 0xe4, 0xaf, 0xa1, 0xa6, 0x77, 0x0e, 0xc9, 0xec, 0x0b, 0xbb, 0xea, 0xa0, 0x05, 0xad,
-	0x38, 0xb7, 0x5b, 0x38, 0xf8, 0x02, 0x9c, 0x80, 0x75, 0x53, 0xfa, 0x3e, 0x0d, 0x42, 0xda, 0xd5,
-	0xcf, 0xd8, 0xae, 0xf8, 0x23, 0x3d, 0x3a, 0xa8, 0xf7, 0x95, 0x62, 0x3c, 0x4e, 0x8e, 0x0b, 0x7f,
-	0x16, 0xad, 0x93, 0x93, 0x17, 0xa3, 0x8a, 0xf8, 0x87, 0xfd, 0xf4, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf5, 0x85, 0x72, 0xbb, 0x6e, 0x0f, 0x00, 0x00,
+	// 3157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4f, 0x73, 0x1b, 0xc7,
+	0xb1, 0x17, 0xb0, 0x20, 0x08, 0x34, 0x40, 0x10, 0x1c, 0x4a, 0x7e, 0x78, 0x5b, 0x7a, 0x32, 0xbd,
+	0x92, 0x25, 0x9a, 0xb6, 0xf8, 0x1c, 0xca, 0x71, 0xfc, 0x27, 0x55, 0x09, 0x09, 0x9a, 0x12, 0x1d,
+	0x59, 0x61, 0x16, 0x52, 0xa9, 0x9c, 0x4b, 0x6a, 0x89, 0x1d, 0x10, 0x4b, 0x2d, 0x76, 0xa0, 0xdd,
+	0x81, 0x24, 0x24, 0x97, 0xa4, 0x72, 0xcd, 0x27, 0x48, 0xe5, 0x94, 0x72, 0x25, 0xd7, 0x9c, 0x53,
+	0x95, 0x43, 0xbe, 0x45, 0x2e, 0xa9, 0xca, 0x47, 0x49, 0xf5, 0xfc, 0xdb, 0xd9, 0x05, 0x60, 0xc9,
+	0xb9, 0xe5, 0xb6, 0xfd, 0xeb, 0x9e, 0x99, 0x9e, 0x9e, 0xee, 0x9e, 0xee, 0x01, 0xa0, 0x39, 0x1a,
+	0x65, 0xfb, 0xd3, 0x94, 0x71, 0x46, 0x9c, 0x74, 0x3a, 0xf4, 0x3e, 0x00, 0x38, 0x9d, 0x8e, 0xb2,
+	0x3e, 0x4b, 0x46, 0xd1, 0x05, 0xb9, 0x01, 0x10, 0x84, 0xe1, 0xe3, 0x68, 0x42, 0xd9, 0x8c, 0xf7,
+	0x2a, 0x3b, 0x95, 0x5d, 0xc7, 0xb7, 0x10, 0x6f, 0x0a, 0xcd, 0x07, 0x8c, 0x2b, 0xe1, 0x1e, 0xac,
+	0xd3, 0x24, 0x38, 0x8f, 0x69, 0x28, 0x24, 0x1b, 0xbe, 0x26, 0xc9, 0x2d, 0xd8, 0x08, 0xe2, 0x98,
+	0xbd, 0x7c, 0x92, 0x8c, 0x52, 0x4a, 0x7f, 0x49, 0x7b, 0x55, 0xc1, 0x2f, 0x82, 0xe4, 0x26, 0xd4,
+	0xa2, 0xe9, 0x28, 0xeb, 0x39, 0x3b, 0x95, 0xdd, 0xd6, 0xc1, 0xe6, 0x7e, 0x3a, 0x1d, 0xee, 0xe7,
+	0xba, 0xf8, 0x82, 0xe9, 0xfd, 0xb5, 0x02, 0x8d, 0x93, 0x28, 0xf6, 0x69, 0x42, 0x5f, 0x7e, 0xcb,
+	0x8a, 0xd7, 0xa1, 0xc9, 0xc7, 0x29, 0xcd, 0xc6, 0x2c, 0x0e, 0xc5, 0x6a, 0x8e, 0x9f, 0x03, 0x64,
+	0x1f, 0x1a, 0x19, 0x4f, 0x03, 0x4e, 0x2f, 0xe6, 0x62, 0xb5, 0xce, 0x01, 0x11, 0xab, 0x89, 0x59,
+	0x07, 0x8a, 0xe3, 0x1b, 0x19, 0xe2, 0x42, 0x63, 0x12, 0xbc, 0x3a, 0x4b, 0xa3, 0x21, 0xed, 0xd5,
+	0x76, 0x2a, 0xbb, 0x35, 0xdf, 0xd0, 0x64, 0x0f, 0xba, 0xfa, 0xfb, 0x34, 0x19, 0xa6, 0x34, 0xc8,
+	0x68, 0x6f, 0x4d, 0x2c, 0xb8, 0x80, 0x7b, 0xef, 0x42, 0x53, 0xe8, 0x3e, 0x0d, 0xa2, 0x74, 0xb5,
+	0xf2, 0xde, 0x3f, 0xaa, 0x42, 0x4e, 0x99, 0xf5, 0x3a, 0x34, 0x53, 0x3a, 0x3d, 0x09, 0x86, 0x9c,
+	0xa5, 0xea, 0x08, 0x72, 0x80, 0x78, 0xd0, 0x0e, 0x69, 0x10, 0x1f, 0xcf, 0xd2, 0x80, 0x47, 0x2c,
+	0x51, 0x7b, 0x2d, 0x60, 0xe4, 0x36, 0x74, 0xe8, 0xab, 0x61, 0x3c, 0x0b, 0x69, 0xf8, 0x55, 0x94,
+	0xd0, 0x14, 0x4d, 0xec, 0xec, 0x36, 0xfd, 0x12, 0x8a, 0x73, 0x0d, 0xd9, 0x2c, 0xe1, 0xe9, 0xbc,
+	0xcf, 0x42, 0x9a, 0xf5, 0x6a, 0x42, 0xaa, 0x80, 0x91, 0x9b, 0xb0, 0x96, 0xa2, 0x95, 0xc4, 0x1e,
+	0x5b, 0x07, 0x1b, 0xc2, 0x6e, 0xfa, 0x40, 0x7c, 0xc9, 0x23, 0xb7, 0xa1, 0x9e, 0x8a, 0x4d, 0xf6,
+	0xea, 0x42, 0xaa, 0x93, 0x4b, 0x21, 0xea, 0x2b, 0x2e, 0xfa, 0x05, 0x4f, 0x67, 0x19, 0x37, 0x7a,
+	0xad, 0x8b, 0x15, 0x8b, 0x60, 0xc1, 0xfa, 0x8d, 0x92, 0xf5, 0x91, 0x17, 0x25, 0x83, 0x21, 0x4b,
+	0x69, 0xaf, 0x29, 0xb6, 0x6e, 0x68, 0x42, 0xa0, 0x16, 0x84, 0x61, 0xda, 0x83, 0x9d, 0xca, 0x6e,
+	0xd3, 0x17, 0xdf, 0x9e, 0x0f, 0xd0, 0x67, 0x71, 0xf8, 0x5a, 0x8f, 0xdd, 0x83, 0xc6, 0x28, 0x8a,
+	0xe9, 0x90, 0x45, 0xd2, 0xa4, 0xd6, 0x1e, 0x94, 0x3b, 0x1a, 0xbe, 0xf7, 0x4d, 0x05, 0x5a, 0x3e,
+	0x15, 0x16, 0x12, 0xb3, 0xee, 0x40, 0x6b, 0x12, 0xbc, 0x3a, 0xe4, 0x9c, 0x4e, 0xa6, 0x3c, 0x53,
+	0x47, 0x66, 0x43, 0xb8, 0xee, 0x79, 0x30, 0x7c, 0xc6, 0x46, 0x23, 0x75, 0x5e, 0x9a, 0xc4, 0x80,
+	0x9b, 0x04, 0xaf, 0x8e, 0x14, 0xd3, 0x11, 0x4c, 0x0b, 0x21, 0x9f, 0x42, 0x67, 0x14, 0x44, 0xf1,
+	0x2c, 0xa5, 0xfd, 0x38, 0xc8, 0x32, 0x75, 0x48, 0x9d, 0x83, 0x2d, 0xa9, 0x9d, 0xc5, 0xf2, 0x4b,
+	0x82, 0xde, 0xef, 0x2a, 0xd0, 0xec, 0x47, 0x7a, 0xeb, 0x5d, 0x70, 0x86, 0x91, 0xdc, 0x76, 0xd3,
+	0xc7, 0x4f, 0xb2, 0x03, 0xce, 0x98, 0xf1, 0xc2, 0x6e, 0x4d, 0x6c, 0xfb, 0xc8, 0xc2, 0x00, 0x1d,
+	0x62, 0x3c, 0xd9, 0x01, 0x9a, 0x5b, 0xd3, 0x17, 0x4c, 0x72, 0x1b, 0x1d, 0x84, 0xa7, 0x73, 0x11,
+	0x28, 0xad, 0x83, 0xae, 0x0a, 0x2c, 0x63, 0x1e, 0x5f, 0xb2, 0xbd, 0xdf, 0x54, 0xa0, 0x7b, 0x4c,
+	0x47, 0xc1, 0x2c, 0xe6, 0xb9, 0x56, 0x4a, 0x87, 0xca, 0xeb, 0x75, 0xa8, 0xbe, 0x91, 0x0e, 0xce,
+	0xb7, 0xeb, 0x70, 0x07, 0x5a, 0x98, 0x60, 0x1e, 0x30, 0x7e, 0x9a, 0x8c, 0x18, 0x1e, 0x0b, 0x06,
+	0x2a, 0x57, 0xee, 0xe0, 0xf8, 0x9a, 0xf4, 0x7e, 0x05, 0xeb, 0x96, 0xd0, 0x0a, 0x9f, 0x21, 0x50,
+	0xcb, 0x22, 0x95, 0xdc, 0x1c, 0x5f, 0x7c, 0x93, 0x5b, 0x85, 0x9c, 0xd6, 0x35, 0x39, 0x4d, 0xcd,
+	0x26, 0x93, 0x1a, 0x86, 0x78, 0x32, 0x9b, 0x1c, 0xc5, 0x6c, 0xf8, 0x2c, 0x13, 0x76, 0x73, 0xfc,
+	0x1c, 0xf0, 0x7e, 0x5d, 0x05, 0x38, 0x89, 0xe2, 0x01, 0x67, 0x69, 0x70, 0x41, 0xd1, 0xbd, 0xa6,
+	0x29, 0x9b, 0xb2, 0x2c, 0x88, 0xfb, 0xe6, 0x04, 0x6d, 0x08, 0x55, 0x14, 0x71, 0x48, 0x43, 0x95,
+	0x68, 0x35, 0x89, 0xe1, 0x12, 0xea, 0x4c, 0x21, 0x9d, 0xcb, 0xd0, 0x64, 0x17, 0x36, 0x83, 0x21,
+	0x8f, 0x5e, 0x08, 0xea, 0x8b, 0x29, 0x1b, 0x8e, 0x95, 0x2a, 0x65, 0x98, 0x5c, 0x85, 0xb5, 0x09,
+	0x86, 0xa6, 0xc8, 0x01, 0x4d, 0x5f, 0x12, 0xe8, 0xba, 0x14, 0xd9, 0x32, 0x50, 0xeb, 0x22, 0x50,
+	0x2d, 0x04, 0x37, 0x39, 0x8d, 0xe8, 0x90, 0x0e, 0xd0, 0x46, 0xeb, 0x82, 0x9d, 0x03, 0xc8, 0xe5,
+	0x8c, 0x07, 0x71, 0x9f, 0x65, 0x5c, 0x45, 0x79, 0x0e, 0x78, 0x23, 0x58, 0x3f, 0x89, 0x62, 0x6d,
+	0xff, 0x30, 0xe0, 0x41, 0xbe, 0x75, 0x4d, 0x92, 0xbb, 0xd0, 0xd4, 0x56, 0xc8, 0x7a, 0xd5, 0x1d,
+	0xc7, 0xf8, 0x47, 0x6e, 0x3c, 0x3f, 0x97, 0x30, 0xc7, 0xe5, 0xe4, 0xc7, 0xe5, 0x7d, 0x04, 0x0d,
+	0x74, 0x26, 0xb1, 0xd0, 0xae, 0x95, 0x02, 0xa4, 0x43, 0xb6, 0xf5, 0x6c, 0xe2, 0xe8, 0x0c, 0xd7,
+	0xfb, 0x7f, 0x58, 0xef, 0x47, 0xa1, 0x4f, 0x47, 0x19, 0xe9, 0x6a, 0x07, 0xc6, 0x3c, 0x86, 0x9f,
+	0xb8, 0x8c, 0x72, 0x58, 0x84, 0xc4, 0xb7, 0xf7, 0x97, 0x8a, 0x18, 0x21, 0x96, 0xb9, 0x0a, 0x6b,
+	0x97, 0xec, 0xfc, 0xf4, 0x58, 0xed, 0x46, 0x12, 0x3a, 0x3c, 0xab, 0x79, 0x78, 0x5a, 0xce, 0xe9,
+	0x14, 0x9c, 0x93, 0xdc, 0x90, 0x6b, 0xd6, 0x2c, 0x1d, 0xb5, 0x7b, 0x09, 0x0d, 0xde, 0x51, 0x1a,
+	0xd8, 0x19, 0x5b, 0xef, 0x52, 0x05, 0xcc, 0x0e, 0xd4, 0x52, 0x3a, 0xca, 0x7a, 0x75, 0x6b, 0x0e,
+	0xb5, 0x25, 0x5f, 0x70, 0xbc, 0x1f, 0x03, 0x3c, 0x0d, 0xe2, 0x98, 0x9a, 0x20, 0xc0, 0x74, 0x4a,
+	0xb3, 0x4c, 0x1f, 0x82, 0x22, 0x65, 0x6a, 0x8b, 0x83, 0x64, 0x28, 0xe3, 0xa0, 0xe6, 0x6b, 0xd2,
+	0x3b, 0x82, 0xce, 0x03, 0xc6, 0xd5, 0x41, 0x88, 0x59, 0xf4, 0x09, 0x54, 0xac, 0x80, 0x29, 0x84,
+	0x42, 0xb5, 0x1c, 0x0a, 0x7f, 0xaa, 0x40, 0xfd, 0x67, 0x33, 0xc6, 0x83, 0x4c, 0x65, 0xd9, 0x07,
+	0x8c, 0x1f, 0xcd, 0x39, 0xb5, 0xb3, 0xac, 0x86, 0xf0, 0x3a, 0x9b, 0x04, 0xaf, 0x70, 0xa7, 0x52,
+	0x44, 0x5d, 0x8d, 0x36, 0x86, 0xea, 0x22, 0x1d, 0x85, 0x99, 0xb6, 0xaa, 0x22, 0xd5, 0xad, 0x33,
+	0x98, 0xd2, 0x24, 0xb4, 0xee, 0x7c, 0x41, 0xe3, 0xda, 0x19, 0x7e, 0x9c, 0xd1, 0x34, 0x62, 0xa1,
+	0xba, 0xee, 0x6d, 0xc8, 0x7b, 0x0e, 0x2d, 0xa9, 0xe7, 0x93, 0x0c, 0x63, 0xd6, 0x85, 0xc6, 0xb8,
+	0xa8, 0xa9, 0xa1, 0x71, 0xc7, 0xc3, 0x92, 0x8e, 0x39, 0x20, 0xdc, 0x27, 0xd7, 0x4e, 0x7c, 0xa3,
+	0xcb, 0xe0, 0x5a, 0x5c, 0xe9, 0x25, 0x09, 0xef, 0x5f, 0x55, 0x68, 0x9f, 0x26, 0x19, 0x47, 0x63,
+	0x0b, 0xf3, 0x76, 0xa0, 0x6a, 0xdc, 0xaa, 0x7a, 0x7a, 0x4c, 0x0e, 0xa1, 0x1b, 0x96, 0x12, 0xae,
+	0x4a, 0xa3, 0xd7, 0xc4, 0x81, 0x97, 0xb3, 0xb1, 0xbf, 0x20, 0x4e, 0xee, 0x40, 0xfd, 0xa5, 0xf0,
+	0x82, 0xc2, 0x1d, 0x90, 0x3b, 0x86, 0xaf, 0xd8, 0xa8, 0xf6, 0x34, 0x4a, 0x74, 0x09, 0x21, 0xbe,
+	0x31, 0x41, 0x3c, 0x9f, 0xd1, 0x19, 0x0d, 0xbf, 0x64, 0xe7, 0x99, 0x32, 0x9a, 0x85, 0x90, 0x7b,
+	0x00, 0x63, 0xe3, 0x20, 0xca, 0x15, 0xb7, 0xb5, 0x3b, 0x5b, 0x7e, 0xe3, 0x5b, 0x62, 0xe4, 0x26,
+	0xd4, 0x9f, 0x0b, 0x43, 0x8b, 0x94, 0xd2, 0x3a, 0x68, 0x89, 0x01, 0xd2, 0xf6, 0xbe, 0x62, 0xe1,
+	0x7d, 0x30, 0xc3, 0x73, 0xe8, 0x35, 0xac, 0x34, 0x6c, 0x9d, 0x8f, 0x2f, 0xd9, 0xa8, 0xa1, 0xc8,
+	0x39, 0xf2, 0xd4, 0x9b, 0x32, 0x85, 0xe5, 0x88, 0x37, 0x10, 0xd7, 0xc0, 0x59, 0x1c, 0x60, 0x4d,
+	0x55, 0xc7, 0xb4, 0xc8, 0x64, 0x6e, 0xe8, 0xe4, 0x97, 0xd5, 0xa1, 0x40, 0x7d, 0xc5, 0x45, 0x57,
+	0x19, 0x06, 0x49, 0xa9, 0xf0, 0xb5, 0x21, 0xaf, 0x0f, 0x1b, 0xa2, 0xd0, 0x39, 0x53, 0x99, 0xc9,
+	0xd4, 0x2d, 0x95, 0xbc, 0x6e, 0x29, 0x25, 0xd7, 0x6a, 0x39, 0xb9, 0x7a, 0x7f, 0xa8, 0xc8, 0xcc,
+	0x25, 0x74, 0x73, 0xa1, 0x91, 0xd0, 0x97, 0xc7, 0x14, 0xf3, 0xa0, 0xf2, 0x36, 0x4d, 0x93, 0x3d,
+	0xa8, 0x4f, 0x64, 0xad, 0x25, 0x33, 0xa4, 0x2c, 0x7c, 0x0b, 0x0a, 0xf8, 0x4a, 0x62, 0xa1, 0xb6,
+	0x74, 0x96, 0xd4, 0x96, 0xb7, 0x60, 0x83, 0x66, 0x3c, 0x9a, 0x60, 0x26, 0x12, 0xb9, 0x5b, 0xfa,
+	0x64, 0x11, 0xf4, 0xce, 0xa1, 0xa5, 0x0e, 0x4c, 0x28, 0xb8, 0x58, 0x7c, 0xdc, 0xb0, 0x8b, 0x0f,
+	0x93, 0xc3, 0x50, 0xb8, 0x98, 0xc3, 0x9c, 0x52, 0x0e, 0x13, 0x12, 0x82, 0xe5, 0xfd, 0xb9, 0x0a,
+	0xce, 0x97, 0xec, 0x7c, 0xc1, 0xed, 0xaf, 0xc2, 0x5a, 0x30, 0x8d, 0x4e, 0x8f, 0x55, 0x32, 0x95,
+	0x04, 0x9e, 0x5f, 0xc6, 0x03, 0x3e, 0xcb, 0x7a, 0x8e, 0x75, 0x7e, 0x5f, 0xb2, 0xf3, 0x81, 0x40,
+	0x7d, 0xc5, 0x45, 0x5b, 0xd2, 0x34, 0xed, 0x07, 0xb3, 0x4c, 0x96, 0xfe, 0x4d, 0xdf, 0xd0, 0xc8,
+	0x0b, 0x74, 0x95, 0x27, 0xdd, 0xd9, 0xd0, 0x22, 0x8f, 0xd1, 0x57, 0x5c, 0x14, 0x1d, 0xbd, 0xba,
+	0xca, 0x63, 0x1a, 0xd0, 0x06, 0x58, 0x5f, 0x9a, 0xde, 0x1b, 0xc5, 0xf4, 0xde, 0x83, 0xf5, 0x8c,
+	0x07, 0x29, 0xa7, 0xd2, 0x23, 0x1d, 0x5f, 0x93, 0xb8, 0xfe, 0x28, 0x4a, 0xa2, 0x6c, 0x4c, 0x43,
+	0x51, 0xe4, 0x3a, 0xbe, 0xa1, 0x91, 0x37, 0x4d, 0x23, 0x96, 0x46, 0x7c, 0xde, 0x6b, 0x49, 0x9e,
+	0xa6, 0xbd, 0x4d, 0xd8, 0xe8, 0x8b, 0xc9, 0x7d, 0xfa, 0x7c, 0x46, 0x33, 0xee, 0xdd, 0x83, 0x96,
+	0x06, 0xa6, 0xf1, 0x7c, 0x99, 0x05, 0x39, 0x7b, 0x46, 0x13, 0x6d, 0x41, 0x41, 0x78, 0x2d, 0x68,
+	0x9e, 0x1e, 0xeb, 0x19, 0xfe, 0x17, 0xd6, 0x4f, 0x8f, 0x97, 0x8e, 0xf6, 0xb6, 0x61, 0x4b, 0x26,
+	0x88, 0xc3, 0x30, 0x4c, 0xb5, 0xfc, 0xbb, 0xb0, 0x69, 0x83, 0x38, 0x6e, 0x89, 0xdb, 0x7b, 0x27,
+	0xd0, 0x40, 0x01, 0x7d, 0x5b, 0x24, 0xc1, 0x84, 0x6a, 0x3e, 0x7e, 0x9b, 0x31, 0xd5, 0x7c, 0x0c,
+	0x62, 0x7c, 0x3e, 0x95, 0xf7, 0x7a, 0xd3, 0x17, 0xdf, 0x5e, 0x07, 0xda, 0x38, 0x4f, 0xa6, 0x97,
+	0x1f, 0x00, 0x28, 0x1a, 0x57, 0xbe, 0x09, 0x6b, 0x38, 0x32, 0x13, 0xd7, 0xb6, 0xf6, 0x2e, 0xbd,
+	0xae, 0x2f, 0x79, 0x18, 0xc8, 0x2a, 0x1d, 0x1e, 0xe6, 0x2b, 0xda, 0x90, 0x37, 0x86, 0xce, 0x23,
+	0xfa, 0xd2, 0xda, 0xe5, 0x52, 0x95, 0x77, 0xa0, 0xa5, 0xee, 0xca, 0xc7, 0xa8, 0xa5, 0x9a, 0xc7,
+	0x82, 0xe4, 0xcd, 0xf6, 0x8c, 0xaa, 0x74, 0x2c, 0xf6, 0xd1, 0xf0, 0x6d, 0xc8, 0xf3, 0xa0, 0x6d,
+	0x56, 0x5a, 0x65, 0xba, 0xf7, 0xe1, 0xda, 0x80, 0xf2, 0xe3, 0x5c, 0x3f, 0x4b, 0xa9, 0x05, 0xe1,
+	0x6b, 0xb0, 0x5d, 0x16, 0x9e, 0xc6, 0x73, 0xef, 0x21, 0x74, 0x06, 0x34, 0x09, 0x45, 0xe3, 0x66,
+	0x06, 0x8f, 0x52, 0x36, 0xd1, 0x83, 0xf1, 0x1b, 0x0f, 0x9c, 0x33, 0xb5, 0x91, 0x2a, 0x67, 0xe4,
+	0x2d, 0xa8, 0x07, 0x13, 0xec, 0x19, 0xd5, 0x11, 0x28, 0xca, 0xdb, 0x87, 0xb6, 0x99, 0x0d, 0xb5,
+	0xc6, 0x5e, 0x87, 0x66, 0x98, 0x78, 0xf3, 0x62, 0xce, 0x42, 0xbc, 0x8f, 0x80, 0x3c, 0x0d, 0x22,
+	0xfe, 0x95, 0x44, 0xb4, 0x06, 0xaf, 0x1b, 0x45, 0xa0, 0x5b, 0x18, 0x85, 0xfb, 0xd8, 0x07, 0xf7,
+	0xbe, 0xd9, 0x5e, 0x7e, 0xbf, 0xa9, 0x19, 0x17, 0xb2, 0x91, 0x77, 0x04, 0xbd, 0xa5, 0xf2, 0xa8,
+	0xf5, 0x6d, 0xa8, 0x0f, 0x05, 0x59, 0xe8, 0x52, 0x72, 0x21, 0xc5, 0xf5, 0xee, 0xc0, 0xf6, 0x7d,
+	0xfa, 0x26, 0x8b, 0x7d, 0x0e, 0x5b, 0xf7, 0xe9, 0x7f, 0xba, 0xca, 0x4f, 0xc0, 0x1d, 0xac, 0xde,
+	0xd9, 0xdd, 0xd2, 0x2c, 0x2b, 0xee, 0x79, 0x3d, 0x99, 0x0b, 0xbd, 0xc1, 0x8a, 0x6d, 0x7b, 0x6f,
+	0x43, 0x6b, 0x30, 0x66, 0x2f, 0x57, 0x6f, 0xe3, 0x1e, 0x34, 0xa5, 0x80, 0x54, 0x7f, 0x7d, 0x28,
+	0xeb, 0xdb, 0x42, 0xe9, 0xac, 0x6a, 0x5e, 0x5f, 0x33, 0xbd, 0x0d, 0x68, 0x09, 0x40, 0x85, 0xe5,
+	0x01, 0x34, 0x25, 0x89, 0x73, 0xbc, 0x0b, 0xb5, 0x28, 0x9f, 0x40, 0x36, 0xb8, 0x76, 0x7d, 0xe3,
+	0x0b, 0xb6, 0x77, 0x1b, 0xcf, 0x9b, 0x0f, 0xc7, 0x58, 0x42, 0x58, 0x5e, 0x7a, 0x19, 0x85, 0x32,
+	0x9e, 0x9b, 0xbe, 0xf8, 0xf6, 0x3e, 0x80, 0x8e, 0x25, 0x87, 0x0b, 0xb8, 0xe0, 0x5c, 0xb2, 0x73,
+	0x35, 0x7f, 0x43, 0xe7, 0x7f, 0x1f, 0x41, 0xef, 0x63, 0x35, 0xeb, 0x43, 0x76, 0x91, 0xad, 0xdc,
+	0x33, 0x22, 0x97, 0x79, 0x95, 0x7e, 0x29, 0x0e, 0xb3, 0x63, 0x8d, 0xc3, 0x55, 0xde, 0x83, 0x46,
+	0xcc, 0x2e, 0xbe, 0xc0, 0x17, 0x14, 0xb5, 0x94, 0xcc, 0x2f, 0x0f, 0x15, 0xe8, 0x1b, 0xb6, 0x77,
+	0x09, 0x0d, 0x8d, 0xbe, 0xc9, 0x62, 0x22, 0xd3, 0x45, 0x13, 0xd3, 0xc1, 0xe0, 0x37, 0x4a, 0x4d,
+	0xb2, 0x0b, 0x75, 0x55, 0xe1, 0x27, 0x66, 0x6f, 0xfa, 0x02, 0xab, 0x45, 0xd5, 0xad, 0x09, 0xc2,
+	0xfb, 0x67, 0x05, 0xb6, 0xce, 0x66, 0xd9, 0xf8, 0x35, 0xde, 0x69, 0x39, 0x62, 0xf5, 0xdb, 0x1c,
+	0x11, 0xef, 0xbb, 0x71, 0xa0, 0x9e, 0xea, 0x54, 0xca, 0xca, 0x01, 0x7c, 0x81, 0x62, 0x2f, 0x68,
+	0x9a, 0x46, 0x21, 0x55, 0x22, 0x35, 0x21, 0x52, 0x42, 0xc9, 0x07, 0xb0, 0x35, 0x0e, 0xb2, 0x9f,
+	0x16, 0x45, 0xd7, 0x84, 0xe8, 0x22, 0xa3, 0x70, 0xc7, 0xd5, 0x4b, 0x77, 0xdc, 0x1d, 0xd8, 0xb4,
+	0xb7, 0x87, 0x27, 0xb1, 0xb4, 0xd3, 0xf2, 0xfe, 0x8e, 0x86, 0x88, 0x83, 0xe4, 0xbf, 0xd8, 0x10,
+	0xde, 0x0f, 0x60, 0xd3, 0xde, 0x02, 0x6e, 0xf6, 0x16, 0xd4, 0xa6, 0x71, 0xa0, 0x3b, 0x57, 0x59,
+	0xf1, 0x5a, 0x25, 0x98, 0x2f, 0xb8, 0xde, 0x0d, 0x80, 0xfb, 0x94, 0xaf, 0x0e, 0xea, 0x1d, 0x68,
+	0x08, 0xbe, 0x32, 0xdf, 0x70, 0x3c, 0x4b, 0x9e, 0x09, 0x7e, 0xdb, 0x97, 0x84, 0xe7, 0x41, 0x07,
+	0xb3, 0x17, 0x8b, 0xc3, 0xd5, 0xb3, 0xdc, 0x82, 0xb6, 0x91, 0x59, 0x3d, 0xd3, 0x2d, 0xe8, 0xf6,
+	0x31, 0xb6, 0x63, 0x0c, 0xc2, 0x7c, 0xae, 0xcb, 0x7c, 0x2e, 0x0c, 0xb0, 0x2e, 0x74, 0x2c, 0x29,
+	0xcc, 0x4c, 0x7f, 0xab, 0xc0, 0xe6, 0xc3, 0x28, 0xe3, 0x76, 0x02, 0x58, 0x3c, 0xbe, 0x3d, 0x68,
+	0xc8, 0x8a, 0x8e, 0xca, 0xca, 0x77, 0xb1, 0xe2, 0x33, 0x7c, 0x51, 0xb3, 0xcb, 0xe2, 0xeb, 0x04,
+	0xef, 0x3a, 0x19, 0x5e, 0x36, 0x24, 0x7a, 0x36, 0x49, 0x3e, 0x66, 0xfa, 0xc1, 0xc6, 0x00, 0x78,
+	0x01, 0xb2, 0xd1, 0x28, 0xa3, 0x5c, 0x55, 0x85, 0x8a, 0xc2, 0x7d, 0xc7, 0xd1, 0x24, 0xe2, 0xca,
+	0x59, 0x25, 0xe1, 0xdd, 0x85, 0x8d, 0x5c, 0x7d, 0x34, 0xcf, 0x75, 0xa8, 0x5d, 0xb2, 0x73, 0x99,
+	0xbd, 0xec, 0xc4, 0x24, 0x50, 0xef, 0xb7, 0x15, 0x68, 0x89, 0x6e, 0xc4, 0xa7, 0x43, 0x96, 0x86,
+	0x4b, 0x1f, 0xf2, 0x0a, 0x0f, 0x44, 0xd5, 0xc5, 0x07, 0x22, 0xf3, 0x80, 0xe3, 0xd8, 0x0f, 0x38,
+	0xf9, 0xbd, 0x2d, 0x6b, 0x78, 0x45, 0x99, 0x34, 0xb3, 0x96, 0xa7, 0x19, 0xef, 0xe7, 0xd0, 0x56,
+	0x4a, 0xac, 0x32, 0xb8, 0x59, 0xa3, 0x6a, 0xaf, 0xa1, 0xeb, 0x07, 0x95, 0xb2, 0xac, 0xfa, 0x41,
+	0x5a, 0xb1, 0xca, 0x99, 0xf7, 0x7b, 0x07, 0x40, 0x4d, 0xae, 0xbc, 0x45, 0xb4, 0x60, 0x62, 0xf2,
+	0x9a, 0x2f, 0x09, 0xf2, 0x21, 0xac, 0x9d, 0xcf, 0xe5, 0xf6, 0xd0, 0x4a, 0xae, 0x74, 0x70, 0x33,
+	0x6a, 0xff, 0x08, 0x99, 0x32, 0xc1, 0x4a, 0x41, 0xf2, 0x31, 0xac, 0x9f, 0xcf, 0xbf, 0x52, 0xdb,
+	0xc6, 0x31, 0xd7, 0x17, 0xc7, 0x08, 0xb6, 0x1c, 0xa5, 0x85, 0xe5, 0x4a, 0xc7, 0xc1, 0xbc, 0x57,
+	0x5b, 0xb5, 0xd2, 0x71, 0x30, 0x37, 0x2b, 0x1d, 0x07, 0x73, 0xb2, 0x87, 0xef, 0x6f, 0x78, 0x38,
+	0xd8, 0x16, 0x38, 0x79, 0xf8, 0xe5, 0xa7, 0xe6, 0x6b, 0x01, 0xf7, 0x13, 0x80, 0x5c, 0x55, 0x34,
+	0xe3, 0x33, 0x3a, 0xd7, 0x66, 0x7c, 0x46, 0xc5, 0xee, 0x5f, 0x04, 0xf1, 0x4c, 0xf7, 0x7c, 0x92,
+	0xf8, 0xac, 0xfa, 0x49, 0xc5, 0xfd, 0x0c, 0xda, 0xb6, 0xc2, 0xdf, 0x69, 0xac, 0x58, 0x55, 0xab,
+	0xfd, 0x5d, 0x46, 0x62, 0x25, 0xdd, 0x8f, 0x59, 0x66, 0x5a, 0x87, 0x36, 0x80, 0xa2, 0x31, 0x16,
+	0x9f, 0x42, 0x77, 0x40, 0xb9, 0xea, 0xbe, 0xf3, 0x82, 0x2d, 0xd2, 0xd7, 0xb6, 0xce, 0xbd, 0x16,
+	0x62, 0x75, 0xf0, 0xd5, 0x95, 0x1d, 0x3c, 0x86, 0xbd, 0x35, 0x31, 0x2e, 0xf5, 0x0b, 0x68, 0x3d,
+	0x66, 0xd3, 0x27, 0xd3, 0x33, 0x16, 0x47, 0xc3, 0x79, 0xf1, 0x07, 0x9f, 0x8a, 0x7a, 0x3f, 0xd4,
+	0x80, 0xe5, 0xda, 0xd5, 0x82, 0x6b, 0xe3, 0x7b, 0x68, 0x10, 0xc5, 0xf3, 0x7e, 0x30, 0x15, 0x2e,
+	0x59, 0xf3, 0x0d, 0xed, 0x05, 0xa2, 0x80, 0xb6, 0xd6, 0x78, 0xd3, 0x0d, 0xed, 0x42, 0x7d, 0x2a,
+	0x06, 0xa8, 0x0d, 0xc9, 0xd3, 0xb7, 0x27, 0x52, 0x7c, 0x55, 0x76, 0x17, 0x96, 0xc0, 0xad, 0x7d,
+	0x0d, 0xad, 0x93, 0x59, 0x12, 0xbe, 0xe9, 0x7a, 0xcb, 0x9a, 0xa0, 0x55, 0x35, 0xf8, 0xfb, 0xd0,
+	0x3c, 0x99, 0xe9, 0xc8, 0x7a, 0x5d, 0x29, 0x7d, 0x07, 0x36, 0x0f, 0xc3, 0xf0, 0x31, 0x7b, 0xc0,
+	0xcc, 0x15, 0xb1, 0x3c, 0x75, 0xbf, 0x03, 0x1b, 0xb9, 0x20, 0xce, 0xbc, 0x90, 0x0e, 0xf6, 0x4e,
+	0x61, 0xa3, 0xf0, 0xeb, 0x1a, 0xd9, 0x52, 0xc0, 0x23, 0xfa, 0x52, 0x38, 0x71, 0xf7, 0x0a, 0x21,
+	0xd0, 0x91, 0x32, 0xc1, 0x84, 0x4a, 0xac, 0x62, 0xc4, 0xfa, 0x63, 0x1a, 0x4c, 0x69, 0xc6, 0xbb,
+	0xd5, 0xbd, 0x0b, 0x68, 0xdb, 0x3f, 0x74, 0x90, 0x6d, 0xd8, 0x54, 0xf4, 0x69, 0xc2, 0x69, 0x9a,
+	0x04, 0x71, 0xf7, 0x0a, 0xb9, 0x06, 0x5b, 0x0a, 0xcc, 0x1f, 0x8f, 0xba, 0x15, 0x4b, 0x56, 0xbf,
+	0xbf, 0x74, 0xab, 0xe4, 0x2d, 0x20, 0x7a, 0x42, 0x16, 0x87, 0x5a, 0xd8, 0xd9, 0x7b, 0x04, 0x4d,
+	0x73, 0x3d, 0x10, 0xc0, 0x97, 0x47, 0x7c, 0xab, 0xea, 0x5e, 0x21, 0x1d, 0x80, 0xd3, 0xe4, 0x2c,
+	0x65, 0x17, 0xd8, 0xb3, 0x75, 0x2b, 0xc8, 0xc3, 0x09, 0x68, 0xd8, 0xad, 0x92, 0x36, 0x34, 0xe4,
+	0x05, 0x45, 0xc3, 0xae, 0x43, 0x5a, 0xb0, 0x3e, 0x98, 0x0d, 0x87, 0x28, 0x56, 0xdb, 0xfb, 0x1a,
+	0x9a, 0xe6, 0x81, 0x08, 0x37, 0x66, 0x88, 0x47, 0x2c, 0xa1, 0xdd, 0x2b, 0xa4, 0x0b, 0x6d, 0x03,
+	0x9d, 0x45, 0x89, 0x54, 0xd7, 0x20, 0x3e, 0x9d, 0xb0, 0x17, 0xa8, 0x2e, 0x81, 0x8e, 0x01, 0x9f,
+	0x24, 0x29, 0x1d, 0x75, 0x9d, 0x83, 0x6f, 0xda, 0x50, 0x3f, 0x39, 0x19, 0x1c, 0x9e, 0x9d, 0x92,
+	0x0f, 0xa1, 0x2e, 0x9b, 0x79, 0x22, 0xdf, 0x76, 0x0a, 0xad, 0xbe, 0xdb, 0x2d, 0x60, 0xe8, 0x6d,
+	0x57, 0xc8, 0x2d, 0xec, 0xd8, 0x89, 0xbc, 0x0f, 0x4d, 0x4b, 0xef, 0xb6, 0x0d, 0x2d, 0xa5, 0x7e,
+	0xa8, 0x5f, 0x80, 0x0f, 0x85, 0x83, 0x59, 0x2f, 0x7f, 0x56, 0x77, 0xe9, 0x5e, 0x5d, 0xc0, 0xe5,
+	0xe8, 0xbb, 0xb0, 0x76, 0x28, 0xfa, 0xe8, 0x2d, 0xd3, 0x5d, 0xeb, 0x0c, 0xe1, 0x6e, 0xda, 0x90,
+	0x14, 0xbf, 0x07, 0xeb, 0xaa, 0xc3, 0x25, 0xf2, 0x09, 0xb0, 0xd8, 0x59, 0xbb, 0x5b, 0x45, 0x50,
+	0x0e, 0x7a, 0x00, 0x9d, 0xbc, 0x7f, 0x11, 0x63, 0x55, 0xb2, 0x5e, 0xd6, 0x07, 0xbb, 0xbd, 0xa5,
+	0x3c, 0xb3, 0xbc, 0x6a, 0x55, 0xd5, 0xf2, 0xc5, 0x36, 0xd8, 0xdd, 0x2a, 0x82, 0x72, 0xd0, 0x8f,
+	0xa0, 0x65, 0x75, 0x9e, 0xe4, 0x7f, 0x94, 0x25, 0xca, 0x1d, 0xac, 0x7b, 0x6d, 0x91, 0x21, 0x27,
+	0x78, 0x2a, 0x5a, 0xc6, 0x85, 0x1f, 0xc5, 0xde, 0x16, 0xf2, 0xab, 0x1b, 0x58, 0xf7, 0xff, 0x56,
+	0x0b, 0xc8, 0x89, 0x8f, 0x64, 0x01, 0x66, 0x66, 0xec, 0xe9, 0x01, 0x0b, 0x53, 0xbd, 0xb5, 0x84,
+	0x63, 0x94, 0x1b, 0xac, 0x54, 0x6e, 0xf0, 0x3a, 0xe5, 0x06, 0xab, 0x95, 0xdb, 0x83, 0x1a, 0x36,
+	0x8e, 0x44, 0x5d, 0x92, 0x79, 0x93, 0xe9, 0x76, 0x2c, 0xc4, 0xc8, 0x8a, 0xb7, 0xa0, 0xae, 0xea,
+	0x06, 0x47, 0xac, 0x28, 0x6b, 0xba, 0x47, 0xef, 0x0a, 0xf9, 0x1c, 0x9a, 0xa6, 0xe1, 0x23, 0xda,
+	0xe6, 0xc5, 0x46, 0xd1, 0xdd, 0x2e, 0xc3, 0x62, 0xe8, 0x87, 0x15, 0x33, 0x18, 0xfb, 0x38, 0x7b,
+	0xb0, 0xd5, 0x0f, 0xba, 0xdb, 0x65, 0x58, 0x0f, 0xfe, 0x14, 0x9a, 0xa6, 0x46, 0x55, 0x83, 0xcb,
+	0x95, 0xad, 0xbb, 0x5d, 0x86, 0xa5, 0xd2, 0x1f, 0x43, 0x43, 0x17, 0x83, 0x44, 0x86, 0x52, 0xa9,
+	0xb4, 0x75, 0x49, 0x09, 0x35, 0xe1, 0x25, 0x7f, 0x9a, 0xd8, 0xb2, 0x4b, 0x0d, 0x3b, 0xbc, 0xf2,
+	0x8a, 0x45, 0xc6, 0x72, 0xde, 0x1d, 0xa9, 0x58, 0x5e, 0xe8, 0x06, 0xdd, 0xab, 0x0b, 0x78, 0x3e,
+	0xda, 0xb4, 0x1b, 0x7a, 0x74, 0xb9, 0x85, 0x72, 0xaf, 0x2e, 0xe0, 0x72, 0xf4, 0x7b, 0xe0, 0xdc,
+	0xa7, 0x9c, 0x6c, 0x6a, 0x4f, 0xd3, 0xf2, 0x1b, 0x39, 0xa0, 0x0d, 0xf9, 0x7d, 0x58, 0x57, 0x8d,
+	0x83, 0x0a, 0xc3, 0x62, 0xab, 0xe1, 0x6e, 0x15, 0x41, 0x3d, 0xec, 0x2e, 0xac, 0x89, 0x9a, 0x44,
+	0x19, 0xc3, 0xae, 0x57, 0xdc, 0x4d, 0x1b, 0x92, 0x0a, 0x7d, 0x02, 0x0d, 0x7d, 0x7b, 0x29, 0x9b,
+	0x97, 0x6e, 0x3d, 0x97, 0x94, 0x50, 0x31, 0x6e, 0x57, 0x1c, 0xb4, 0xa9, 0x4a, 0xd4, 0x41, 0x97,
+	0xcb, 0x1f, 0x77, 0xbb, 0x0c, 0x1b, 0x4f, 0xc6, 0x8b, 0x58, 0x79, 0xb2, 0x75, 0xdd, 0xbb, 0x1d,
+	0x0b, 0xb1, 0xf3, 0x9a, 0x5d, 0xed, 0x98, 0xbc, 0xb6, 0x58, 0x9e, 0xb8, 0xbd, 0xa5, 0x3c, 0x31,
+	0xd3, 0xd1, 0xf7, 0xc0, 0x8d, 0xd8, 0x3e, 0xa7, 0xaf, 0x78, 0x14, 0xd3, 0x7d, 0xfd, 0x03, 0xe6,
+	0xbe, 0xf8, 0x0f, 0xd0, 0xf9, 0x51, 0xeb, 0x44, 0x01, 0xa3, 0x51, 0x76, 0x56, 0xf9, 0x63, 0xd5,
+	0x79, 0xfc, 0xf8, 0x8b, 0xf3, 0xba, 0xf8, 0x73, 0xd0, 0xbd, 0x7f, 0x0f, 0x00, 0x7a, 0xd6, 0x7e,
+	0xf1, 0x29, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2225,6 +4595,11 @@ type FFSAPIClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateReply, error)
 	ID(ctx context.Context, in *IDRequest, opts ...grpc.CallOption) (*IDReply, error)
 	WalletAddr(ctx context.Context, in *WalletAddrRequest, opts ...grpc.CallOption) (*WalletAddrReply, error)
+	Addrs(ctx context.Context, in *AddrsRequest, opts ...grpc.CallOption) (*AddrsReply, error)
+	NewAddr(ctx context.Context, in *NewAddrRequest, opts ...grpc.CallOption) (*NewAddrReply, error)
+	SetDefaultAddr(ctx context.Context, in *SetDefaultAddrRequest, opts ...grpc.CallOption) (*SetDefaultAddrReply, error)
+	SendFil(ctx context.Context, in *SendFilRequest, opts ...grpc.CallOption) (*SendFilReply, error)
+	WaitMessage(ctx context.Context, in *WaitMessageRequest, opts ...grpc.CallOption) (*WaitMessageReply, error)
 	GetDefaultCidConfig(ctx context.Context, in *GetDefaultCidConfigRequest, opts ...grpc.CallOption) (*GetDefaultCidConfigReply, error)
 	GetCidConfig(ctx context.Context, in *GetCidConfigRequest, opts ...grpc.CallOption) (*GetCidConfigReply, error)
 	SetDefaultCidConfig(ctx context.Context, in *SetDefaultCidConfigRequest, opts ...grpc.CallOption) (*SetDefaultCidConfigReply, error)
@@ -2232,10 +4607,18 @@ type FFSAPIClient interface {
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoReply, error)
 	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (FFSAPI_WatchJobsClient, error)
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (FFSAPI_WatchLogsClient, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobReply, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error)
+	Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendReply, error)
 	PushConfig(ctx context.Context, in *PushConfigRequest, opts ...grpc.CallOption) (*PushConfigReply, error)
+	PlanConfig(ctx context.Context, in *PlanConfigRequest, opts ...grpc.CallOption) (*PlanConfigReply, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (FFSAPI_GetClient, error)
+	GetCold(ctx context.Context, in *GetColdRequest, opts ...grpc.CallOption) (FFSAPI_GetColdClient, error)
 	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseReply, error)
 	AddToHot(ctx context.Context, opts ...grpc.CallOption) (FFSAPI_AddToHotClient, error)
+	SetQuotas(ctx context.Context, in *SetQuotasRequest, opts ...grpc.CallOption) (*SetQuotasReply, error)
+	Fund(ctx context.Context, in *FundRequest, opts ...grpc.CallOption) (*FundReply, error)
+	SetTopUpPolicy(ctx context.Context, in *SetTopUpPolicyRequest, opts ...grpc.CallOption) (*SetTopUpPolicyReply, error)
 }
 
 type fFSAPIClient struct {
@@ -2273,6 +4656,51 @@ func (c *fFSAPIClient) WalletAddr(ctx context.Context, in *WalletAddrRequest, op
 	return out, nil
 }
 
+func (c *fFSAPIClient) Addrs(ctx context.Context, in *AddrsRequest, opts ...grpc.CallOption) (*AddrsReply, error) {
+	out := new(AddrsReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/Addrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) NewAddr(ctx context.Context, in *NewAddrRequest, opts ...grpc.CallOption) (*NewAddrReply, error) {
+	out := new(NewAddrReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/NewAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) SetDefaultAddr(ctx context.Context, in *SetDefaultAddrRequest, opts ...grpc.CallOption) (*SetDefaultAddrReply, error) {
+	out := new(SetDefaultAddrReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/SetDefaultAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) SendFil(ctx context.Context, in *SendFilRequest, opts ...grpc.CallOption) (*SendFilReply, error) {
+	out := new(SendFilReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/SendFil", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) WaitMessage(ctx context.Context, in *WaitMessageRequest, opts ...grpc.CallOption) (*WaitMessageReply, error) {
+	out := new(WaitMessageReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/WaitMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fFSAPIClient) GetDefaultCidConfig(ctx context.Context, in *GetDefaultCidConfigRequest, opts ...grpc.CallOption) (*GetDefaultCidConfigReply, error) {
 	out := new(GetDefaultCidConfigReply)
 	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/GetDefaultCidConfig", in, out, opts...)
@@ -2365,38 +4793,106 @@ func (c *fFSAPIClient) WatchLogs(ctx context.Context, in *WatchLogsRequest, opts
 	return x, nil
 }
 
-type FFSAPI_WatchLogsClient interface {
-	Recv() (*WatchLogsReply, error)
+type FFSAPI_WatchLogsClient interface {
+	Recv() (*WatchLogsReply, error)
+	grpc.ClientStream
+}
+
+type fFSAPIWatchLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fFSAPIWatchLogsClient) Recv() (*WatchLogsReply, error) {
+	m := new(WatchLogsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fFSAPIClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobReply, error) {
+	out := new(CancelJobReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error) {
+	out := new(ListJobsReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendReply, error) {
+	out := new(SpendReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/Spend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) PushConfig(ctx context.Context, in *PushConfigRequest, opts ...grpc.CallOption) (*PushConfigReply, error) {
+	out := new(PushConfigReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/PushConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) PlanConfig(ctx context.Context, in *PlanConfigRequest, opts ...grpc.CallOption) (*PlanConfigReply, error) {
+	out := new(PlanConfigReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/PlanConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (FFSAPI_GetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FFSAPI_serviceDesc.Streams[2], "/rpc.FFSAPI/Get", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fFSAPIGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FFSAPI_GetClient interface {
+	Recv() (*GetReply, error)
 	grpc.ClientStream
 }
 
-type fFSAPIWatchLogsClient struct {
+type fFSAPIGetClient struct {
 	grpc.ClientStream
 }
 
-func (x *fFSAPIWatchLogsClient) Recv() (*WatchLogsReply, error) {
-	m := new(WatchLogsReply)
+func (x *fFSAPIGetClient) Recv() (*GetReply, error) {
+	m := new(GetReply)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *fFSAPIClient) PushConfig(ctx context.Context, in *PushConfigRequest, opts ...grpc.CallOption) (*PushConfigReply, error) {
-	out := new(PushConfigReply)
-	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/PushConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *fFSAPIClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (FFSAPI_GetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_FFSAPI_serviceDesc.Streams[2], "/rpc.FFSAPI/Get", opts...)
+func (c *fFSAPIClient) GetCold(ctx context.Context, in *GetColdRequest, opts ...grpc.CallOption) (FFSAPI_GetColdClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FFSAPI_serviceDesc.Streams[3], "/rpc.FFSAPI/GetCold", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &fFSAPIGetClient{stream}
+	x := &fFSAPIGetColdClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -2406,17 +4902,17 @@ func (c *fFSAPIClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.Cal
 	return x, nil
 }
 
-type FFSAPI_GetClient interface {
-	Recv() (*GetReply, error)
+type FFSAPI_GetColdClient interface {
+	Recv() (*GetColdReply, error)
 	grpc.ClientStream
 }
 
-type fFSAPIGetClient struct {
+type fFSAPIGetColdClient struct {
 	grpc.ClientStream
 }
 
-func (x *fFSAPIGetClient) Recv() (*GetReply, error) {
-	m := new(GetReply)
+func (x *fFSAPIGetColdClient) Recv() (*GetColdReply, error) {
+	m := new(GetColdReply)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -2433,7 +4929,7 @@ func (c *fFSAPIClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc
 }
 
 func (c *fFSAPIClient) AddToHot(ctx context.Context, opts ...grpc.CallOption) (FFSAPI_AddToHotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_FFSAPI_serviceDesc.Streams[3], "/rpc.FFSAPI/AddToHot", opts...)
+	stream, err := c.cc.NewStream(ctx, &_FFSAPI_serviceDesc.Streams[4], "/rpc.FFSAPI/AddToHot", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2466,11 +4962,43 @@ func (x *fFSAPIAddToHotClient) CloseAndRecv() (*AddToHotReply, error) {
 	return m, nil
 }
 
+func (c *fFSAPIClient) SetQuotas(ctx context.Context, in *SetQuotasRequest, opts ...grpc.CallOption) (*SetQuotasReply, error) {
+	out := new(SetQuotasReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/SetQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) Fund(ctx context.Context, in *FundRequest, opts ...grpc.CallOption) (*FundReply, error) {
+	out := new(FundReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/Fund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fFSAPIClient) SetTopUpPolicy(ctx context.Context, in *SetTopUpPolicyRequest, opts ...grpc.CallOption) (*SetTopUpPolicyReply, error) {
+	out := new(SetTopUpPolicyReply)
+	err := c.cc.Invoke(ctx, "/rpc.FFSAPI/SetTopUpPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FFSAPIServer is the server API for FFSAPI service.
 type FFSAPIServer interface {
 	Create(context.Context, *CreateRequest) (*CreateReply, error)
 	ID(context.Context, *IDRequest) (*IDReply, error)
 	WalletAddr(context.Context, *WalletAddrRequest) (*WalletAddrReply, error)
+	Addrs(context.Context, *AddrsRequest) (*AddrsReply, error)
+	NewAddr(context.Context, *NewAddrRequest) (*NewAddrReply, error)
+	SetDefaultAddr(context.Context, *SetDefaultAddrRequest) (*SetDefaultAddrReply, error)
+	SendFil(context.Context, *SendFilRequest) (*SendFilReply, error)
+	WaitMessage(context.Context, *WaitMessageRequest) (*WaitMessageReply, error)
 	GetDefaultCidConfig(context.Context, *GetDefaultCidConfigRequest) (*GetDefaultCidConfigReply, error)
 	GetCidConfig(context.Context, *GetCidConfigRequest) (*GetCidConfigReply, error)
 	SetDefaultCidConfig(context.Context, *SetDefaultCidConfigRequest) (*SetDefaultCidConfigReply, error)
@@ -2478,10 +5006,18 @@ type FFSAPIServer interface {
 	Info(context.Context, *InfoRequest) (*InfoReply, error)
 	WatchJobs(*WatchJobsRequest, FFSAPI_WatchJobsServer) error
 	WatchLogs(*WatchLogsRequest, FFSAPI_WatchLogsServer) error
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobReply, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error)
+	Spend(context.Context, *SpendRequest) (*SpendReply, error)
 	PushConfig(context.Context, *PushConfigRequest) (*PushConfigReply, error)
+	PlanConfig(context.Context, *PlanConfigRequest) (*PlanConfigReply, error)
 	Get(*GetRequest, FFSAPI_GetServer) error
+	GetCold(*GetColdRequest, FFSAPI_GetColdServer) error
 	Close(context.Context, *CloseRequest) (*CloseReply, error)
 	AddToHot(FFSAPI_AddToHotServer) error
+	SetQuotas(context.Context, *SetQuotasRequest) (*SetQuotasReply, error)
+	Fund(context.Context, *FundRequest) (*FundReply, error)
+	SetTopUpPolicy(context.Context, *SetTopUpPolicyRequest) (*SetTopUpPolicyReply, error)
 }
 
 // UnimplementedFFSAPIServer can be embedded to have forward compatible implementations.
@@ -2497,6 +5033,21 @@ func (*UnimplementedFFSAPIServer) ID(ctx context.Context, req *IDRequest) (*IDRe
 func (*UnimplementedFFSAPIServer) WalletAddr(ctx context.Context, req *WalletAddrRequest) (*WalletAddrReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WalletAddr not implemented")
 }
+func (*UnimplementedFFSAPIServer) Addrs(ctx context.Context, req *AddrsRequest) (*AddrsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Addrs not implemented")
+}
+func (*UnimplementedFFSAPIServer) NewAddr(ctx context.Context, req *NewAddrRequest) (*NewAddrReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewAddr not implemented")
+}
+func (*UnimplementedFFSAPIServer) SetDefaultAddr(ctx context.Context, req *SetDefaultAddrRequest) (*SetDefaultAddrReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAddr not implemented")
+}
+func (*UnimplementedFFSAPIServer) SendFil(ctx context.Context, req *SendFilRequest) (*SendFilReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFil not implemented")
+}
+func (*UnimplementedFFSAPIServer) WaitMessage(ctx context.Context, req *WaitMessageRequest) (*WaitMessageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitMessage not implemented")
+}
 func (*UnimplementedFFSAPIServer) GetDefaultCidConfig(ctx context.Context, req *GetDefaultCidConfigRequest) (*GetDefaultCidConfigReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultCidConfig not implemented")
 }
@@ -2518,18 +5069,42 @@ func (*UnimplementedFFSAPIServer) WatchJobs(req *WatchJobsRequest, srv FFSAPI_Wa
 func (*UnimplementedFFSAPIServer) WatchLogs(req *WatchLogsRequest, srv FFSAPI_WatchLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchLogs not implemented")
 }
+func (*UnimplementedFFSAPIServer) CancelJob(ctx context.Context, req *CancelJobRequest) (*CancelJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (*UnimplementedFFSAPIServer) ListJobs(ctx context.Context, req *ListJobsRequest) (*ListJobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (*UnimplementedFFSAPIServer) Spend(ctx context.Context, req *SpendRequest) (*SpendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spend not implemented")
+}
 func (*UnimplementedFFSAPIServer) PushConfig(ctx context.Context, req *PushConfigRequest) (*PushConfigReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushConfig not implemented")
 }
+func (*UnimplementedFFSAPIServer) PlanConfig(ctx context.Context, req *PlanConfigRequest) (*PlanConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanConfig not implemented")
+}
 func (*UnimplementedFFSAPIServer) Get(req *GetRequest, srv FFSAPI_GetServer) error {
 	return status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (*UnimplementedFFSAPIServer) GetCold(req *GetColdRequest, srv FFSAPI_GetColdServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCold not implemented")
+}
 func (*UnimplementedFFSAPIServer) Close(ctx context.Context, req *CloseRequest) (*CloseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
 func (*UnimplementedFFSAPIServer) AddToHot(srv FFSAPI_AddToHotServer) error {
 	return status.Errorf(codes.Unimplemented, "method AddToHot not implemented")
 }
+func (*UnimplementedFFSAPIServer) SetQuotas(ctx context.Context, req *SetQuotasRequest) (*SetQuotasReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuotas not implemented")
+}
+func (*UnimplementedFFSAPIServer) Fund(ctx context.Context, req *FundRequest) (*FundReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fund not implemented")
+}
+func (*UnimplementedFFSAPIServer) SetTopUpPolicy(ctx context.Context, req *SetTopUpPolicyRequest) (*SetTopUpPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopUpPolicy not implemented")
+}
 
 func RegisterFFSAPIServer(s *grpc.Server, srv FFSAPIServer) {
 	s.RegisterService(&_FFSAPI_serviceDesc, srv)
@@ -2589,6 +5164,96 @@ func _FFSAPI_WalletAddr_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FFSAPI_Addrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).Addrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/Addrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).Addrs(ctx, req.(*AddrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_NewAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).NewAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/NewAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).NewAddr(ctx, req.(*NewAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_SetDefaultAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).SetDefaultAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/SetDefaultAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).SetDefaultAddr(ctx, req.(*SetDefaultAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_SendFil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).SendFil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/SendFil",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).SendFil(ctx, req.(*SendFilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_WaitMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).WaitMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/WaitMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).WaitMessage(ctx, req.(*WaitMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FFSAPI_GetDefaultCidConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDefaultCidConfigRequest)
 	if err := dec(in); err != nil {
@@ -2721,6 +5386,60 @@ func (x *fFSAPIWatchLogsServer) Send(m *WatchLogsReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _FFSAPI_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_Spend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).Spend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/Spend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).Spend(ctx, req.(*SpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FFSAPI_PushConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PushConfigRequest)
 	if err := dec(in); err != nil {
@@ -2739,6 +5458,24 @@ func _FFSAPI_PushConfig_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FFSAPI_PlanConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).PlanConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/PlanConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).PlanConfig(ctx, req.(*PlanConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FFSAPI_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2760,6 +5497,27 @@ func (x *fFSAPIGetServer) Send(m *GetReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _FFSAPI_GetCold_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetColdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FFSAPIServer).GetCold(m, &fFSAPIGetColdServer{stream})
+}
+
+type FFSAPI_GetColdServer interface {
+	Send(*GetColdReply) error
+	grpc.ServerStream
+}
+
+type fFSAPIGetColdServer struct {
+	grpc.ServerStream
+}
+
+func (x *fFSAPIGetColdServer) Send(m *GetColdReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _FFSAPI_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloseRequest)
 	if err := dec(in); err != nil {
@@ -2804,6 +5562,60 @@ func (x *fFSAPIAddToHotServer) Recv() (*AddToHotRequest, error) {
 	return m, nil
 }
 
+func _FFSAPI_SetQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).SetQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/SetQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).SetQuotas(ctx, req.(*SetQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_Fund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).Fund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/Fund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).Fund(ctx, req.(*FundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FFSAPI_SetTopUpPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTopUpPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FFSAPIServer).SetTopUpPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.FFSAPI/SetTopUpPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FFSAPIServer).SetTopUpPolicy(ctx, req.(*SetTopUpPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _FFSAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpc.FFSAPI",
 	HandlerType: (*FFSAPIServer)(nil),
@@ -2820,6 +5632,26 @@ var _FFSAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WalletAddr",
 			Handler:    _FFSAPI_WalletAddr_Handler,
 		},
+		{
+			MethodName: "Addrs",
+			Handler:    _FFSAPI_Addrs_Handler,
+		},
+		{
+			MethodName: "NewAddr",
+			Handler:    _FFSAPI_NewAddr_Handler,
+		},
+		{
+			MethodName: "SetDefaultAddr",
+			Handler:    _FFSAPI_SetDefaultAddr_Handler,
+		},
+		{
+			MethodName: "SendFil",
+			Handler:    _FFSAPI_SendFil_Handler,
+		},
+		{
+			MethodName: "WaitMessage",
+			Handler:    _FFSAPI_WaitMessage_Handler,
+		},
 		{
 			MethodName: "GetDefaultCidConfig",
 			Handler:    _FFSAPI_GetDefaultCidConfig_Handler,
@@ -2840,14 +5672,42 @@ var _FFSAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _FFSAPI_Info_Handler,
 		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _FFSAPI_CancelJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _FFSAPI_ListJobs_Handler,
+		},
+		{
+			MethodName: "Spend",
+			Handler:    _FFSAPI_Spend_Handler,
+		},
 		{
 			MethodName: "PushConfig",
 			Handler:    _FFSAPI_PushConfig_Handler,
 		},
+		{
+			MethodName: "PlanConfig",
+			Handler:    _FFSAPI_PlanConfig_Handler,
+		},
 		{
 			MethodName: "Close",
 			Handler:    _FFSAPI_Close_Handler,
 		},
+		{
+			MethodName: "SetQuotas",
+			Handler:    _FFSAPI_SetQuotas_Handler,
+		},
+		{
+			MethodName: "Fund",
+			Handler:    _FFSAPI_Fund_Handler,
+		},
+		{
+			MethodName: "SetTopUpPolicy",
+			Handler:    _FFSAPI_SetTopUpPolicy_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2865,6 +5725,11 @@ var _FFSAPI_serviceDesc = grpc.ServiceDesc{
 			Handler:       _FFSAPI_Get_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCold",
+			Handler:       _FFSAPI_GetCold_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AddToHot",
 			Handler:       _FFSAPI_AddToHot_Handler,
@@ -2,9 +2,12 @@ package rpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/ipfs/go-cid"
@@ -17,6 +20,12 @@ import (
 var (
 	// ErrEmptyAuthToken is returned when the provided auth-token is unknown.
 	ErrEmptyAuthToken = errors.New("auth token can't be empty")
+	// ErrAdminDisabled is returned when calling an admin endpoint and the
+	// server doesn't have an admin token configured.
+	ErrAdminDisabled = errors.New("admin endpoints are disabled")
+	// ErrInvalidAdminToken is returned when calling an admin endpoint
+	// without the admin token.
+	ErrInvalidAdminToken = errors.New("invalid admin token")
 
 	log = logger.Logger("ffs-grpc-service")
 )
@@ -25,15 +34,18 @@ var (
 type Service struct {
 	UnimplementedFFSAPIServer
 
-	m   *manager.Manager
-	hot ffs.HotStorage
+	m          *manager.Manager
+	hot        ffs.HotStorage
+	adminToken string
 }
 
-// NewService returns a new Service.
-func NewService(m *manager.Manager, hot ffs.HotStorage) *Service {
+// NewService returns a new Service. Admin endpoints require adminToken,
+// and are disabled if it's empty.
+func NewService(m *manager.Manager, hot ffs.HotStorage, adminToken string) *Service {
 	return &Service{
-		m:   m,
-		hot: hot,
+		m:          m,
+		hot:        hot,
+		adminToken: adminToken,
 	}
 }
 
@@ -70,6 +82,89 @@ func (s *Service) WalletAddr(ctx context.Context, req *WalletAddrRequest) (*Wall
 	return &WalletAddrReply{Addr: addr}, nil
 }
 
+// Addrs returns the wallet addresses of the instance
+func (s *Service) Addrs(ctx context.Context, req *AddrsRequest) (*AddrsReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addrs := i.Addrs()
+	res := make([]*AddrInfo, len(addrs))
+	for j, a := range addrs {
+		res[j] = &AddrInfo{
+			Name: a.Name,
+			Addr: a.Addr,
+			Type: a.Type,
+		}
+	}
+	return &AddrsReply{Addrs: res, DefaultAddr: i.WalletAddr()}, nil
+}
+
+// NewAddr creates a new wallet address of the instance
+func (s *Service) NewAddr(ctx context.Context, req *NewAddrRequest) (*NewAddrReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var opts []api.NewAddressOption
+	if req.AddressType != "" {
+		opts = append(opts, api.WithAddressType(req.AddressType))
+	}
+	if req.MakeDefault {
+		opts = append(opts, api.WithMakeDefault(req.MakeDefault))
+	}
+	addr, err := i.NewAddr(ctx, req.Name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &NewAddrReply{Addr: addr}, nil
+}
+
+// SetDefaultAddr sets the default wallet address of the instance
+func (s *Service) SetDefaultAddr(ctx context.Context, req *SetDefaultAddrRequest) (*SetDefaultAddrReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.SetDefaultAddr(req.Addr); err != nil {
+		return nil, err
+	}
+	return &SetDefaultAddrReply{}, nil
+}
+
+// SendFil sends fil from an address of the instance to another address
+func (s *Service) SendFil(ctx context.Context, req *SendFilRequest) (*SendFilReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("parsing amount %s", req.Amount)
+	}
+	mcid, err := i.SendFil(ctx, req.From, req.To, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &SendFilReply{MessageCid: mcid.String()}, nil
+}
+
+// WaitMessage waits for a message to land on chain
+func (s *Service) WaitMessage(ctx context.Context, req *WaitMessageRequest) (*WaitMessageReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mcid, err := cid.Decode(req.MessageCid)
+	if err != nil {
+		return nil, fmt.Errorf("parsing message cid: %s", err)
+	}
+	if err := i.WaitMessage(ctx, mcid); err != nil {
+		return nil, err
+	}
+	return &WaitMessageReply{}, nil
+}
+
 // GetDefaultCidConfig returns the default cid config prepped for the provided cid
 func (s *Service) GetDefaultCidConfig(ctx context.Context, req *GetDefaultCidConfigRequest) (*GetDefaultCidConfigReply, error) {
 	i, err := s.getInstanceByToken(ctx)
@@ -98,12 +193,23 @@ func (s *Service) GetDefaultCidConfig(ctx context.Context, req *GetDefaultCidCon
 					DealDuration:   int64(config.Cold.Filecoin.DealDuration),
 					ExcludedMiners: config.Cold.Filecoin.ExcludedMiners,
 					CountryCodes:   config.Cold.Filecoin.CountryCodes,
+					TrustedMiners:  config.Cold.Filecoin.TrustedMiners,
+					MaxPrice:       config.Cold.Filecoin.MaxPrice,
+					MinScore:       int64(config.Cold.Filecoin.MinScore),
+					Addr:           config.Cold.Filecoin.Addr,
 					Renew: &FilRenew{
-						Enabled:   config.Cold.Filecoin.Renew.Enabled,
-						Threshold: int64(config.Cold.Filecoin.Renew.Threshold),
+						Enabled:          config.Cold.Filecoin.Renew.Enabled,
+						Threshold:        int64(config.Cold.Filecoin.Renew.Threshold),
+						Strategy:         RenewStrategy(config.Cold.Filecoin.Renew.Strategy),
+						MaxPrice:         config.Cold.Filecoin.Renew.MaxPrice,
+						MaxPriceIncrease: int64(config.Cold.Filecoin.Renew.MaxPriceIncrease),
+					},
+					Repair: &FilRepair{
+						Enabled: config.Cold.Filecoin.Repair.Enabled,
 					},
 				},
 			},
+			Retry: toRPCRetryConfig(config.Retry),
 		},
 	}, nil
 }
@@ -139,12 +245,23 @@ func (s *Service) GetCidConfig(ctx context.Context, req *GetCidConfigRequest) (*
 					DealDuration:   int64(config.Cold.Filecoin.DealDuration),
 					ExcludedMiners: config.Cold.Filecoin.ExcludedMiners,
 					CountryCodes:   config.Cold.Filecoin.CountryCodes,
+					TrustedMiners:  config.Cold.Filecoin.TrustedMiners,
+					MaxPrice:       config.Cold.Filecoin.MaxPrice,
+					MinScore:       int64(config.Cold.Filecoin.MinScore),
+					Addr:           config.Cold.Filecoin.Addr,
 					Renew: &FilRenew{
-						Enabled:   config.Cold.Filecoin.Renew.Enabled,
-						Threshold: int64(config.Cold.Filecoin.Renew.Threshold),
+						Enabled:          config.Cold.Filecoin.Renew.Enabled,
+						Threshold:        int64(config.Cold.Filecoin.Renew.Threshold),
+						Strategy:         RenewStrategy(config.Cold.Filecoin.Renew.Strategy),
+						MaxPrice:         config.Cold.Filecoin.Renew.MaxPrice,
+						MaxPriceIncrease: int64(config.Cold.Filecoin.Renew.MaxPriceIncrease),
+					},
+					Repair: &FilRepair{
+						Enabled: config.Cold.Filecoin.Repair.Enabled,
 					},
 				},
 			},
+			Retry: toRPCRetryConfig(config.Retry),
 		},
 	}, nil
 }
@@ -170,12 +287,23 @@ func (s *Service) SetDefaultCidConfig(ctx context.Context, req *SetDefaultCidCon
 				DealDuration:   req.Config.Cold.Filecoin.DealDuration,
 				ExcludedMiners: req.Config.Cold.Filecoin.ExcludedMiners,
 				CountryCodes:   req.Config.Cold.Filecoin.CountryCodes,
+				TrustedMiners:  req.Config.Cold.Filecoin.TrustedMiners,
+				MaxPrice:       req.Config.Cold.Filecoin.MaxPrice,
+				MinScore:       int(req.Config.Cold.Filecoin.MinScore),
+				Addr:           req.Config.Cold.Filecoin.Addr,
 				Renew: ffs.FilRenew{
-					Enabled:   req.Config.Cold.Filecoin.Renew.Enabled,
-					Threshold: int(req.Config.Cold.Filecoin.Renew.Threshold),
+					Enabled:          req.Config.Cold.Filecoin.Renew.Enabled,
+					Threshold:        int(req.Config.Cold.Filecoin.Renew.Threshold),
+					Strategy:         ffs.RenewStrategy(req.Config.Cold.Filecoin.Renew.Strategy),
+					MaxPrice:         req.Config.Cold.Filecoin.Renew.MaxPrice,
+					MaxPriceIncrease: int(req.Config.Cold.Filecoin.Renew.MaxPriceIncrease),
+				},
+				Repair: ffs.FilRepair{
+					Enabled: req.Config.Cold.Filecoin.GetRepair().GetEnabled(),
 				},
 			},
 		},
+		Retry: fromRPCRetryConfig(req.Config.GetRetry()),
 	}
 	if err := i.SetDefaultCidConfig(defaultConfig); err != nil {
 		return nil, err
@@ -205,8 +333,9 @@ func (s *Service) Show(ctx context.Context, req *ShowRequest) (*ShowReply, error
 			Cid:     info.Cid.String(),
 			Created: info.Created.UnixNano(),
 			Hot: &HotInfo{
-				Enabled: info.Hot.Enabled,
-				Size:    int64(info.Hot.Size),
+				Enabled:   info.Hot.Enabled,
+				Size:      int64(info.Hot.Size),
+				NumBlocks: int64(info.Hot.NumBlocks),
 				Ipfs: &IpfsHotInfo{
 					Created: info.Hot.Ipfs.Created.UnixNano(),
 				},
@@ -214,11 +343,22 @@ func (s *Service) Show(ctx context.Context, req *ShowRequest) (*ShowReply, error
 			Cold: &ColdInfo{
 				Filecoin: &FilInfo{
 					DataCid:   info.Cold.Filecoin.DataCid.String(),
+					Size:      int64(info.Cold.Filecoin.Size),
 					Proposals: make([]*FilStorage, len(info.Cold.Filecoin.Proposals)),
 				},
 			},
+			Refs: &CidRefs{
+				Hot:  make([]string, len(info.Refs.Hot)),
+				Cold: make([]string, len(info.Refs.Cold)),
+			},
 		},
 	}
+	for i, iid := range info.Refs.Hot {
+		reply.CidInfo.Refs.Hot[i] = iid.String()
+	}
+	for i, iid := range info.Refs.Cold {
+		reply.CidInfo.Refs.Cold[i] = iid.String()
+	}
 	for i, p := range info.Cold.Filecoin.Proposals {
 		reply.CidInfo.Cold.Filecoin.Proposals[i] = &FilStorage{
 			ProposalCid:     p.ProposalCid.String(),
@@ -226,6 +366,9 @@ func (s *Service) Show(ctx context.Context, req *ShowRequest) (*ShowReply, error
 			Duration:        p.Duration,
 			ActivationEpoch: p.ActivationEpoch,
 			Miner:           p.Miner,
+			EpochPrice:      p.EpochPrice,
+			PieceSize:       p.PieceSize,
+			TotalCost:       p.TotalCost,
 		}
 	}
 	return reply, nil
@@ -261,18 +404,48 @@ func (s *Service) Info(ctx context.Context, req *InfoRequest) (*InfoReply, error
 						DealDuration:   info.DefaultCidConfig.Cold.Filecoin.DealDuration,
 						ExcludedMiners: info.DefaultCidConfig.Cold.Filecoin.ExcludedMiners,
 						CountryCodes:   info.DefaultCidConfig.Cold.Filecoin.CountryCodes,
+						TrustedMiners:  info.DefaultCidConfig.Cold.Filecoin.TrustedMiners,
+						MaxPrice:       info.DefaultCidConfig.Cold.Filecoin.MaxPrice,
+						MinScore:       int64(info.DefaultCidConfig.Cold.Filecoin.MinScore),
+						Addr:           info.DefaultCidConfig.Cold.Filecoin.Addr,
 						Renew: &FilRenew{
-							Enabled:   info.DefaultCidConfig.Cold.Filecoin.Renew.Enabled,
-							Threshold: int64(info.DefaultCidConfig.Cold.Filecoin.Renew.Threshold),
+							Enabled:          info.DefaultCidConfig.Cold.Filecoin.Renew.Enabled,
+							Threshold:        int64(info.DefaultCidConfig.Cold.Filecoin.Renew.Threshold),
+							Strategy:         RenewStrategy(info.DefaultCidConfig.Cold.Filecoin.Renew.Strategy),
+							MaxPrice:         info.DefaultCidConfig.Cold.Filecoin.Renew.MaxPrice,
+							MaxPriceIncrease: int64(info.DefaultCidConfig.Cold.Filecoin.Renew.MaxPriceIncrease),
+						},
+						Repair: &FilRepair{
+							Enabled: info.DefaultCidConfig.Cold.Filecoin.Repair.Enabled,
 						},
 					},
 				},
+				Retry: toRPCRetryConfig(info.DefaultCidConfig.Retry),
 			},
 			Wallet: &WalletInfo{
 				Address: info.Wallet.Address,
 				Balance: info.Wallet.Balance,
 			},
-			Pins: make([]string, len(info.Pins)),
+			Pins:       make([]string, len(info.Pins)),
+			QueuedJobs: int64(info.QueuedJobs),
+			HotStorage: &HotStorageInfo{
+				Size:      int64(info.HotStorage.Size),
+				NumBlocks: int64(info.HotStorage.NumBlocks),
+			},
+			Quotas: &Quotas{
+				MaxHotBytes:  int64(info.Quotas.MaxHotBytes),
+				MaxColdBytes: int64(info.Quotas.MaxColdBytes),
+				MaxCids:      int64(info.Quotas.MaxCids),
+				MaxSpend:     info.Quotas.MaxSpend,
+				SpendPeriod:  int64(info.Quotas.SpendPeriod / time.Second),
+			},
+			Usage: &QuotasUsage{
+				HotBytes:  int64(info.Usage.HotBytes),
+				ColdBytes: int64(info.Usage.ColdBytes),
+				Cids:      int64(info.Usage.Cids),
+				Spent:     info.Usage.Spent,
+			},
+			TotalSpend: info.TotalSpend,
 		},
 	}
 	for i, p := range info.Pins {
@@ -300,12 +473,7 @@ func (s *Service) WatchJobs(req *WatchJobsRequest, srv FFSAPI_WatchJobsServer) e
 	}()
 	for job := range ch {
 		reply := &WatchJobsReply{
-			Job: &Job{
-				ID:       job.ID.String(),
-				ApiID:    job.APIID.String(),
-				Status:   JobStatus(job.Status),
-				ErrCause: job.ErrCause,
-			},
+			Job: toRPCJob(job),
 		}
 		if err := srv.Send(reply); err != nil {
 			return err
@@ -317,6 +485,115 @@ func (s *Service) WatchJobs(req *WatchJobsRequest, srv FFSAPI_WatchJobsServer) e
 	return nil
 }
 
+// CancelJob calls API.CancelJob
+func (s *Service) CancelJob(ctx context.Context, req *CancelJobRequest) (*CancelJobReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CancelJob(ffs.JobID(req.Jid)); err != nil {
+		return nil, err
+	}
+	return &CancelJobReply{}, nil
+}
+
+// ListJobs calls API.ListJobs
+func (s *Service) ListJobs(ctx context.Context, req *ListJobsRequest) (*ListJobsReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []api.ListJobsOption
+	if req.Cid != "" {
+		c, err := cid.Decode(req.Cid)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, api.WithJobsCid(c))
+	}
+	if len(req.Statuses) > 0 {
+		statuses := make([]ffs.JobStatus, len(req.Statuses))
+		for i, st := range req.Statuses {
+			statuses[i] = ffs.JobStatus(st)
+		}
+		opts = append(opts, api.WithJobsStatus(statuses...))
+	}
+	var from, to time.Time
+	if req.CreatedFrom != 0 {
+		from = time.Unix(0, req.CreatedFrom)
+	}
+	if req.CreatedTo != 0 {
+		to = time.Unix(0, req.CreatedTo)
+	}
+	opts = append(opts, api.WithJobsCreatedBetween(from, to), api.WithJobsPage(int(req.Offset), int(req.Limit)))
+
+	js, err := i.ListJobs(opts...)
+	if err != nil {
+		return nil, err
+	}
+	reply := &ListJobsReply{
+		Jobs: make([]*Job, len(js)),
+	}
+	for i, j := range js {
+		reply.Jobs[i] = toRPCJob(j)
+	}
+	return reply, nil
+}
+
+// Spend returns a report of the attoFIL committed to active deals by an Api.
+func (s *Service) Spend(ctx context.Context, req *SpendRequest) (*SpendReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []api.SpendOption
+	if req.Cid != "" {
+		c, err := cid.Decode(req.Cid)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, api.WithSpendCid(c))
+	}
+	if req.Miner != "" {
+		opts = append(opts, api.WithSpendMiner(req.Miner))
+	}
+	var from, to time.Time
+	if req.From != 0 {
+		from = time.Unix(0, req.From)
+	}
+	if req.To != 0 {
+		to = time.Unix(0, req.To)
+	}
+	opts = append(opts, api.WithSpendBetween(from, to))
+
+	report, err := i.Spend(opts...)
+	if err != nil {
+		return nil, err
+	}
+	reply := &SpendReply{
+		Total:   report.Total,
+		ByCid:   make(map[string]uint64, len(report.ByCid)),
+		ByMiner: report.ByMiner,
+		ByDay:   report.ByDay,
+		Records: make([]*SpendRecord, len(report.Records)),
+	}
+	for c, amount := range report.ByCid {
+		reply.ByCid[c.String()] = amount
+	}
+	for i, r := range report.Records {
+		reply.Records[i] = &SpendRecord{
+			Cid:         r.Cid.String(),
+			ProposalCid: r.ProposalCid.String(),
+			Miner:       r.Miner,
+			Amount:      r.Amount,
+			Time:        r.Time.UnixNano(),
+		}
+	}
+	return reply, nil
+}
+
 // WatchLogs returns a stream of human-readable messages related to executions of a Cid.
 // The listener is automatically unsubscribed when the client closes the stream.
 func (s *Service) WatchLogs(req *WatchLogsRequest, srv FFSAPI_WatchLogsServer) error {
@@ -342,10 +619,11 @@ func (s *Service) WatchLogs(req *WatchLogsRequest, srv FFSAPI_WatchLogsServer) e
 	for l := range ch {
 		reply := &WatchLogsReply{
 			LogEntry: &LogEntry{
-				Cid:  c.String(),
-				Jid:  l.Jid.String(),
-				Time: l.Timestamp.Unix(),
-				Msg:  l.Msg,
+				Cid:   c.String(),
+				Jid:   l.Jid.String(),
+				Time:  l.Timestamp.Unix(),
+				Msg:   l.Msg,
+				Event: string(l.Event),
 			},
 		}
 		if err := srv.Send(reply); err != nil {
@@ -371,41 +649,13 @@ func (s *Service) PushConfig(ctx context.Context, req *PushConfigRequest) (*Push
 		return nil, err
 	}
 
-	options := []api.PushConfigOption{}
-
-	if req.HasConfig {
-		cid, err := cid.Decode(req.Config.Cid)
-		if err != nil {
-			return nil, err
-		}
-		config := ffs.CidConfig{
-			Cid: cid,
-			Hot: ffs.HotConfig{
-				Enabled:       req.Config.Hot.Enabled,
-				AllowUnfreeze: req.Config.Hot.AllowUnfreeze,
-				Ipfs: ffs.IpfsConfig{
-					AddTimeout: int(req.Config.Hot.Ipfs.AddTimeout),
-				},
-			},
-			Cold: ffs.ColdConfig{
-				Enabled: req.Config.Cold.Enabled,
-				Filecoin: ffs.FilConfig{
-					RepFactor:      int(req.Config.Cold.Filecoin.RepFactor),
-					DealDuration:   req.Config.Cold.Filecoin.DealDuration,
-					ExcludedMiners: req.Config.Cold.Filecoin.ExcludedMiners,
-					CountryCodes:   req.Config.Cold.Filecoin.CountryCodes,
-					Renew: ffs.FilRenew{
-						Enabled:   req.Config.Cold.Filecoin.Renew.Enabled,
-						Threshold: int(req.Config.Cold.Filecoin.Renew.Threshold),
-					},
-				},
-			},
-		}
-		options = append(options, api.WithCidConfig(config))
+	options, err := pushConfigOptions(req.Config, req.HasConfig, req.OverrideConfig, req.HasOverrideConfig)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.HasOverrideConfig {
-		options = append(options, api.WithOverride(req.OverrideConfig))
+	if req.Priority != 0 {
+		options = append(options, api.WithPriority(int(req.Priority)))
 	}
 
 	jid, err := i.PushConfig(c, options...)
@@ -418,6 +668,52 @@ func (s *Service) PushConfig(ctx context.Context, req *PushConfigRequest) (*Push
 	}, nil
 }
 
+// PlanConfig returns what pushing a configuration for a Cid would do, without creating a Job.
+func (s *Service) PlanConfig(ctx context.Context, req *PlanConfigRequest) (*PlanConfigReply, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := cid.Decode(req.Cid)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := pushConfigOptions(req.Config, req.HasConfig, req.OverrideConfig, req.HasOverrideConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := i.PlanConfig(ctx, c, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &PlanConfigReply{
+		Plan: &StoragePlan{
+			Cid: plan.Cid.String(),
+			Hot: &HotPlan{
+				Action:      HotAction(plan.Hot.Action),
+				CanUnfreeze: plan.Hot.CanUnfreeze,
+			},
+			Cold: &ColdPlan{
+				NewDeals:      int64(plan.Cold.NewDeals),
+				Miners:        make([]*MinerProposal, len(plan.Cold.Miners)),
+				DealDuration:  plan.Cold.DealDuration,
+				EstimatedCost: plan.Cold.EstimatedCost,
+			},
+		},
+	}
+	for i, m := range plan.Cold.Miners {
+		reply.Plan.Cold.Miners[i] = &MinerProposal{
+			Addr:       m.Addr,
+			EpochPrice: m.EpochPrice,
+		}
+	}
+	return reply, nil
+}
+
 // Get gets the data for a stored Cid.
 func (s *Service) Get(req *GetRequest, srv FFSAPI_GetServer) error {
 	i, err := s.getInstanceByToken(srv.Context())
@@ -448,6 +744,41 @@ func (s *Service) Get(req *GetRequest, srv FFSAPI_GetServer) error {
 	}
 }
 
+// GetCold streams the data of a stored Cid retrieved from the Cold Storage, as a CAR.
+func (s *Service) GetCold(req *GetColdRequest, srv FFSAPI_GetColdServer) error {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+	c, err := cid.Decode(req.GetCid())
+	if err != nil {
+		return err
+	}
+	r, err := i.GetFromCold(srv.Context(), c)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("closing reader on GetCold: %s", err)
+		}
+	}()
+
+	buffer := make([]byte, 1024*32)
+	for {
+		bytesRead, err := r.Read(buffer)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if sendErr := srv.Send(&GetColdReply{Chunk: buffer[:bytesRead]}); sendErr != nil {
+			return sendErr
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
 // Close calls API.Close
 func (s *Service) Close(ctx context.Context, req *CloseRequest) (*CloseReply, error) {
 	i, err := s.getInstanceByToken(ctx)
@@ -463,7 +794,11 @@ func (s *Service) Close(ctx context.Context, req *CloseRequest) (*CloseReply, er
 // AddToHot stores data in the Hot Storage so the resulting cid can be used in PushConfig
 func (s *Service) AddToHot(srv FFSAPI_AddToHotServer) error {
 	// check that an API instance exists so not just anyone can add data to the hot layer
-	if _, err := s.getInstanceByToken(srv.Context()); err != nil {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+	if err := i.CanAddToHot(); err != nil {
 		return err
 	}
 
@@ -484,6 +819,69 @@ func (s *Service) AddToHot(srv FFSAPI_AddToHotServer) error {
 	return srv.SendAndClose(&AddToHotReply{Cid: c.String()})
 }
 
+// SetQuotas sets the quotas of an instance. It's an admin endpoint.
+func (s *Service) SetQuotas(ctx context.Context, req *SetQuotasRequest) (*SetQuotasReply, error) {
+	if err := s.checkAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	q := api.Quotas{
+		MaxHotBytes:  int(req.GetQuotas().GetMaxHotBytes()),
+		MaxColdBytes: int(req.GetQuotas().GetMaxColdBytes()),
+		MaxCids:      int(req.GetQuotas().GetMaxCids()),
+		MaxSpend:     req.GetQuotas().GetMaxSpend(),
+		SpendPeriod:  time.Duration(req.GetQuotas().GetSpendPeriod()) * time.Second,
+	}
+	if err := s.m.SetQuotas(ffs.APIID(req.InstanceID), q); err != nil {
+		return nil, err
+	}
+	return &SetQuotasReply{}, nil
+}
+
+// Fund sends fil from the master address to an address of an instance.
+// It's an admin endpoint.
+func (s *Service) Fund(ctx context.Context, req *FundRequest) (*FundReply, error) {
+	if err := s.checkAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	amount, ok := new(big.Int).SetString(req.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("parsing amount %s", req.Amount)
+	}
+	mcid, err := s.m.Fund(ctx, ffs.APIID(req.InstanceID), req.Addr, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &FundReply{MessageCid: mcid.String()}, nil
+}
+
+// SetTopUpPolicy sets the automatic top-up policy of an instance. It's an
+// admin endpoint.
+func (s *Service) SetTopUpPolicy(ctx context.Context, req *SetTopUpPolicyRequest) (*SetTopUpPolicyReply, error) {
+	if err := s.checkAdminToken(ctx); err != nil {
+		return nil, err
+	}
+	p := manager.TopUpPolicy{
+		Threshold: req.Policy.GetThreshold(),
+		Amount:    req.Policy.GetAmount(),
+		DailyCap:  req.Policy.GetDailyCap(),
+	}
+	if err := s.m.SetTopUpPolicy(ffs.APIID(req.InstanceID), p); err != nil {
+		return nil, err
+	}
+	return &SetTopUpPolicyReply{}, nil
+}
+
+func (s *Service) checkAdminToken(ctx context.Context) error {
+	if s.adminToken == "" {
+		return ErrAdminDisabled
+	}
+	token := metautils.ExtractIncoming(ctx).Get("X-ffs-Admin-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+		return ErrInvalidAdminToken
+	}
+	return nil
+}
+
 func (s *Service) getInstanceByToken(ctx context.Context) (*api.API, error) {
 	token := metautils.ExtractIncoming(ctx).Get("X-ffs-Token")
 	if token == "" {
@@ -496,6 +894,32 @@ func (s *Service) getInstanceByToken(ctx context.Context) (*api.API, error) {
 	return i, nil
 }
 
+func toRPCRetryConfig(rc ffs.RetryConfig) *RetryConfig {
+	res := &RetryConfig{
+		MaxAttempts:    int64(rc.MaxAttempts),
+		Backoff:        int64(rc.Backoff),
+		MaxBackoff:     int64(rc.MaxBackoff),
+		FailureClasses: make([]FailureClass, len(rc.FailureClasses)),
+	}
+	for i, fc := range rc.FailureClasses {
+		res.FailureClasses[i] = FailureClass(fc)
+	}
+	return res
+}
+
+func fromRPCRetryConfig(rc *RetryConfig) ffs.RetryConfig {
+	res := ffs.RetryConfig{
+		MaxAttempts:    int(rc.GetMaxAttempts()),
+		Backoff:        int(rc.GetBackoff()),
+		MaxBackoff:     int(rc.GetMaxBackoff()),
+		FailureClasses: make([]ffs.FailureClass, len(rc.GetFailureClasses())),
+	}
+	for i, fc := range rc.GetFailureClasses() {
+		res.FailureClasses[i] = ffs.FailureClass(fc)
+	}
+	return res
+}
+
 func receiveFile(srv FFSAPI_AddToHotServer, writer *io.PipeWriter) {
 	for {
 		req, err := srv.Recv()
@@ -514,3 +938,81 @@ func receiveFile(srv FFSAPI_AddToHotServer, writer *io.PipeWriter) {
 		}
 	}
 }
+
+func toRPCJob(j ffs.Job) *Job {
+	rj := &Job{
+		ID:       j.ID.String(),
+		ApiID:    j.APIID.String(),
+		Status:   JobStatus(j.Status),
+		ErrCause: j.ErrCause,
+		Attempts: int64(j.Attempts),
+		Priority: int64(j.Priority),
+	}
+	if j.Cid.Defined() {
+		rj.Cid = j.Cid.String()
+	}
+	if !j.NextRetry.IsZero() {
+		rj.NextRetry = j.NextRetry.UnixNano()
+	}
+	if !j.Created.IsZero() {
+		rj.Created = j.Created.UnixNano()
+	}
+	if !j.Started.IsZero() {
+		rj.Started = j.Started.UnixNano()
+	}
+	if !j.Finished.IsZero() {
+		rj.Finished = j.Finished.UnixNano()
+	}
+	return rj
+}
+
+func pushConfigOptions(rc *CidConfig, hasConfig, override, hasOverride bool) ([]api.PushConfigOption, error) {
+	options := []api.PushConfigOption{}
+
+	if hasConfig {
+		cid, err := cid.Decode(rc.Cid)
+		if err != nil {
+			return nil, err
+		}
+		config := ffs.CidConfig{
+			Cid: cid,
+			Hot: ffs.HotConfig{
+				Enabled:       rc.Hot.Enabled,
+				AllowUnfreeze: rc.Hot.AllowUnfreeze,
+				Ipfs: ffs.IpfsConfig{
+					AddTimeout: int(rc.Hot.Ipfs.AddTimeout),
+				},
+			},
+			Cold: ffs.ColdConfig{
+				Enabled: rc.Cold.Enabled,
+				Filecoin: ffs.FilConfig{
+					RepFactor:      int(rc.Cold.Filecoin.RepFactor),
+					DealDuration:   rc.Cold.Filecoin.DealDuration,
+					ExcludedMiners: rc.Cold.Filecoin.ExcludedMiners,
+					CountryCodes:   rc.Cold.Filecoin.CountryCodes,
+					TrustedMiners:  rc.Cold.Filecoin.TrustedMiners,
+					MaxPrice:       rc.Cold.Filecoin.MaxPrice,
+					MinScore:       int(rc.Cold.Filecoin.MinScore),
+					Addr:           rc.Cold.Filecoin.Addr,
+					Renew: ffs.FilRenew{
+						Enabled:          rc.Cold.Filecoin.Renew.Enabled,
+						Threshold:        int(rc.Cold.Filecoin.Renew.Threshold),
+						Strategy:         ffs.RenewStrategy(rc.Cold.Filecoin.Renew.Strategy),
+						MaxPrice:         rc.Cold.Filecoin.Renew.MaxPrice,
+						MaxPriceIncrease: int(rc.Cold.Filecoin.Renew.MaxPriceIncrease),
+					},
+					Repair: ffs.FilRepair{
+						Enabled: rc.Cold.Filecoin.GetRepair().GetEnabled(),
+					},
+				},
+			},
+			Retry: fromRPCRetryConfig(rc.GetRetry()),
+		}
+		options = append(options, api.WithCidConfig(config))
+	}
+
+	if hasOverride {
+		options = append(options, api.WithOverride(override))
+	}
+	return options, nil
+}
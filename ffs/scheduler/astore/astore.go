@@ -48,6 +48,39 @@ func (s *Store) Get(jid ffs.JobID) (scheduler.Action, error) {
 	return a, nil
 }
 
+// GetLatest returns the latest pushed Action of a Cid by an instance.
+func (s *Store) GetLatest(iid ffs.APIID, c cid.Cid) (scheduler.Action, error) {
+	as, err := s.queryLatest(func(a scheduler.Action) bool {
+		return a.APIID == iid && a.Cfg.Cid == c
+	})
+	if err != nil {
+		return scheduler.Action{}, err
+	}
+	if len(as) == 0 {
+		return scheduler.Action{}, scheduler.ErrNotFound
+	}
+	return as[0], nil
+}
+
+// ListLatest returns the latest pushed Action of every Cid by every instance.
+func (s *Store) ListLatest() ([]scheduler.Action, error) {
+	return s.latestMatching(func(scheduler.Action) bool { return true })
+}
+
+// ListUndated returns the Actions saved without a creation time, by the
+// JobID they were saved with.
+func (s *Store) ListUndated() (map[ffs.JobID]scheduler.Action, error) {
+	es, err := s.query(func(a scheduler.Action) bool { return a.Created.IsZero() })
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[ffs.JobID]scheduler.Action, len(es))
+	for _, e := range es {
+		res[e.jid] = e.a
+	}
+	return res, nil
+}
+
 // Put saves a new Action for a Cid.
 func (s *Store) Put(ji ffs.JobID, a scheduler.Action) error {
 	buf, err := json.Marshal(a)
@@ -60,8 +93,8 @@ func (s *Store) Put(ji ffs.JobID, a scheduler.Action) error {
 	return nil
 }
 
-// Remove removes any Action associated with a Cid.
-func (s *Store) Remove(c cid.Cid) error {
+// Remove removes all Actions of a Cid pushed by an instance.
+func (s *Store) Remove(iid ffs.APIID, c cid.Cid) error {
 	// ToDo: if this becomes a bottleneck, consider including
 	// Cid in key or make an index.
 	q := query.Query{Prefix: ""}
@@ -69,31 +102,112 @@ func (s *Store) Remove(c cid.Cid) error {
 	if err != nil {
 		return fmt.Errorf("executing query in datastore: %s", err)
 	}
-	defer func() {
-		if err := res.Close(); err != nil {
-			log.Errorf("closing query result: %s", err)
-		}
-	}()
-
+	var keys []datastore.Key
 	for r := range res.Next() {
 		var a scheduler.Action
 		if err := json.Unmarshal(r.Value, &a); err != nil {
+			_ = res.Close()
 			return fmt.Errorf("unmarshalling push config action in query: %s", err)
 		}
-		if a.Cfg.Cid == c {
-			if err := s.ds.Delete(datastore.NewKey(r.Key)); err != nil {
-				return fmt.Errorf("deleting from datastore: %s", err)
-			}
-			return nil
+		if a.APIID == iid && a.Cfg.Cid == c {
+			keys = append(keys, datastore.NewKey(r.Key))
 		}
 	}
-	return scheduler.ErrNotFound
-
+	if err := res.Close(); err != nil {
+		return fmt.Errorf("closing query result: %s", err)
+	}
+	if len(keys) == 0 {
+		return scheduler.ErrNotFound
+	}
+	for _, k := range keys {
+		if err := s.ds.Delete(k); err != nil {
+			return fmt.Errorf("deleting from datastore: %s", err)
+		}
+	}
+	return nil
 }
 
-// GetRenewable returns all Actions that have CidConfigs that have the Renew flag enabled
-// and should be inspected for Deal renewals.
+// GetRenewable returns the latest Actions of Cids that have CidConfigs that have the Renew
+// flag enabled and should be inspected for Deal renewals.
 func (s *Store) GetRenewable() ([]scheduler.Action, error) {
+	return s.latestMatching(func(a scheduler.Action) bool {
+		return a.Cfg.Cold.Enabled && a.Cfg.Cold.Filecoin.Renew.Enabled
+	})
+}
+
+// GetRepairable returns the latest Actions of Cids that have CidConfigs that have the Repair
+// flag enabled and should be inspected for missing deals.
+func (s *Store) GetRepairable() ([]scheduler.Action, error) {
+	return s.latestMatching(func(a scheduler.Action) bool {
+		return a.Cfg.Cold.Enabled && a.Cfg.Cold.Filecoin.Repair.Enabled
+	})
+}
+
+// queryLatest returns the latest pushed Action of each Cid by each instance,
+// between the Actions selected by the filter. Actions saved without a creation
+// time are older than the rest, and ties are broken by JobID so the result is
+// deterministic.
+func (s *Store) queryLatest(f func(scheduler.Action) bool) ([]scheduler.Action, error) {
+	es, err := s.query(f)
+	if err != nil {
+		return nil, err
+	}
+	type key struct {
+		iid ffs.APIID
+		c   cid.Cid
+	}
+	latest := make(map[key]int, len(es))
+	var les []entry
+	for _, e := range es {
+		k := key{iid: e.a.APIID, c: e.a.Cfg.Cid}
+		i, ok := latest[k]
+		if !ok {
+			latest[k] = len(les)
+			les = append(les, e)
+			continue
+		}
+		if e.newerThan(les[i]) {
+			les[i] = e
+		}
+	}
+	res := make([]scheduler.Action, len(les))
+	for i, e := range les {
+		res[i] = e.a
+	}
+	return res, nil
+}
+
+// latestMatching returns the latest pushed Actions of Cids which are
+// selected by the filter. Older Actions of a Cid aren't considered, even
+// if they're selected by the filter.
+func (s *Store) latestMatching(f func(scheduler.Action) bool) ([]scheduler.Action, error) {
+	as, err := s.queryLatest(func(scheduler.Action) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	var res []scheduler.Action
+	for _, a := range as {
+		if f(a) {
+			res = append(res, a)
+		}
+	}
+	return res, nil
+}
+
+// entry is an Action with the JobID it was saved with.
+type entry struct {
+	jid ffs.JobID
+	a   scheduler.Action
+}
+
+func (e entry) newerThan(o entry) bool {
+	if !e.a.Created.Equal(o.a.Created) {
+		return e.a.Created.After(o.a.Created)
+	}
+	return e.jid.String() > o.jid.String()
+}
+
+func (s *Store) query(filter func(scheduler.Action) bool) ([]entry, error) {
 	q := query.Query{Prefix: ""}
 	res, err := s.ds.Query(q)
 	if err != nil {
@@ -105,17 +219,18 @@ func (s *Store) GetRenewable() ([]scheduler.Action, error) {
 		}
 	}()
 
-	var as []scheduler.Action
+	var es []entry
 	for r := range res.Next() {
 		var a scheduler.Action
 		if err := json.Unmarshal(r.Value, &a); err != nil {
 			return nil, fmt.Errorf("unmarshalling push config action in query: %s", err)
 		}
-		if a.Cfg.Cold.Enabled && a.Cfg.Cold.Filecoin.Renew.Enabled {
-			as = append(as, a)
+		if filter(a) {
+			jid := ffs.JobID(datastore.RawKey(r.Key).BaseNamespace())
+			es = append(es, entry{jid: jid, a: a})
 		}
 	}
-	return as, nil
+	return es, nil
 }
 
 func makeKey(jid ffs.JobID) datastore.Key {
@@ -11,7 +11,8 @@ import (
 )
 
 var (
-	dsBase = datastore.NewKey("cistore")
+	dsBase         = datastore.NewKey("cistore")
+	dsFailedMiners = datastore.NewKey("failedminers")
 )
 
 // Store is an Datastore implementation of CidInfoStore
@@ -59,6 +60,63 @@ func (s *Store) Put(ci ffs.CidInfo) error {
 	return nil
 }
 
+// AddFailedMiners records miners that had deals of a Cid which aren't
+// active anymore. Miners that were already recorded are ignored.
+func (s *Store) AddFailedMiners(c cid.Cid, miners []string) error {
+	if len(miners) == 0 {
+		return nil
+	}
+	curr, err := s.GetFailedMiners(c)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]struct{}, len(curr))
+	for _, m := range curr {
+		known[m] = struct{}{}
+	}
+	added := false
+	for _, m := range miners {
+		if _, ok := known[m]; ok {
+			continue
+		}
+		known[m] = struct{}{}
+		curr = append(curr, m)
+		added = true
+	}
+	if !added {
+		return nil
+	}
+	buf, err := json.Marshal(curr)
+	if err != nil {
+		return fmt.Errorf("marshaling failed miners for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeFailedMinersKey(c), buf); err != nil {
+		return fmt.Errorf("put failed miners in datastore: %s", err)
+	}
+	return nil
+}
+
+// GetFailedMiners returns the miners that had deals of a Cid which aren't
+// active anymore.
+func (s *Store) GetFailedMiners(c cid.Cid) ([]string, error) {
+	buf, err := s.ds.Get(makeFailedMinersKey(c))
+	if err == datastore.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting failed miners from datastore: %s", err)
+	}
+	var miners []string
+	if err := json.Unmarshal(buf, &miners); err != nil {
+		return nil, fmt.Errorf("unmarshaling failed miners from datastore: %s", err)
+	}
+	return miners, nil
+}
+
+func makeFailedMinersKey(c cid.Cid) datastore.Key {
+	return dsFailedMiners.ChildString(c.String())
+}
+
 func makeKey(c cid.Cid) datastore.Key {
 	return dsBase.ChildString(c.String())
 }
@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
@@ -15,6 +18,8 @@ import (
 
 var (
 	log = logging.Logger("ffs-sched-jstore")
+
+	startedDealsPrefix = datastore.NewKey("started-deals")
 )
 
 // Store is a Datastore implementation of JobStore, which saves
@@ -41,6 +46,12 @@ func New(ds datastore.Datastore) *Store {
 
 // GetByStatus returns all Jobs with the specified JobStatus.
 func (s *Store) GetByStatus(status ffs.JobStatus) ([]ffs.Job, error) {
+	return s.query(func(j ffs.Job) bool {
+		return j.Status == status
+	})
+}
+
+func (s *Store) query(filter func(ffs.Job) bool) ([]ffs.Job, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -51,23 +62,67 @@ func (s *Store) GetByStatus(status ffs.JobStatus) ([]ffs.Job, error) {
 	}
 	defer func() {
 		if err := res.Close(); err != nil {
-			log.Errorf("closing getbystatus query result: %s", err)
+			log.Errorf("closing query result: %s", err)
 		}
 	}()
 
 	var ret []ffs.Job
 	for r := range res.Next() {
+		if strings.HasPrefix(r.Key, startedDealsPrefix.String()) {
+			continue
+		}
 		var job ffs.Job
 		if err := json.Unmarshal(r.Value, &job); err != nil {
 			return nil, fmt.Errorf("unmarshalling job in query: %s", err)
 		}
-		if job.Status == status {
+		if filter(job) {
 			ret = append(ret, job)
 		}
 	}
 	return ret, nil
 }
 
+// List returns Jobs selected by the filter, ordered by creation time.
+func (s *Store) List(f ffs.JobFilter) ([]ffs.Job, error) {
+	if f.Offset < 0 || f.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit can't be negative")
+	}
+	js, err := s.query(f.Match)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(js, func(i, j int) bool {
+		return js[i].Created.Before(js[j].Created)
+	})
+	if f.Offset >= len(js) {
+		return nil, nil
+	}
+	js = js[f.Offset:]
+	if f.Limit > 0 && f.Limit < len(js) {
+		js = js[:f.Limit]
+	}
+	return js, nil
+}
+
+// RemoveFinished removes Jobs that reached a final status before
+// a specified time. It returns the number of removed Jobs.
+func (s *Store) RemoveFinished(before time.Time) (int, error) {
+	js, err := s.query(func(j ffs.Job) bool {
+		return j.Status.IsFinal() && !j.Finished.IsZero() && j.Finished.Before(before)
+	})
+	if err != nil {
+		return 0, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, j := range js {
+		if err := s.ds.Delete(makeKey(j.ID)); err != nil {
+			return 0, fmt.Errorf("deleting job from datastore: %s", err)
+		}
+	}
+	return len(js), nil
+}
+
 // Put saves Job's data in the Datastore.
 func (s *Store) Put(j ffs.Job) error {
 	s.lock.Lock()
@@ -103,6 +158,50 @@ func (s *Store) Get(jid ffs.JobID) (ffs.Job, error) {
 	return job, nil
 }
 
+// PutStartedDeals saves in-flight deal proposals made by a Job, so they can be
+// watched again if the Job execution gets interrupted.
+func (s *Store) PutStartedDeals(jid ffs.JobID, props []ffs.FilProposal) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	buf, err := json.Marshal(props)
+	if err != nil {
+		return fmt.Errorf("marshaling for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeStartedDealsKey(jid), buf); err != nil {
+		return fmt.Errorf("saving to datastore: %s", err)
+	}
+	return nil
+}
+
+// GetStartedDeals returns in-flight deal proposals made by a Job. If there're
+// none, it returns an empty result.
+func (s *Store) GetStartedDeals(jid ffs.JobID) ([]ffs.FilProposal, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	buf, err := s.ds.Get(makeStartedDealsKey(jid))
+	if err == datastore.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting started deals from datastore: %s", err)
+	}
+	var props []ffs.FilProposal
+	if err := json.Unmarshal(buf, &props); err != nil {
+		return nil, fmt.Errorf("unmarshaling started deals from datastore: %s", err)
+	}
+	return props, nil
+}
+
+// RemoveStartedDeals removes in-flight deal proposals made by a Job.
+func (s *Store) RemoveStartedDeals(jid ffs.JobID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.ds.Delete(makeStartedDealsKey(jid)); err != nil && err != datastore.ErrNotFound {
+		return fmt.Errorf("deleting started deals from datastore: %s", err)
+	}
+	return nil
+}
+
 // Watch subscribes to Job changes from a specified Api instance.
 func (s *Store) Watch(ctx context.Context, c chan<- ffs.Job, iid ffs.APIID) error {
 	s.lock.Lock()
@@ -161,3 +260,7 @@ func (s *Store) notifyWatchers(j ffs.Job) {
 func makeKey(jid ffs.JobID) datastore.Key {
 	return datastore.NewKey(jid.String())
 }
+
+func makeStartedDealsKey(jid ffs.JobID) datastore.Key {
+	return startedDealsPrefix.ChildString(jid.String())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
+	"sync"
 	"time"
 
 	blocks "github.com/ipfs/go-block-format"
@@ -13,6 +15,14 @@ import (
 	"github.com/textileio/powergate/util"
 )
 
+const (
+	defaultMaxParallel = 10
+)
+
+var (
+	jobsPruningInterval = time.Minute * 10
+)
+
 var (
 	log = logging.Logger("ffs-scheduler")
 )
@@ -27,10 +37,24 @@ type Scheduler struct {
 	js  JobStore
 	as  ActionStore
 	cis CidInfoStore
+	ss  SpendStore
 	l   ffs.CidLogger
 
 	queuedWork chan struct{}
+	retryTimer *time.Timer
+	rateLim    chan struct{}
+
+	jobRetention time.Duration
 
+	lock          sync.Mutex
+	fk            ffs.FundsKeeper
+	executingCids map[cid.Cid]struct{}
+	cancelJobs    map[ffs.JobID]context.CancelFunc
+	runningJobs   map[ffs.APIID]int
+	dispatchSeq   uint64
+	lastDispatch  map[ffs.APIID]uint64
+
+	wg       sync.WaitGroup
 	ctx      context.Context
 	cancel   context.CancelFunc
 	finished chan struct{}
@@ -40,7 +64,13 @@ var _ ffs.Scheduler = (*Scheduler)(nil)
 
 // New returns a new instance of Scheduler which uses JobStore as its backing repository for state,
 // HotStorage for the hot layer, and ColdStorage for the cold layer.
-func New(js JobStore, as ActionStore, cis CidInfoStore, l ffs.CidLogger, hs ffs.HotStorage, cs ffs.ColdStorage) *Scheduler {
+func New(js JobStore, as ActionStore, cis CidInfoStore, ss SpendStore, l ffs.CidLogger, hs ffs.HotStorage, cs ffs.ColdStorage, opts ...Option) (*Scheduler, error) {
+	cfg := Config{MaxParallel: defaultMaxParallel}
+	for _, o := range opts {
+		if err := o(&cfg); err != nil {
+			return nil, err
+		}
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := &Scheduler{
 		cs:  cs,
@@ -48,22 +78,98 @@ func New(js JobStore, as ActionStore, cis CidInfoStore, l ffs.CidLogger, hs ffs.
 		js:  js,
 		as:  as,
 		cis: cis,
+		ss:  ss,
 		l:   l,
 
 		queuedWork: make(chan struct{}, 1),
+		rateLim:    make(chan struct{}, cfg.MaxParallel),
+
+		jobRetention: cfg.JobRetention,
+
+		executingCids: make(map[cid.Cid]struct{}),
+		cancelJobs:    make(map[ffs.JobID]context.CancelFunc),
+		runningJobs:   make(map[ffs.APIID]int),
+		lastDispatch:  make(map[ffs.APIID]uint64),
 
 		ctx:      ctx,
 		cancel:   cancel,
 		finished: make(chan struct{}),
 	}
+	// The retry timer is armed when a queued Job has a pending retry.
+	sch.retryTimer = time.AfterFunc(time.Hour, sch.signalQueuedWork)
+	sch.retryTimer.Stop()
 	go sch.run()
-	return sch
+	return sch, nil
+}
+
+// PushConfig queues the specified CidConfig to be executed as a new Job. Queued Jobs
+// of the same instance with higher priority are executed first. It returns the created
+// JobID for further tracking of its state.
+func (s *Scheduler) PushConfig(iid ffs.APIID, waddr string, cfg ffs.CidConfig, priority int) (ffs.JobID, error) {
+	return s.push(iid, waddr, cfg, cid.Undef, priority)
+}
+
+// PlanConfig returns what executing the specified CidConfig pushed by an instance would
+// do in the Hot and Cold Storages considering the current Cid storage state, without
+// creating a Job.
+func (s *Scheduler) PlanConfig(ctx context.Context, iid ffs.APIID, cfg ffs.CidConfig) (ffs.StoragePlan, error) {
+	if !cfg.Cid.Defined() {
+		return ffs.StoragePlan{}, fmt.Errorf("cid can't be undefined")
+	}
+	if err := cfg.Validate(); err != nil {
+		return ffs.StoragePlan{}, fmt.Errorf("validating cid config: %s", err)
+	}
+	ci, err := s.getRefreshedInfo(ctx, cfg.Cid)
+	if err != nil {
+		return ffs.StoragePlan{}, fmt.Errorf("getting current cid info: %s", err)
+	}
+
+	// References are updated as executing the config would do, so the Cid
+	// is kept in the Hot Storage if other instances still need it.
+	ci.Refs = ci.Refs.Update(iid, cfg.Hot.Enabled, cfg.Cold.Enabled)
+	plan := ffs.StoragePlan{Cid: cfg.Cid}
+	if cfg.Hot.Enabled != ci.Hot.Enabled {
+		switch {
+		case cfg.Hot.Enabled:
+			plan.Hot.Action = ffs.HotActionPin
+			plan.Hot.CanUnfreeze = cfg.Hot.AllowUnfreeze && len(ci.Cold.Filecoin.Proposals) > 0
+		case len(ci.Refs.Hot) > 0:
+			plan.Hot.Action = ffs.HotActionUnref
+		default:
+			plan.Hot.Action = ffs.HotActionRemove
+		}
+	}
+
+	if !cfg.Cold.Enabled || isCurrentRepFactorEnough(cfg.Cold.Filecoin.RepFactor, ci) {
+		return plan, nil
+	}
+	deltaFilConfig := createDeltaFilConfig(cfg.Cold, ci.Cold.Filecoin)
+	miners, err := s.cs.PlanDeals(ctx, knownDagSize(ci), deltaFilConfig)
+	if err != nil {
+		return ffs.StoragePlan{}, fmt.Errorf("planning cold storage deals: %s", err)
+	}
+	plan.Cold.NewDeals = deltaFilConfig.RepFactor
+	plan.Cold.Miners = miners
+	plan.Cold.DealDuration = deltaFilConfig.DealDuration
+	for _, m := range miners {
+		plan.Cold.EstimatedCost += m.EpochPrice * uint64(deltaFilConfig.DealDuration)
+	}
+	return plan, nil
+}
+
+// SetFundsKeeper sets the FundsKeeper that ensures wallet addresses have
+// enough funds before making new deals. If it isn't set, funds aren't
+// checked before making deals.
+func (s *Scheduler) SetFundsKeeper(fk ffs.FundsKeeper) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.fk = fk
 }
 
-// PushConfig queues the specified CidConfig to be executed as a new Job. It returns
-// the created JobID for further tracking of its state.
-func (s *Scheduler) PushConfig(iid ffs.APIID, waddr string, cfg ffs.CidConfig) (ffs.JobID, error) {
-	return s.push(iid, waddr, cfg, cid.Undef)
+func (s *Scheduler) fundsKeeper() ffs.FundsKeeper {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.fk
 }
 
 // PushReplace queues a new CidConfig to be executed as a new Job, replacing an oldCid that will be
@@ -72,10 +178,10 @@ func (s *Scheduler) PushReplace(iid ffs.APIID, waddr string, cfg ffs.CidConfig,
 	if !oldCid.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
-	return s.push(iid, waddr, cfg, oldCid)
+	return s.push(iid, waddr, cfg, oldCid, 0)
 }
 
-func (s *Scheduler) push(iid ffs.APIID, waddr string, cfg ffs.CidConfig, oldCid cid.Cid) (ffs.JobID, error) {
+func (s *Scheduler) push(iid ffs.APIID, waddr string, cfg ffs.CidConfig, oldCid cid.Cid, priority int) (ffs.JobID, error) {
 	if !cfg.Cid.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
@@ -90,9 +196,12 @@ func (s *Scheduler) push(iid ffs.APIID, waddr string, cfg ffs.CidConfig, oldCid
 	}
 	jid := ffs.NewJobID()
 	j := ffs.Job{
-		ID:     jid,
-		APIID:  iid,
-		Status: ffs.Queued,
+		ID:       jid,
+		APIID:    iid,
+		Cid:      cfg.Cid,
+		Status:   ffs.Queued,
+		Created:  time.Now(),
+		Priority: priority,
 	}
 	if err := s.js.Put(j); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("saving replace action in store: %s", err)
@@ -105,29 +214,27 @@ func (s *Scheduler) push(iid ffs.APIID, waddr string, cfg ffs.CidConfig, oldCid
 		Waddr:       waddr,
 		Cfg:         cfg,
 		ReplacedCid: oldCid,
+		Created:     j.Created,
 	}
 	if err := s.as.Put(j.ID, aa); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("saving new config in store: %s", err)
 	}
 
 	if oldCid.Defined() {
-		if err := s.Untrack(oldCid); err != nil {
+		if err := s.Untrack(iid, oldCid); err != nil {
 			return ffs.EmptyJobID, fmt.Errorf("untracking replaced cid: %s", err)
 		}
 	}
-	select {
-	case s.queuedWork <- struct{}{}:
-	default:
-	}
+	s.signalQueuedWork()
 
 	s.l.Log(ctx, cfg.Cid, "Configuration saved successfully")
 	return jid, nil
 
 }
 
-// Untrack untracks a Cid for renewal and repair background crons.
-func (s *Scheduler) Untrack(c cid.Cid) error {
-	if err := s.as.Remove(c); err != nil {
+// Untrack untracks a Cid of an instance for renewal and repair background crons.
+func (s *Scheduler) Untrack(iid ffs.APIID, c cid.Cid) error {
+	if err := s.as.Remove(iid, c); err != nil {
 		return fmt.Errorf("removing cid from action store: %s", err)
 	}
 	return nil
@@ -155,6 +262,26 @@ func (s *Scheduler) GetCidFromHot(ctx context.Context, c cid.Cid) (io.Reader, er
 	return r, nil
 }
 
+// GetCidFromCold returns a CAR stream of the Cid data retrieved from the Cold Storage,
+// paying with the provided wallet address. The Cid storage state isn't changed.
+func (s *Scheduler) GetCidFromCold(ctx context.Context, c cid.Cid, waddr string) (io.ReadCloser, error) {
+	ci, err := s.cis.Get(c)
+	if err == ErrNotFound {
+		return nil, ffs.ErrColdStorageEmpty
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting cid info from store: %s", err)
+	}
+	if len(ci.Cold.Filecoin.Proposals) == 0 {
+		return nil, ffs.ErrColdStorageEmpty
+	}
+	r, err := s.cs.RetrieveCAR(ctx, c, ci.Cold.Filecoin.DataCid, waddr)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s from cold layer: %s", c, err)
+	}
+	return r, nil
+}
+
 // GetJob the current state of a Job.
 func (s *Scheduler) GetJob(jid ffs.JobID) (ffs.Job, error) {
 	j, err := s.js.Get(jid)
@@ -167,6 +294,63 @@ func (s *Scheduler) GetJob(jid ffs.JobID) (ffs.Job, error) {
 	return j, nil
 }
 
+// CancelJob cancels a Job. If the Job is queued, it won't be executed. If the
+// Job is executing, its execution is interrupted and the storage state reached
+// until that moment is saved. Canceling a finished Job is a no-op.
+func (s *Scheduler) CancelJob(jid ffs.JobID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	j, err := s.js.Get(jid)
+	if err != nil {
+		if err == ErrNotFound {
+			return err
+		}
+		return fmt.Errorf("get Job from store: %s", err)
+	}
+	switch j.Status {
+	case ffs.Queued:
+		if err := s.mutateJobStatus(j, ffs.Canceled); err != nil {
+			return fmt.Errorf("changing job to canceled: %s", err)
+		}
+		ctx := context.WithValue(context.Background(), ffs.CtxKeyJid, jid)
+		s.l.Log(ctx, j.Cid, "Job %s was canceled before execution.", jid)
+		// Queued Jobs of the same Cid might be waiting for this one.
+		s.signalQueuedWork()
+	case ffs.InProgress:
+		if cancel, ok := s.cancelJobs[jid]; ok {
+			cancel()
+		}
+	}
+	return nil
+}
+
+// ListJobs returns Jobs selected by a filter, ordered by creation time.
+func (s *Scheduler) ListJobs(f ffs.JobFilter) ([]ffs.Job, error) {
+	js, err := s.js.List(f)
+	if err != nil {
+		return nil, fmt.Errorf("listing jobs from store: %s", err)
+	}
+	return js, nil
+}
+
+// ListSpends returns the costs of active deals selected by a filter, ordered by time.
+func (s *Scheduler) ListSpends(f ffs.SpendFilter) ([]ffs.SpendRecord, error) {
+	rs, err := s.ss.List(f)
+	if err != nil {
+		return nil, fmt.Errorf("listing spends from store: %s", err)
+	}
+	return rs, nil
+}
+
+// GetSpendTotals returns the running spend totals of an instance.
+func (s *Scheduler) GetSpendTotals(iid ffs.APIID) (ffs.SpendTotals, error) {
+	t, err := s.ss.Totals(iid)
+	if err != nil {
+		return ffs.SpendTotals{}, fmt.Errorf("getting spend totals from store: %s", err)
+	}
+	return t, nil
+}
+
 // WatchJobs returns a channel to listen to Job status changes from a specified
 // API instance. It immediately pushes the current Job state to the channel.
 func (s *Scheduler) WatchJobs(ctx context.Context, c chan<- ffs.Job, iid ffs.APIID) error {
@@ -188,19 +372,157 @@ func (s *Scheduler) Close() error {
 
 func (s *Scheduler) run() {
 	defer close(s.finished)
+	s.backfillActionsCreated()
+	s.backfillCidRefs()
+	s.requeueInterruptedJobs()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.runRenewalChecks()
+	}()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.runRepairChecks()
+	}()
+	if s.jobRetention > 0 {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.runJobsPruning()
+		}()
+	}
 	for {
 		select {
 		case <-s.ctx.Done():
 			log.Infof("terminating scheduler daemon")
+			s.retryTimer.Stop()
+			// Wait for background checks and in-progress Jobs to finish.
+			s.wg.Wait()
+			return
+		case <-s.queuedWork:
+			log.Debug("dispatching queued jobs...")
+			s.execQueuedJobs()
+			log.Debug("dispatching queued jobs done")
+		}
+	}
+}
+
+// backfillActionsCreated sets the creation time of Actions saved before it
+// was tracked, using the creation time of their Jobs. Without it, the latest
+// Action of a Cid can't be known. Actions of pruned Jobs are left as they are.
+func (s *Scheduler) backfillActionsCreated() {
+	as, err := s.as.ListUndated()
+	if err != nil {
+		log.Errorf("getting undated actions: %s", err)
+		return
+	}
+	for jid, a := range as {
+		j, err := s.js.Get(jid)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			log.Errorf("getting job %s from store: %s", jid, err)
+			continue
+		}
+		a.Created = j.Created
+		if err := s.as.Put(jid, a); err != nil {
+			log.Errorf("saving backfilled creation time of action %s: %s", jid, err)
+			continue
+		}
+		log.Infof("backfilled creation time of action %s", jid)
+	}
+}
+
+// backfillCidRefs sets the instance references of Cids stored before they
+// were tracked, using the latest pushed Actions of each instance. Without
+// them, renewals and repairs of these Cids would be skipped.
+func (s *Scheduler) backfillCidRefs() {
+	as, err := s.as.ListLatest()
+	if err != nil {
+		log.Errorf("getting latest actions: %s", err)
+		return
+	}
+	refs := make(map[cid.Cid]ffs.CidRefs)
+	for _, a := range as {
+		refs[a.Cfg.Cid] = refs[a.Cfg.Cid].Update(a.APIID, a.Cfg.Hot.Enabled, a.Cfg.Cold.Enabled)
+	}
+	for c, r := range refs {
+		if len(r.Hot) == 0 && len(r.Cold) == 0 {
+			continue
+		}
+		ci, err := s.cis.Get(c)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			log.Errorf("getting cid info of %s from store: %s", c, err)
+			continue
+		}
+		if len(ci.Refs.Hot) > 0 || len(ci.Refs.Cold) > 0 {
+			continue
+		}
+		ci.Refs = r
+		if err := s.cis.Put(ci); err != nil {
+			log.Errorf("saving backfilled references of %s: %s", c, err)
+			continue
+		}
+		log.Infof("backfilled instance references of %s", c)
+	}
+}
+
+// requeueInterruptedJobs queues again Jobs that were left in-progress by a
+// previous run of the Scheduler. When executed, deals already started by these
+// Jobs are watched again instead of making new ones.
+func (s *Scheduler) requeueInterruptedJobs() {
+	js, err := s.js.GetByStatus(ffs.InProgress)
+	if err != nil {
+		log.Errorf("getting interrupted jobs: %s", err)
+		return
+	}
+	for _, j := range js {
+		ctx := context.WithValue(s.ctx, ffs.CtxKeyJid, j.ID)
+		s.l.Log(ctx, j.Cid, "Job %s was interrupted, queuing it again...", j.ID)
+		// An interrupted execution isn't considered an attempt.
+		if j.Attempts > 0 {
+			j.Attempts--
+		}
+		if err := s.mutateJobStatus(j, ffs.Queued); err != nil {
+			log.Errorf("changing interrupted job to queued: %s", err)
+		}
+	}
+	// Dispatch queued Jobs left by a previous run.
+	s.signalQueuedWork()
+}
+
+// runJobsPruning periodically removes finished Jobs older than
+// the configured retention.
+func (s *Scheduler) runJobsPruning() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(jobsPruningInterval):
+			n, err := s.js.RemoveFinished(time.Now().Add(-s.jobRetention))
+			if err != nil {
+				log.Errorf("removing finished jobs: %s", err)
+				continue
+			}
+			log.Debugf("removed %d finished jobs", n)
+		}
+	}
+}
+
+func (s *Scheduler) runRenewalChecks() {
+	for {
+		select {
+		case <-s.ctx.Done():
 			return
 		case <-time.After(util.AvgBlockTime):
 			log.Debug("running renewal checks...")
 			s.scanRenewable(s.ctx)
 			log.Debug("renewal checks done")
-		case <-s.queuedWork:
-			log.Debug("running queued Job...")
-			s.executeQueuedJobs(s.ctx)
-			log.Debug("running queued job done")
 		}
 	}
 }
@@ -211,10 +533,20 @@ func (s *Scheduler) scanRenewable(ctx context.Context) {
 		log.Errorf("getting renweable cid configs from store: %s", err)
 	}
 	for _, a := range as {
+		if ctx.Err() != nil {
+			return
+		}
+		if !s.tryLockCid(a.Cfg.Cid) {
+			log.Debugf("skipping renewal of %s since it has an executing job", a.Cfg.Cid)
+			continue
+		}
 		log.Debugf("evaluating deal renewal for Cid %s", a.Cfg.Cid)
 		if err := s.evaluateRenewal(ctx, a); err != nil {
 			log.Errorf("renweal of %s: %s", a.Cfg.Cid, err)
 		}
+		s.unlockCid(a.Cfg.Cid)
+		// Queued Jobs for the Cid could have been waiting for the renewal.
+		s.signalQueuedWork()
 		log.Debugf("deal renewal done")
 	}
 }
@@ -228,12 +560,26 @@ func (s *Scheduler) evaluateRenewal(ctx context.Context, a Action) error {
 	if err != nil {
 		return fmt.Errorf("getting cid info from store: %s", err)
 	}
+	if !inf.Refs.HasCold(a.APIID) {
+		log.Infof("skip renewal evaluation for %s since instance %s doesn't need it in cold storage", a.Cfg.Cid, a.APIID)
+		return nil
+	}
 	s.l.Log(ctx, a.Cfg.Cid, "Evaluating deal renweal...")
 
+	release, err := s.ensureRenewalFunds(ctx, a, inf.Cold.Filecoin)
+	if err != nil {
+		return err
+	}
+	// Renewals are made and waited one by one inside EnsureRenewals, so
+	// the funds stay reserved until all of them are done.
+	defer release()
+
+	prev := inf.Cold.Filecoin.Proposals
 	inf.Cold.Filecoin, err = s.cs.EnsureRenewals(ctx, a.Cfg.Cid, inf.Cold.Filecoin, a.Waddr, a.Cfg.Cold.Filecoin)
 	if err != nil {
 		return fmt.Errorf("evaluating renewal in cold-storage: %s", err)
 	}
+	s.recordSpends(a.APIID, a.Cfg.Cid, prev, inf.Cold.Filecoin.Proposals)
 
 	if err := s.cis.Put(inf); err != nil {
 		return fmt.Errorf("saving new cid info in store: %s", err)
@@ -243,85 +589,474 @@ func (s *Scheduler) evaluateRenewal(ctx context.Context, a Action) error {
 	return nil
 }
 
-func (s *Scheduler) executeQueuedJobs(ctx context.Context) {
+func (s *Scheduler) runRepairChecks() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(util.AvgBlockTime):
+			log.Debug("running repair checks...")
+			s.scanRepairable(s.ctx)
+			log.Debug("repair checks done")
+		}
+	}
+}
+
+func (s *Scheduler) scanRepairable(ctx context.Context) {
+	as, err := s.as.GetRepairable()
+	if err != nil {
+		log.Errorf("getting repairable cid configs from store: %s", err)
+		return
+	}
+	queued, err := s.js.GetByStatus(ffs.Queued)
+	if err != nil {
+		log.Errorf("getting queued jobs from store: %s", err)
+		return
+	}
+	queuedCids := make(map[cid.Cid]struct{}, len(queued))
+	for _, j := range queued {
+		queuedCids[j.Cid] = struct{}{}
+	}
+	for _, a := range as {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, ok := queuedCids[a.Cfg.Cid]; ok {
+			log.Debugf("skipping repair of %s since it has a queued job", a.Cfg.Cid)
+			continue
+		}
+		if !s.tryLockCid(a.Cfg.Cid) {
+			log.Debugf("skipping repair of %s since it has an executing job", a.Cfg.Cid)
+			continue
+		}
+		log.Debugf("evaluating repair for Cid %s", a.Cfg.Cid)
+		if err := s.evaluateRepair(ctx, a); err != nil {
+			log.Errorf("repair of %s: %s", a.Cfg.Cid, err)
+		}
+		s.unlockCid(a.Cfg.Cid)
+		log.Debugf("repair evaluation done")
+	}
+}
+
+// evaluateRepair pushes a Job to make new deals for a Cid if some of its
+// stored deals aren't active anymore and the replication factor is lower
+// than desired. The Job is executed as any other pushed configuration, so
+// miners of the inactive deals are excluded from the selection of the new
+// ones. The latest pushed configuration of the Cid is used, since it could
+// have changed after a was listed.
+func (s *Scheduler) evaluateRepair(ctx context.Context, a Action) error {
+	latest, err := s.as.GetLatest(a.APIID, a.Cfg.Cid)
+	if err == ErrNotFound {
+		log.Infof("skip repair evaluation for %s since it was untracked", a.Cfg.Cid)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting latest action from store: %s", err)
+	}
+	a = latest
+	if !a.Cfg.Cold.Enabled || !a.Cfg.Cold.Filecoin.Repair.Enabled {
+		log.Infof("skip repair evaluation for %s since repair is disabled in the latest config", a.Cfg.Cid)
+		return nil
+	}
+	ci, err := s.cis.Get(a.Cfg.Cid)
+	if err == ErrNotFound {
+		log.Infof("skip repair evaluation for %s since Cid isn't stored yet", a.Cfg.Cid)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting cid info from store: %s", err)
+	}
+	if !ci.Refs.HasCold(a.APIID) {
+		log.Infof("skip repair evaluation for %s since instance %s doesn't need it in cold storage", a.Cfg.Cid, a.APIID)
+		return nil
+	}
+	ci.Cold, err = s.getRefreshedColdInfo(ctx, a.Cfg.Cid, ci.Cold)
+	if err != nil {
+		return fmt.Errorf("getting refreshed cold info: %s", err)
+	}
+	if isCurrentRepFactorEnough(a.Cfg.Cold.Filecoin.RepFactor, ci) {
+		return nil
+	}
+
+	s.l.Log(ctx, a.Cfg.Cid, "Detected %d active deals of %d desired, pushing repair job...", len(ci.Cold.Filecoin.Proposals), a.Cfg.Cold.Filecoin.RepFactor)
+	if _, err := s.push(a.APIID, a.Waddr, a.Cfg, cid.Undef, 0); err != nil {
+		return fmt.Errorf("pushing repair job: %s", err)
+	}
+	return nil
+}
+
+// inactiveMiners returns the miners of deals in prev which don't have
+// an active deal in curr.
+func inactiveMiners(prev, curr ffs.FilInfo) []string {
+	active := make(map[string]struct{}, len(curr.Proposals))
+	for _, p := range curr.Proposals {
+		active[p.Miner] = struct{}{}
+	}
+	var res []string
+	for _, p := range prev.Proposals {
+		if _, ok := active[p.Miner]; ok {
+			continue
+		}
+		active[p.Miner] = struct{}{}
+		res = append(res, p.Miner)
+	}
+	return res
+}
+
+// execQueuedJobs dispatches queued Jobs to be executed concurrently, up to the
+// configured maximum parallelism. A Job is only considered if no other Job for
+// the same Cid is executing or queued before it, so Jobs touching the same Cid
+// are executed in creation order. Considered Jobs are dispatched fairly between
+// instances: the next Job always belongs to the instance with fewer running Jobs,
+// taking turns between instances, and between Jobs of the same instance, higher
+// priority ones go first.
+func (s *Scheduler) execQueuedJobs() {
 	js, err := s.js.GetByStatus(ffs.Queued)
 	if err != nil {
 		log.Errorf("getting queued jobs: %s", err)
 		return
 	}
 	log.Infof("detected %d queued jobs", len(js))
+	sort.Slice(js, func(i, j int) bool {
+		return js[i].Created.Before(js[j].Created)
+	})
+	considered := make(map[cid.Cid]struct{})
+	queues := make(map[ffs.APIID][]ffs.Job)
+	var nextRetry time.Time
+	defer func() {
+		// Wake up when the closest retry is due.
+		if !nextRetry.IsZero() {
+			s.retryTimer.Reset(time.Until(nextRetry))
+		}
+	}()
 	for _, j := range js {
+		if _, ok := considered[j.Cid]; ok {
+			continue
+		}
+		considered[j.Cid] = struct{}{}
+		if time.Now().Before(j.NextRetry) {
+			if nextRetry.IsZero() || j.NextRetry.Before(nextRetry) {
+				nextRetry = j.NextRetry
+			}
+			continue
+		}
+		queues[j.APIID] = append(queues[j.APIID], j)
+	}
+	for _, q := range queues {
+		sort.SliceStable(q, func(i, j int) bool {
+			return q[i].Priority > q[j].Priority
+		})
+	}
+
+	for len(queues) > 0 {
+		iid := s.nextFairInstance(queues)
+		j := queues[iid][0]
+		queues[iid] = queues[iid][1:]
+		if len(queues[iid]) == 0 {
+			delete(queues, iid)
+		}
 
-		if err := s.mutateJobStatus(j, ffs.InProgress); err != nil {
+		// A Job replacing a Cid also changes its storage state, so
+		// both Cids are locked.
+		cids := s.jobCids(j)
+		if !s.tryLockCid(cids...) {
+			continue
+		}
+		select {
+		case s.rateLim <- struct{}{}:
+		default:
+			log.Debugf("max parallel executions reached, waiting for running jobs")
+			s.unlockCid(cids...)
+			return
+		}
+		ctx, ok, err := s.startJob(&j)
+		if err != nil {
 			log.Errorf("changing job to in-progress: %s", err)
+			s.unlockCid(cids...)
+			<-s.rateLim
 			return
 		}
+		if !ok {
+			// The Job was canceled after being listed.
+			s.unlockCid(cids...)
+			<-s.rateLim
+			continue
+		}
+		s.wg.Add(1)
+		go func(ctx context.Context, j ffs.Job, cids []cid.Cid) {
+			defer s.wg.Done()
+			s.executeQueuedJob(ctx, j)
+			s.finishJob(j)
+			s.unlockCid(cids...)
+			<-s.rateLim
+			s.signalQueuedWork()
+		}(ctx, j, cids)
+	}
+}
 
-		a, err := s.as.Get(j.ID)
-		if err != nil {
-			log.Errorf("getting push config action data from store: %s", err)
+// nextFairInstance returns the instance with fewer running Jobs between the
+// ones with queued Jobs. Ties are broken by the instance which had a Job
+// dispatched least recently, and then by the oldest next queued Job.
+func (s *Scheduler) nextFairInstance(queues map[ffs.APIID][]ffs.Job) ffs.APIID {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var res ffs.APIID
+	for iid, q := range queues {
+		if res == ffs.EmptyInstanceID {
+			res = iid
+			continue
+		}
+		ri, rr := s.runningJobs[iid], s.runningJobs[res]
+		if ri != rr {
+			if ri < rr {
+				res = iid
+			}
 			continue
 		}
+		di, dr := s.lastDispatch[iid], s.lastDispatch[res]
+		if di < dr || (di == dr && q[0].Created.Before(queues[res][0].Created)) {
+			res = iid
+		}
+	}
+	return res
+}
 
-		ctx := context.WithValue(s.ctx, ffs.CtxKeyJid, j.ID)
-		s.l.Log(ctx, a.Cfg.Cid, "Executing job %s...", j.ID)
+// startJob changes a queued Job to in-progress as a new attempt, and returns
+// a cancelable context for its execution. The error cause of a previous failed
+// attempt is cleared. If the Job isn't queued anymore, it returns false.
+func (s *Scheduler) startJob(j *ffs.Job) (context.Context, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	curr, err := s.js.Get(j.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("get Job from store: %s", err)
+	}
+	if curr.Status != ffs.Queued {
+		return nil, false, nil
+	}
+	j.Attempts++
+	j.NextRetry = time.Time{}
+	j.ErrCause = ""
+	j.Started = time.Now()
+	if err := s.mutateJobStatus(*j, ffs.InProgress); err != nil {
+		return nil, false, err
+	}
+	j.Status = ffs.InProgress
+	ctx, cancel := context.WithCancel(context.WithValue(s.ctx, ffs.CtxKeyJid, j.ID))
+	s.cancelJobs[j.ID] = cancel
+	s.runningJobs[j.APIID]++
+	s.dispatchSeq++
+	s.lastDispatch[j.APIID] = s.dispatchSeq
+	return ctx, true, nil
+}
 
-		info, err := s.executePushConfigAction(ctx, a, j)
-		if err != nil {
-			log.Errorf("executing job %s: %s", j.ID, err)
-			j.ErrCause = err.Error()
-			if err := s.mutateJobStatus(j, ffs.Failed); err != nil {
-				log.Errorf("changing job to failed: %s", err)
+func (s *Scheduler) finishJob(j ffs.Job) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if cancel, ok := s.cancelJobs[j.ID]; ok {
+		cancel()
+		delete(s.cancelJobs, j.ID)
+	}
+	s.runningJobs[j.APIID]--
+	if s.runningJobs[j.APIID] == 0 {
+		delete(s.runningJobs, j.APIID)
+	}
+}
+
+func (s *Scheduler) executeQueuedJob(ctx context.Context, j ffs.Job) {
+	a, err := s.as.Get(j.ID)
+	if err != nil {
+		log.Errorf("getting push config action data from store: %s", err)
+		j.ErrCause = err.Error()
+		if err := s.mutateJobStatus(j, ffs.Failed); err != nil {
+			log.Errorf("changing job to failed: %s", err)
+		}
+		return
+	}
+
+	s.l.Log(ctx, a.Cfg.Cid, "Executing job %s...", j.ID)
+
+	info, err := s.executePushConfigAction(ctx, a, j)
+	if err != nil && s.ctx.Err() != nil {
+		// The Scheduler is closing, so the Job is left in-progress
+		// to be resumed in the next run.
+		log.Infof("job %s execution was interrupted: %s", j.ID, err)
+		return
+	}
+	defer func() {
+		if err := s.js.RemoveStartedDeals(j.ID); err != nil {
+			log.Errorf("removing started deals of finished job: %s", err)
+		}
+	}()
+	if err != nil && ctx.Err() != nil {
+		// The Job was canceled, save the storage state reached
+		// until cancellation.
+		log.Infof("job %s was canceled: %s", j.ID, err)
+		if info.Cid.Defined() {
+			if err := s.cis.Put(info); err != nil {
+				log.Errorf("saving cid info to store: %s", err)
 			}
-			s.l.Log(ctx, a.Cfg.Cid, "Job %s execution failed.", j.ID)
-			continue
 		}
-		if err := s.cis.Put(info); err != nil {
-			log.Errorf("saving cid info to store: %s", err)
+		if err := s.mutateJobStatus(j, ffs.Canceled); err != nil {
+			log.Errorf("changing job to canceled: %s", err)
+		}
+		s.l.Log(ctx, a.Cfg.Cid, "Job %s was canceled.", j.ID)
+		return
+	}
+	if err != nil {
+		log.Errorf("executing job %s: %s", j.ID, err)
+		j.ErrCause = err.Error()
+		if a.Cfg.Retry.ShouldRetry(j.Attempts, failureClassOf(err)) {
+			s.retryJob(ctx, j, a, info)
+			return
 		}
-		if err := s.mutateJobStatus(j, ffs.Success); err != nil {
-			log.Errorf("changing job to success: %s", err)
+		if err := s.mutateJobStatus(j, ffs.Failed); err != nil {
+			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, a.Cfg.Cid, "Job %s execution finished successfully.", j.ID)
+		s.l.Log(ctx, a.Cfg.Cid, "Job %s execution failed.", j.ID)
+		return
+	}
+	if err := s.cis.Put(info); err != nil {
+		log.Errorf("saving cid info to store: %s", err)
+	}
+	if err := s.mutateJobStatus(j, ffs.Success); err != nil {
+		log.Errorf("changing job to success: %s", err)
+	}
+	s.l.Log(ctx, a.Cfg.Cid, "Job %s execution finished successfully.", j.ID)
+}
 
-		if ctx.Err() != nil {
-			break
+// retryJob queues again a failed Job to be retried after the backoff
+// defined in its retry policy. The storage state reached by the failed
+// attempt is saved, so it's considered by the next attempt.
+func (s *Scheduler) retryJob(ctx context.Context, j ffs.Job, a Action, info ffs.CidInfo) {
+	if info.Cid.Defined() {
+		if err := s.cis.Put(info); err != nil {
+			log.Errorf("saving cid info to store: %s", err)
 		}
 	}
+	backoff := a.Cfg.Retry.NextBackoff(j.Attempts)
+	j.NextRetry = time.Now().Add(backoff)
+	if err := s.mutateJobStatus(j, ffs.Queued); err != nil {
+		log.Errorf("changing job to queued for retry: %s", err)
+		return
+	}
+	s.l.Log(ctx, a.Cfg.Cid, "Job %s execution failed, attempt %d of %d will start in %s.", j.ID, j.Attempts+1, a.Cfg.Retry.MaxAttempts, backoff)
 }
 
+// executePushConfigAction executes the Action of a Job. If the execution fails, the
+// returned CidInfo contains the storage state reached until the failure.
 func (s *Scheduler) executePushConfigAction(ctx context.Context, a Action, job ffs.Job) (ffs.CidInfo, error) {
 	ci, err := s.getRefreshedInfo(ctx, a.Cfg.Cid)
 	if err != nil {
 		return ffs.CidInfo{}, fmt.Errorf("getting current cid info from store: %s", err)
 	}
+	ci.JobID = job.ID
+	ci.Created = time.Now()
+	ci.Refs = ci.Refs.Update(a.APIID, a.Cfg.Hot.Enabled, a.Cfg.Cold.Enabled)
+
+	replaceCid := a.ReplacedCid
+	if replaceCid.Defined() {
+		stillNeeded, err := s.isReplacedCidNeeded(a.APIID, replaceCid)
+		if err != nil {
+			return ci, fmt.Errorf("checking replaced cid references: %s", err)
+		}
+		if stillNeeded {
+			s.l.Log(ctx, a.Cfg.Cid, "Replaced Cid %s is still needed by other instances, keeping it in Hot-Storage.", replaceCid)
+			replaceCid = cid.Undef
+		}
+	}
 
 	s.l.Log(ctx, a.Cfg.Cid, "Ensuring Hot-Storage satisfies the configuration...")
-	hot, err := s.executeHotStorage(ctx, ci, a.Cfg.Hot, a.Waddr, a.ReplacedCid)
+	hot, err := s.executeHotStorage(ctx, ci, a.Cfg.Hot, a.Waddr, replaceCid)
 	if err != nil {
 		s.l.Log(ctx, a.Cfg.Cid, "Hot-Storage excution failed.")
-		return ffs.CidInfo{}, fmt.Errorf("executing hot-storage config: %s", err)
+		return ci, newFailure(ffs.FailureHotStorage, "executing hot-storage config", err)
 	}
+	ci.Hot = hot
 	s.l.Log(ctx, a.Cfg.Cid, "Hot-Storage execution ran successfully.")
+	if a.ReplacedCid.Defined() {
+		// The replaced Cid is locked by the dispatcher, so its references
+		// can be released now that the replacement was applied.
+		if err := s.releaseReplacedCid(a.APIID, a.ReplacedCid); err != nil {
+			return ci, fmt.Errorf("releasing replaced cid: %s", err)
+		}
+	}
 
 	s.l.Log(ctx, a.Cfg.Cid, "Ensuring Cold-Storage satisfies the configuration...")
-	cold, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, a.Waddr)
+	cold, err := s.executeColdStorage(ctx, a.APIID, job.ID, ci, a.Cfg.Cold, a.Waddr)
+	s.recordSpends(a.APIID, a.Cfg.Cid, ci.Cold.Filecoin.Proposals, cold.Filecoin.Proposals)
+	ci.Cold = cold
 	if err != nil {
 		s.l.Log(ctx, a.Cfg.Cid, "Cold-Storage execution failed.")
-		return ffs.CidInfo{}, fmt.Errorf("executing cold-storage config: %s", err)
+		return ci, newFailure(ffs.FailureColdStorage, "executing cold-storage config", err)
 	}
 	s.l.Log(ctx, a.Cfg.Cid, "Cold-Storage execution ran successfully.")
 
-	return ffs.CidInfo{
-		JobID:   job.ID,
-		Cid:     a.Cfg.Cid,
-		Hot:     hot,
-		Cold:    cold,
-		Created: time.Now(),
-	}, nil
+	return ci, nil
+}
+
+// recordSpends records the cost of deals in curr which aren't in prev as
+// spent by the instance. Failing to record a cost doesn't fail the execution.
+func (s *Scheduler) recordSpends(iid ffs.APIID, c cid.Cid, prev, curr []ffs.FilStorage) {
+	known := make(map[cid.Cid]struct{}, len(prev))
+	for _, p := range prev {
+		known[p.ProposalCid] = struct{}{}
+	}
+	for _, p := range curr {
+		if _, ok := known[p.ProposalCid]; ok {
+			continue
+		}
+		r := ffs.SpendRecord{
+			APIID:       iid,
+			Cid:         c,
+			ProposalCid: p.ProposalCid,
+			Miner:       p.Miner,
+			Amount:      p.TotalCost,
+			Time:        time.Now(),
+		}
+		if err := s.ss.Add(r); err != nil {
+			log.Errorf("recording spend of deal %s: %s", p.ProposalCid, err)
+		}
+	}
+}
+
+// isReplacedCidNeeded returns true if instances other than iid still need
+// a replaced Cid in the Hot Storage.
+func (s *Scheduler) isReplacedCidNeeded(iid ffs.APIID, c cid.Cid) (bool, error) {
+	ci, err := s.cis.Get(c)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting replaced cid info from store: %s", err)
+	}
+	return len(ci.Refs.Update(iid, false, false).Hot) > 0, nil
+}
+
+// releaseReplacedCid removes the references of an instance to a replaced Cid.
+// The replaced Cid should be locked by the caller.
+func (s *Scheduler) releaseReplacedCid(iid ffs.APIID, c cid.Cid) error {
+	ci, err := s.cis.Get(c)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting replaced cid info from store: %s", err)
+	}
+	ci.Refs = ci.Refs.Update(iid, false, false)
+	if err := s.cis.Put(ci); err != nil {
+		return fmt.Errorf("saving replaced cid info in store: %s", err)
+	}
+	return nil
 }
 
+// executeHotStorage ensures the Hot Storage satisfies the configuration. The Cid is
+// only removed from the Hot Storage if no other instance references it.
 func (s *Scheduler) executeHotStorage(ctx context.Context, curr ffs.CidInfo, cfg ffs.HotConfig, waddr string, replaceCid cid.Cid) (ffs.HotInfo, error) {
+	if !cfg.Enabled && len(curr.Refs.Hot) > 0 {
+		s.l.Log(ctx, curr.Cid, "Cid is still needed by other instances in Hot-Storage, keeping it.")
+		return curr.Hot, nil
+	}
 	if cfg.Enabled == curr.Hot.Enabled {
 		s.l.Log(ctx, curr.Cid, "Current Cid state is healthy in Hot-Storage.")
 		return curr.Hot, nil
@@ -338,13 +1073,13 @@ func (s *Scheduler) executeHotStorage(ctx context.Context, curr ffs.CidInfo, cfg
 	sctx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(cfg.Ipfs.AddTimeout))
 	defer cancel()
 
-	var size int
+	var stat ffs.DagStat
 	var err error
 	if !replaceCid.Defined() {
-		size, err = s.hs.Store(sctx, curr.Cid)
+		stat, err = s.hs.Store(sctx, curr.Cid)
 	} else {
 		s.l.Log(ctx, curr.Cid, "Replace of previous pin %s", replaceCid)
-		size, err = s.hs.Replace(sctx, replaceCid, curr.Cid)
+		stat, err = s.hs.Replace(sctx, replaceCid, curr.Cid)
 	}
 	if err != nil {
 		s.l.Log(ctx, curr.Cid, "Direct fetching from IPFS wasn't possible.")
@@ -356,17 +1091,19 @@ func (s *Scheduler) executeHotStorage(ctx context.Context, curr ffs.CidInfo, cfg
 		bs := &hotStorageBlockstore{ctx: ctx, put: s.hs.Put}
 		carHeaderCid, err := s.cs.Retrieve(ctx, curr.Cold.Filecoin.DataCid, bs, waddr)
 		if err != nil {
-			return ffs.HotInfo{}, fmt.Errorf("unfreezing from Cold Storage: %s", err)
+			return ffs.HotInfo{}, &failure{class: ffs.FailureUnfreeze, err: fmt.Errorf("unfreezing from Cold Storage: %s", err)}
 		}
 		s.l.Log(ctx, curr.Cid, "Unfrozen successfully, saving in Hot-Storage...")
-		size, err = s.hs.Store(ctx, carHeaderCid)
+		stat, err = s.hs.Store(ctx, carHeaderCid)
 		if err != nil {
 			return ffs.HotInfo{}, fmt.Errorf("pinning unfrozen cid: %s", err)
 		}
 	}
+	s.l.Log(ctx, curr.Cid, "Stored %d bytes in %d blocks in Hot-Storage.", stat.Size, stat.NumBlocks)
 	return ffs.HotInfo{
-		Enabled: true,
-		Size:    size,
+		Enabled:   true,
+		Size:      stat.Size,
+		NumBlocks: stat.NumBlocks,
 		Ipfs: ffs.IpfsHotInfo{
 			Created: time.Now(),
 		},
@@ -405,6 +1142,9 @@ func (s *Scheduler) getRefreshedHotInfo(ctx context.Context, c cid.Cid, curr ffs
 	return curr, nil
 }
 
+// getRefreshedColdInfo returns curr without the deals that aren't active
+// anymore. Miners of those deals are recorded as failed for the Cid, so
+// they're excluded when making new deals even after curr is replaced.
 func (s *Scheduler) getRefreshedColdInfo(ctx context.Context, c cid.Cid, curr ffs.ColdInfo) (ffs.ColdInfo, error) {
 	activeDeals := make([]ffs.FilStorage, 0, len(curr.Filecoin.Proposals))
 	for _, fp := range curr.Filecoin.Proposals {
@@ -416,31 +1156,172 @@ func (s *Scheduler) getRefreshedColdInfo(ctx context.Context, c cid.Cid, curr ff
 			activeDeals = append(activeDeals, fp)
 		}
 	}
-	curr.Filecoin.Proposals = activeDeals
-	return curr, nil
+	refreshed := curr
+	refreshed.Filecoin.Proposals = activeDeals
+	if failed := inactiveMiners(curr.Filecoin, refreshed.Filecoin); len(failed) > 0 {
+		if err := s.cis.AddFailedMiners(c, failed); err != nil {
+			return ffs.ColdInfo{}, fmt.Errorf("saving failed miners: %s", err)
+		}
+	}
+	return refreshed, nil
 }
 
-func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.CidInfo, cfg ffs.ColdConfig, waddr string) (ffs.ColdInfo, error) {
+// executeColdStorage ensures the Cold Storage satisfies the configuration. If jid isn't
+// empty, new deal proposals are saved as started deals of the Job, so they can be
+// watched again instead of making new ones if the Job execution gets interrupted.
+func (s *Scheduler) executeColdStorage(ctx context.Context, iid ffs.APIID, jid ffs.JobID, curr ffs.CidInfo, cfg ffs.ColdConfig, waddr string) (ffs.ColdInfo, error) {
 	if !cfg.Enabled {
-		s.l.Log(ctx, curr.Cid, "Cold-Storage was disabled, Filecoin deals will eventually expire.")
+		if len(curr.Refs.Cold) > 0 {
+			s.l.Log(ctx, curr.Cid, "Cold-Storage was disabled, but Filecoin deals are still needed by other instances.")
+		} else {
+			s.l.Log(ctx, curr.Cid, "Cold-Storage was disabled, Filecoin deals will eventually expire.")
+		}
 		return curr.Cold, nil
 	}
 
-	if isCurrentRepFactorEnough(cfg.Filecoin.RepFactor, curr) {
-		s.l.Log(ctx, curr.Cid, "The current replication factor is equal or higher than desired, avoiding making new deals.")
-		log.Infof("replication well enough, avoid making new deals")
-		return curr.Cold, nil
+	var props []ffs.FilProposal
+	size := curr.Cold.Filecoin.Size
+	if jid != ffs.EmptyJobID {
+		var err error
+		props, err = s.js.GetStartedDeals(jid)
+		if err != nil {
+			return curr.Cold, fmt.Errorf("getting started deals: %s", err)
+		}
 	}
+	if len(props) > 0 {
+		s.l.Log(ctx, curr.Cid, "Resuming watching %d deals started before an interruption...", len(props))
+	} else {
+		if isCurrentRepFactorEnough(cfg.Filecoin.RepFactor, curr) {
+			s.l.Log(ctx, curr.Cid, "The current replication factor is equal or higher than desired, avoiding making new deals.")
+			log.Infof("replication well enough, avoid making new deals")
+			return curr.Cold, nil
+		}
 
-	deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
-	s.l.Log(ctx, curr.Cid, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
-	fi, err := s.cs.Store(ctx, curr.Cid, waddr, deltaFilConfig)
-	if err != nil {
-		return ffs.ColdInfo{}, err
+		deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
+		failedMiners, err := s.cis.GetFailedMiners(curr.Cid)
+		if err != nil {
+			return curr.Cold, fmt.Errorf("getting failed miners: %s", err)
+		}
+		if len(failedMiners) > 0 {
+			s.l.Log(ctx, curr.Cid, "Excluding miners with inactive deals: %v", failedMiners)
+			deltaFilConfig.ExcludedMiners = append(deltaFilConfig.ExcludedMiners, failedMiners...)
+		}
+		s.l.Log(ctx, curr.Cid, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
+		size = s.getDagSize(ctx, curr)
+		release, err := s.ensureDealFunds(ctx, iid, curr.Cid, size, waddr, deltaFilConfig)
+		if err != nil {
+			return curr.Cold, err
+		}
+		props, err = s.cs.Store(ctx, curr.Cid, size, waddr, deltaFilConfig)
+		// Funds are escrowed by Lotus when deals are made, so
+		// from now on the balance already reflects what they cost.
+		release()
+		if err != nil {
+			return curr.Cold, err
+		}
+		if jid != ffs.EmptyJobID {
+			if err := s.js.PutStartedDeals(jid, props); err != nil {
+				return curr.Cold, fmt.Errorf("saving started deals: %s", err)
+			}
+		}
+	}
+
+	fi, err := s.cs.WaitForDeals(ctx, curr.Cid, props, cfg.Filecoin.DealDuration)
+	// Keep current active deals, since new deals only
+	// complete the desired replication factor. If waiting
+	// failed, fi has the deals that became active anyway.
+	proposals := make([]ffs.FilStorage, 0, len(curr.Cold.Filecoin.Proposals)+len(fi.Proposals))
+	proposals = append(proposals, curr.Cold.Filecoin.Proposals...)
+	proposals = append(proposals, fi.Proposals...)
+	if !fi.DataCid.Defined() {
+		fi.DataCid = curr.Cold.Filecoin.DataCid
 	}
+	if size == 0 && len(proposals) > 0 {
+		size = s.getDagSize(ctx, curr)
+	}
+	fi.Size = size
+	fi.Proposals = proposals
 	return ffs.ColdInfo{
 		Filecoin: fi,
-	}, nil
+	}, err
+}
+
+// ensureDealFunds ensures that waddr has enough funds to pay for the estimated
+// cost of the deals that will be made with cfg, and reserves them until the
+// returned func is called. If it doesn't, it returns an insufficient funds
+// error so the Job fails before making deals.
+func (s *Scheduler) ensureDealFunds(ctx context.Context, iid ffs.APIID, c cid.Cid, size int, waddr string, cfg ffs.FilConfig) (func(), error) {
+	fk := s.fundsKeeper()
+	if fk == nil {
+		return func() {}, nil
+	}
+	mps, err := s.cs.PlanDeals(ctx, size, cfg)
+	if err != nil {
+		// Making deals will fail with a more descriptive error.
+		log.Warnf("estimating cost of deals for %s: %s", c, err)
+		return func() {}, nil
+	}
+	return s.reserveFunds(ctx, fk, iid, c, waddr, mps, cfg.DealDuration)
+}
+
+// ensureRenewalFunds ensures that the wallet address of an Action has enough
+// funds to pay for the estimated cost of the renewals of its deals, and
+// reserves them until the returned func is called.
+func (s *Scheduler) ensureRenewalFunds(ctx context.Context, a Action, inf ffs.FilInfo) (func(), error) {
+	fk := s.fundsKeeper()
+	if fk == nil {
+		return func() {}, nil
+	}
+	mps, err := s.cs.PlanRenewals(ctx, inf, a.Cfg.Cold.Filecoin)
+	if err != nil {
+		return nil, fmt.Errorf("estimating cost of renewals: %s", err)
+	}
+	return s.reserveFunds(ctx, fk, a.APIID, a.Cfg.Cid, a.Waddr, mps, a.Cfg.Cold.Filecoin.DealDuration)
+}
+
+// reserveFunds ensures with fk that waddr can pay for deals with the miners
+// for duration epochs.
+func (s *Scheduler) reserveFunds(ctx context.Context, fk ffs.FundsKeeper, iid ffs.APIID, c cid.Cid, waddr string, mps []ffs.MinerProposal, duration int64) (func(), error) {
+	if len(mps) == 0 {
+		return func() {}, nil
+	}
+	var cost uint64
+	for _, m := range mps {
+		cost += m.EpochPrice * uint64(duration)
+	}
+	release, err := fk.EnsureFunds(ctx, iid, waddr, cost)
+	if err == ffs.ErrInsufficientFunds {
+		s.l.Log(ctx, c, "Wallet address %s doesn't have enough funds for the estimated deals cost of %d attoFIL, and can't be topped-up.", waddr, cost)
+		return nil, fmt.Errorf("%s: wallet address %s can't pay the estimated deals cost of %d attoFIL", err, waddr, cost)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ensuring funds of wallet address %s: %s", waddr, err)
+	}
+	return release, nil
+}
+
+// getDagSize returns the size of the DAG of a Cid. If it isn't known from
+// the current storage state, it's asked to the Hot Storage. It returns zero
+// if the size can't be known.
+func (s *Scheduler) getDagSize(ctx context.Context, ci ffs.CidInfo) int {
+	if size := knownDagSize(ci); size > 0 {
+		return size
+	}
+	stat, err := s.hs.Stat(ctx, ci.Cid)
+	if err != nil {
+		log.Errorf("getting dag size of %s: %s", ci.Cid, err)
+		return 0
+	}
+	return stat.Size
+}
+
+// knownDagSize returns the size of the DAG of a Cid known from its
+// current storage state, or zero if it isn't known.
+func knownDagSize(ci ffs.CidInfo) int {
+	if ci.Cold.Filecoin.Size > 0 {
+		return ci.Cold.Filecoin.Size
+	}
+	return ci.Hot.Size
 }
 
 func isCurrentRepFactorEnough(desiredRepFactor int, curr ffs.CidInfo) bool {
@@ -451,14 +1332,65 @@ func isCurrentRepFactorEnough(desiredRepFactor int, curr ffs.CidInfo) bool {
 func createDeltaFilConfig(cfg ffs.ColdConfig, curr ffs.FilInfo) ffs.FilConfig {
 	res := cfg.Filecoin
 	res.RepFactor = cfg.Filecoin.RepFactor - len(curr.Proposals)
+	res.ExcludedMiners = make([]string, len(cfg.Filecoin.ExcludedMiners), len(cfg.Filecoin.ExcludedMiners)+len(curr.Proposals))
+	copy(res.ExcludedMiners, cfg.Filecoin.ExcludedMiners)
 	for _, p := range curr.Proposals {
 		res.ExcludedMiners = append(res.ExcludedMiners, p.Miner)
 	}
 	return res
 }
 
+// tryLockCid marks Cids as being executed. It returns false, without
+// marking any of them, if some Cid is already being executed.
+func (s *Scheduler) tryLockCid(cs ...cid.Cid) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, c := range cs {
+		if _, ok := s.executingCids[c]; ok {
+			return false
+		}
+	}
+	for _, c := range cs {
+		s.executingCids[c] = struct{}{}
+	}
+	return true
+}
+
+func (s *Scheduler) unlockCid(cs ...cid.Cid) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, c := range cs {
+		delete(s.executingCids, c)
+	}
+}
+
+// jobCids returns the Cids whose storage state is changed by a Job,
+// which are its Cid and the replaced Cid, if any.
+func (s *Scheduler) jobCids(j ffs.Job) []cid.Cid {
+	a, err := s.as.Get(j.ID)
+	if err != nil {
+		// The Job execution will fail with a proper error.
+		log.Errorf("getting action of job %s: %s", j.ID, err)
+		return []cid.Cid{j.Cid}
+	}
+	if !a.ReplacedCid.Defined() || a.ReplacedCid == j.Cid {
+		return []cid.Cid{j.Cid}
+	}
+	return []cid.Cid{j.Cid, a.ReplacedCid}
+}
+
+func (s *Scheduler) signalQueuedWork() {
+	select {
+	case s.queuedWork <- struct{}{}:
+	default:
+	}
+}
+
 func (s *Scheduler) mutateJobStatus(j ffs.Job, status ffs.JobStatus) error {
 	j.Status = status
+	if status.IsFinal() {
+		j.Finished = time.Now()
+	}
 	if err := s.js.Put(j); err != nil {
 		return err
 	}
@@ -476,3 +1408,29 @@ func (hsb *hotStorageBlockstore) Put(b blocks.Block) error {
 	}
 	return nil
 }
+
+// failure is a Job execution error with its failure class.
+type failure struct {
+	class ffs.FailureClass
+	err   error
+}
+
+func (f *failure) Error() string {
+	return f.err.Error()
+}
+
+// newFailure wraps err with a message. If err is a failure its
+// class is kept, otherwise class is used.
+func newFailure(class ffs.FailureClass, msg string, err error) error {
+	if f, ok := err.(*failure); ok {
+		class = f.class
+	}
+	return &failure{class: class, err: fmt.Errorf("%s: %s", msg, err)}
+}
+
+func failureClassOf(err error) ffs.FailureClass {
+	if f, ok := err.(*failure); ok {
+		return f.class
+	}
+	return ffs.FailureInternal
+}
@@ -0,0 +1,729 @@
+package scheduler_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-car"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/cidlogger"
+	"github.com/textileio/powergate/ffs/scheduler"
+	"github.com/textileio/powergate/ffs/scheduler/astore"
+	"github.com/textileio/powergate/ffs/scheduler/cistore"
+	"github.com/textileio/powergate/ffs/scheduler/jstore"
+	"github.com/textileio/powergate/ffs/scheduler/sstore"
+	"github.com/textileio/powergate/tests"
+	txndstr "github.com/textileio/powergate/txndstransform"
+	"github.com/textileio/powergate/util"
+)
+
+const (
+	waddr = "t3wallet"
+)
+
+func TestMain(m *testing.M) {
+	logging.SetAllLoggers(logging.LevelError)
+	util.AvgBlockTime = time.Millisecond * 50
+	os.Exit(m.Run())
+}
+
+func TestRepair(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1", "m2", "m3")
+	s, cls := newScheduler(t, cs)
+	defer cls()
+
+	iid := ffs.NewAPIID()
+	c := newCid("TestRepair")
+	cfg := newCidConfig(c).WithColdFilRepFactor(2).WithColdFilRepair(true)
+	jid, err := s.PushConfig(iid, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	requireMiners(t, s, c, "m1", "m2")
+
+	// The deal with m1 isn't active anymore, so a new deal should be
+	// made with the only miner that wasn't used.
+	cs.setInactive("m1")
+	require.Eventually(t, func() bool {
+		return equalMiners(t, s, c, "m2", "m3")
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, 2, cs.storeCalls())
+
+	// The new deal of the repair is recorded as spent by the instance.
+	spends, err := s.ListSpends(ffs.SpendFilter{APIID: iid})
+	require.NoError(t, err)
+	require.Len(t, spends, 3)
+	spends, err = s.ListSpends(ffs.SpendFilter{APIID: iid, Miner: "m3"})
+	require.NoError(t, err)
+	require.Len(t, spends, 1)
+}
+
+func TestRepairExcludesFailedMiners(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1", "m2", "m3", "m4")
+	s, cls := newScheduler(t, cs)
+	defer cls()
+
+	iid := ffs.NewAPIID()
+	c := newCid("TestRepairExcludesFailedMiners")
+	cfg := newCidConfig(c).WithColdFilRepFactor(2).WithColdFilRepair(true)
+	jid, err := s.PushConfig(iid, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	cs.setInactive("m1")
+	require.Eventually(t, func() bool {
+		return equalMiners(t, s, c, "m2", "m3")
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// The stored deals don't include m1 anymore, but it still
+	// shouldn't be selected when repairing again.
+	cs.setInactive("m2")
+	require.Eventually(t, func() bool {
+		return equalMiners(t, s, c, "m3", "m4")
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, 3, cs.storeCalls())
+}
+
+func TestRepairUsesLatestConfig(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1", "m2", "m3")
+	s, cls := newScheduler(t, cs)
+	defer cls()
+
+	iid := ffs.NewAPIID()
+	c := newCid("TestRepairUsesLatestConfig")
+	cfg := newCidConfig(c).WithColdFilRepFactor(2).WithColdFilRepair(true)
+	jid, err := s.PushConfig(iid, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+
+	// A newer config disables repair, so losing a deal shouldn't
+	// trigger new deals even if the first config is repairable.
+	jid, err = s.PushConfig(iid, waddr, cfg.WithColdFilRepair(false), 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	cs.setInactive("m1")
+	time.Sleep(util.AvgBlockTime * 10)
+	require.Equal(t, 1, cs.storeCalls())
+}
+
+func TestRetriedJobSuccess(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1")
+	cs.failStores = 1
+	s, cls := newScheduler(t, cs)
+	defer cls()
+
+	c := newCid("TestRetriedJobSuccess")
+	cfg := newCidConfig(c).WithRetry(2, 0, 0)
+	jid, err := s.PushConfig(ffs.NewAPIID(), waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+
+	j, err := s.GetJob(jid)
+	require.NoError(t, err)
+	require.Equal(t, 2, j.Attempts)
+	require.Empty(t, j.ErrCause)
+	require.True(t, j.NextRetry.IsZero())
+}
+
+func TestJobPriority(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1")
+	cs.block = make(chan struct{})
+	s, cls := newScheduler(t, cs, scheduler.WithMaxParallel(1))
+	defer cls()
+
+	// The first Job keeps the Scheduler busy while the
+	// rest of Jobs are queued.
+	iid := ffs.NewAPIID()
+	first := pushBlockedJob(t, s, cs, iid, "TestJobPriority")
+	priorities := []int{0, 10, -1, 5}
+	cids := make([]cid.Cid, len(priorities))
+	for i, p := range priorities {
+		cids[i] = newCid(fmt.Sprintf("TestJobPriority-%d", i))
+		_, err := s.PushConfig(iid, waddr, newCidConfig(cids[i]), p)
+		require.NoError(t, err)
+	}
+	for range priorities {
+		cs.block <- struct{}{}
+	}
+	expected := []cid.Cid{first, cids[1], cids[3], cids[0], cids[2]}
+	require.Eventually(t, func() bool {
+		return len(cs.storedCids()) == len(expected)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, cs.storedCids())
+}
+
+func TestJobFairness(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1")
+	cs.block = make(chan struct{})
+	s, cls := newScheduler(t, cs, scheduler.WithMaxParallel(1))
+	defer cls()
+
+	// A busy instance queues many high priority Jobs before
+	// another instance queues a low priority one.
+	busy := ffs.NewAPIID()
+	first := pushBlockedJob(t, s, cs, busy, "TestJobFairness")
+	busyCids := make([]cid.Cid, 3)
+	for i := range busyCids {
+		busyCids[i] = newCid(fmt.Sprintf("TestJobFairness-%d", i))
+		_, err := s.PushConfig(busy, waddr, newCidConfig(busyCids[i]), 10)
+		require.NoError(t, err)
+	}
+	low := newCid("TestJobFairness-low")
+	_, err := s.PushConfig(ffs.NewAPIID(), waddr, newCidConfig(low), -10)
+	require.NoError(t, err)
+	for i := 0; i < len(busyCids)+1; i++ {
+		cs.block <- struct{}{}
+	}
+
+	// The low priority Job takes the next turn instead of
+	// waiting for all the Jobs of the busy instance.
+	expected := []cid.Cid{first, low, busyCids[0], busyCids[1], busyCids[2]}
+	require.Eventually(t, func() bool {
+		return len(cs.storedCids()) == len(expected)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, cs.storedCids())
+}
+
+// pushBlockedJob pushes a config for a new Cid, and waits until its Job
+// is executing and blocked waiting for deals.
+func pushBlockedJob(t *testing.T, s *scheduler.Scheduler, cs *coldStorage, iid ffs.APIID, data string) cid.Cid {
+	c := newCid(data)
+	jid, err := s.PushConfig(iid, waddr, newCidConfig(c), 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.InProgress)
+	require.Eventually(t, func() bool {
+		return len(cs.storedCids()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	return c
+}
+
+func TestUntrackSharedCid(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1", "m2")
+	s, cls := newScheduler(t, cs)
+	defer cls()
+
+	c := newCid("TestUntrackSharedCid")
+	cfg := newCidConfig(c).WithColdFilRepair(true)
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	jid, err := s.PushConfig(iid1, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	jid, err = s.PushConfig(iid2, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+
+	// Untracking the Cid for one instance keeps it
+	// repaired for the other one.
+	require.NoError(t, s.Untrack(iid1, c))
+	require.Error(t, s.Untrack(iid1, c))
+	cs.setInactive("m1")
+	require.Eventually(t, func() bool {
+		return equalMiners(t, s, c, "m2")
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestPlanConfigSharedHotCid(t *testing.T) {
+	t.Parallel()
+	s, cls := newScheduler(t, newColdStorage("m1"))
+	defer cls()
+
+	c := newCid("TestPlanConfigSharedHotCid")
+	cfg := newCidConfig(c).WithHotEnabled(true)
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	jid, err := s.PushConfig(iid1, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	plan, err := s.PlanConfig(context.Background(), iid1, cfg.WithHotEnabled(false))
+	require.NoError(t, err)
+	require.Equal(t, ffs.HotActionRemove, plan.Hot.Action)
+
+	// Disabling Hot Storage for one instance keeps the Cid,
+	// since the other one still needs it.
+	jid, err = s.PushConfig(iid2, waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	plan, err = s.PlanConfig(context.Background(), iid1, cfg.WithHotEnabled(false))
+	require.NoError(t, err)
+	require.Equal(t, ffs.HotActionUnref, plan.Hot.Action)
+	jid, err = s.PushConfig(iid1, waddr, cfg.WithHotEnabled(false), 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	ci, err := s.GetCidInfo(c)
+	require.NoError(t, err)
+	require.True(t, ci.Hot.Enabled)
+}
+
+func TestBackfillCidRefs(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	iid := ffs.NewAPIID()
+	c := newCid("TestBackfillCidRefs")
+
+	// Simulate a Cid stored before instance references were tracked.
+	cfg := newCidConfig(c).WithColdFilRepair(true)
+	as := astore.New(txndstr.Wrap(ds, "astore"))
+	require.NoError(t, as.Put(ffs.NewJobID(), scheduler.Action{APIID: iid, Waddr: waddr, Cfg: cfg}))
+	cis := cistore.New(txndstr.Wrap(ds, "cistore"))
+	require.NoError(t, cis.Put(ffs.CidInfo{Cid: c}))
+
+	s, cls := newSchedulerFromDs(t, ds, newColdStorage("m1"))
+	defer cls()
+	require.Eventually(t, func() bool {
+		ci, err := s.GetCidInfo(c)
+		require.NoError(t, err)
+		return ci.Refs.HasCold(iid) && !ci.Refs.HasHot(iid)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestBackfillActionsCreated(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	iid := ffs.NewAPIID()
+	c := newCid("TestBackfillActionsCreated")
+
+	// Simulate Actions saved before their creation time was tracked,
+	// where the one of the latest Job disables repair.
+	js := jstore.New(txndstr.Wrap(ds, "jstore"))
+	as := astore.New(txndstr.Wrap(ds, "astore"))
+	cfg := newCidConfig(c).WithColdFilRepair(true)
+	actions := []struct {
+		cfg ffs.CidConfig
+		age time.Duration
+	}{
+		{cfg: cfg, age: 3 * time.Hour},
+		{cfg: cfg.WithColdFilRepair(false), age: time.Hour},
+		{cfg: cfg, age: 2 * time.Hour},
+	}
+	for _, a := range actions {
+		j := ffs.Job{
+			ID:      ffs.NewJobID(),
+			APIID:   iid,
+			Cid:     c,
+			Status:  ffs.Success,
+			Created: time.Now().Add(-a.age),
+		}
+		require.NoError(t, js.Put(j))
+		require.NoError(t, as.Put(j.ID, scheduler.Action{APIID: iid, Waddr: waddr, Cfg: a.cfg}))
+	}
+
+	_, cls := newSchedulerFromDs(t, ds, newColdStorage("m1"))
+	defer cls()
+	require.Eventually(t, func() bool {
+		undated, err := as.ListUndated()
+		require.NoError(t, err)
+		return len(undated) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	a, err := as.GetLatest(iid, c)
+	require.NoError(t, err)
+	require.False(t, a.Cfg.Cold.Filecoin.Repair.Enabled)
+	repairable, err := as.GetRepairable()
+	require.NoError(t, err)
+	require.Empty(t, repairable)
+}
+
+func TestFundsCheck(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1", "m2")
+	cs.block = make(chan struct{})
+	s, cls := newScheduler(t, cs)
+	defer cls()
+	fk := &fundsKeeper{available: 4000}
+	s.SetFundsKeeper(fk)
+
+	// Deals with m1 and m2 cost 1 and 2 attoFIL per epoch.
+	c := newCid("TestFundsCheck")
+	cfg := newCidConfig(c).WithColdFilRepFactor(2)
+	jid, err := s.PushConfig(ffs.NewAPIID(), waddr, cfg, 0)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return cs.storeCalls() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{3000}, fk.requested())
+
+	// The funds are released once the deals are made, since Lotus
+	// already escrowed them, so another Job paid by the same
+	// address can make deals while waiting for the first ones.
+	require.Zero(t, fk.reservedFunds())
+	c2 := newCid("TestFundsCheck2")
+	jid2, err := s.PushConfig(ffs.NewAPIID(), waddr, newCidConfig(c2), 0)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return cs.storeCalls() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{3000, 1000}, fk.requested())
+
+	close(cs.block)
+	requireJobStatus(t, s, jid, ffs.Success)
+	requireJobStatus(t, s, jid2, ffs.Success)
+	require.Zero(t, fk.reservedFunds())
+
+	// A Job whose deals cost more than the available
+	// funds fails before making them.
+	fk.setAvailable(2000)
+	c3 := newCid("TestFundsCheck3")
+	jid3, err := s.PushConfig(ffs.NewAPIID(), waddr, newCidConfig(c3).WithColdFilRepFactor(2), 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid3, ffs.Failed)
+	require.Equal(t, 2, cs.storeCalls())
+}
+
+func TestRenewalFundsCheck(t *testing.T) {
+	t.Parallel()
+	cs := newColdStorage("m1")
+	s, cls := newScheduler(t, cs)
+	defer cls()
+	fk := &fundsKeeper{available: 1000}
+	s.SetFundsKeeper(fk)
+
+	c := newCid("TestRenewalFundsCheck")
+	cfg := newCidConfig(c).WithColdFilRenew(true, 100)
+	jid, err := s.PushConfig(ffs.NewAPIID(), waddr, cfg, 0)
+	require.NoError(t, err)
+	requireJobStatus(t, s, jid, ffs.Success)
+	require.Eventually(t, func() bool {
+		return cs.renewCalls() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A renewal with m2 costs more than the available funds,
+	// so it shouldn't be attempted.
+	fk.setAvailable(0)
+	cs.lock.Lock()
+	cs.renewals = []ffs.MinerProposal{{Addr: "m2", EpochPrice: 2}}
+	cs.lock.Unlock()
+	time.Sleep(util.AvgBlockTime * 2)
+	renews := cs.renewCalls()
+	time.Sleep(util.AvgBlockTime * 10)
+	require.Equal(t, renews, cs.renewCalls())
+	require.Contains(t, fk.requested(), uint64(2000))
+
+	fk.setAvailable(2000)
+	require.Eventually(t, func() bool {
+		return cs.renewCalls() > renews
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func newScheduler(t *testing.T, cs ffs.ColdStorage, opts ...scheduler.Option) (*scheduler.Scheduler, func()) {
+	return newSchedulerFromDs(t, tests.NewTxMapDatastore(), cs, opts...)
+}
+
+func newSchedulerFromDs(t *testing.T, ds datastore.TxnDatastore, cs ffs.ColdStorage, opts ...scheduler.Option) (*scheduler.Scheduler, func()) {
+	js := jstore.New(txndstr.Wrap(ds, "jstore"))
+	as := astore.New(txndstr.Wrap(ds, "astore"))
+	cis := cistore.New(txndstr.Wrap(ds, "cistore"))
+	ss := sstore.New(txndstr.Wrap(ds, "sstore"))
+	l := cidlogger.New(txndstr.Wrap(ds, "logger"))
+	s, err := scheduler.New(js, as, cis, ss, l, newHotStorage(), cs, opts...)
+	require.NoError(t, err)
+	return s, func() {
+		require.NoError(t, s.Close())
+	}
+}
+
+func newCidConfig(c cid.Cid) ffs.CidConfig {
+	return ffs.CidConfig{
+		Cid: c,
+		Hot: ffs.HotConfig{
+			Ipfs: ffs.IpfsConfig{AddTimeout: 1},
+		},
+		Cold: ffs.ColdConfig{
+			Enabled: true,
+			Filecoin: ffs.FilConfig{
+				RepFactor:    1,
+				DealDuration: 1000,
+			},
+		},
+	}
+}
+
+func newCid(data string) cid.Cid {
+	return blocks.NewBlock([]byte(data)).Cid()
+}
+
+func requireJobStatus(t *testing.T, s *scheduler.Scheduler, jid ffs.JobID, status ffs.JobStatus) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		j, err := s.GetJob(jid)
+		require.NoError(t, err)
+		return j.Status == status
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func requireMiners(t *testing.T, s *scheduler.Scheduler, c cid.Cid, miners ...string) {
+	t.Helper()
+	require.True(t, equalMiners(t, s, c, miners...))
+}
+
+func equalMiners(t *testing.T, s *scheduler.Scheduler, c cid.Cid, miners ...string) bool {
+	ci, err := s.GetCidInfo(c)
+	require.NoError(t, err)
+	if len(ci.Cold.Filecoin.Proposals) != len(miners) {
+		return false
+	}
+	for i, p := range ci.Cold.Filecoin.Proposals {
+		if p.Miner != miners[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type hotStorage struct {
+	lock   sync.Mutex
+	stored map[cid.Cid]struct{}
+}
+
+var _ ffs.HotStorage = (*hotStorage)(nil)
+
+func newHotStorage() *hotStorage {
+	return &hotStorage{stored: make(map[cid.Cid]struct{})}
+}
+
+func (hs *hotStorage) Add(context.Context, io.Reader) (cid.Cid, error) {
+	return cid.Undef, fmt.Errorf("not implemented")
+}
+
+func (hs *hotStorage) Remove(_ context.Context, c cid.Cid) error {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	delete(hs.stored, c)
+	return nil
+}
+
+func (hs *hotStorage) Get(context.Context, cid.Cid) (io.Reader, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (hs *hotStorage) Store(_ context.Context, c cid.Cid) (ffs.DagStat, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	hs.stored[c] = struct{}{}
+	return ffs.DagStat{Size: 1024, NumBlocks: 1}, nil
+}
+
+func (hs *hotStorage) Replace(ctx context.Context, c1 cid.Cid, c2 cid.Cid) (ffs.DagStat, error) {
+	if err := hs.Remove(ctx, c1); err != nil {
+		return ffs.DagStat{}, err
+	}
+	return hs.Store(ctx, c2)
+}
+
+func (hs *hotStorage) Stat(context.Context, cid.Cid) (ffs.DagStat, error) {
+	return ffs.DagStat{Size: 1024, NumBlocks: 1}, nil
+}
+
+func (hs *hotStorage) Put(context.Context, blocks.Block) error {
+	return nil
+}
+
+func (hs *hotStorage) IsStored(_ context.Context, c cid.Cid) (bool, error) {
+	hs.lock.Lock()
+	defer hs.lock.Unlock()
+	_, ok := hs.stored[c]
+	return ok, nil
+}
+
+// coldStorage makes deals with miners in the provided order, skipping
+// excluded ones. Deals are active unless their miner was set inactive.
+type coldStorage struct {
+	lock     sync.Mutex
+	miners   []string
+	inactive map[string]struct{}
+	deals    map[cid.Cid]string
+	stores   int
+	// failStores is the number of next Store calls that fail.
+	failStores int
+	// block, if not nil, blocks WaitForDeals until receiving from it.
+	block  chan struct{}
+	stored []cid.Cid
+	// renewals are the miners returned by PlanRenewals.
+	renewals []ffs.MinerProposal
+	renews   int
+}
+
+var _ ffs.ColdStorage = (*coldStorage)(nil)
+
+func newColdStorage(miners ...string) *coldStorage {
+	return &coldStorage{
+		miners:   miners,
+		inactive: make(map[string]struct{}),
+		deals:    make(map[cid.Cid]string),
+	}
+}
+
+func (cs *coldStorage) setInactive(miner string) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.inactive[miner] = struct{}{}
+}
+
+func (cs *coldStorage) storedCids() []cid.Cid {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	res := make([]cid.Cid, len(cs.stored))
+	copy(res, cs.stored)
+	return res
+}
+
+func (cs *coldStorage) storeCalls() int {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.stores
+}
+
+func (cs *coldStorage) Store(ctx context.Context, c cid.Cid, size int, waddr string, cfg ffs.FilConfig) ([]ffs.FilProposal, error) {
+	mps, err := cs.PlanDeals(ctx, size, cfg)
+	if err != nil {
+		return nil, err
+	}
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.stores++
+	if cs.failStores > 0 {
+		cs.failStores--
+		return nil, fmt.Errorf("deal proposals rejected")
+	}
+	cs.stored = append(cs.stored, c)
+	res := make([]ffs.FilProposal, len(mps))
+	for i, mp := range mps {
+		pcid := newCid(fmt.Sprintf("%s-%s-%d", c, mp.Addr, len(cs.deals)))
+		cs.deals[pcid] = mp.Addr
+		res[i] = ffs.FilProposal{ProposalCid: pcid, Miner: mp.Addr, EpochPrice: mp.EpochPrice}
+	}
+	return res, nil
+}
+
+func (cs *coldStorage) PlanDeals(_ context.Context, _ int, cfg ffs.FilConfig) ([]ffs.MinerProposal, error) {
+	excluded := make(map[string]struct{}, len(cfg.ExcludedMiners))
+	for _, m := range cfg.ExcludedMiners {
+		excluded[m] = struct{}{}
+	}
+	var res []ffs.MinerProposal
+	for i, m := range cs.miners {
+		if len(res) == cfg.RepFactor {
+			break
+		}
+		if _, ok := excluded[m]; ok {
+			continue
+		}
+		res = append(res, ffs.MinerProposal{Addr: m, EpochPrice: uint64(i + 1)})
+	}
+	if len(res) < cfg.RepFactor {
+		return nil, fmt.Errorf("not enough miners, want %d and got %d", cfg.RepFactor, len(res))
+	}
+	return res, nil
+}
+
+func (cs *coldStorage) WaitForDeals(ctx context.Context, c cid.Cid, props []ffs.FilProposal, duration int64) (ffs.FilInfo, error) {
+	if cs.block != nil {
+		select {
+		case <-cs.block:
+		case <-ctx.Done():
+			return ffs.FilInfo{}, ctx.Err()
+		}
+	}
+	res := ffs.FilInfo{DataCid: c}
+	for _, p := range props {
+		res.Proposals = append(res.Proposals, ffs.FilStorage{
+			ProposalCid: p.ProposalCid,
+			Duration:    duration,
+			Miner:       p.Miner,
+			EpochPrice:  p.EpochPrice,
+			TotalCost:   p.EpochPrice * uint64(duration),
+		})
+	}
+	return res, nil
+}
+
+func (cs *coldStorage) Retrieve(context.Context, cid.Cid, car.Store, string) (cid.Cid, error) {
+	return cid.Undef, fmt.Errorf("not implemented")
+}
+
+func (cs *coldStorage) RetrieveCAR(context.Context, cid.Cid, cid.Cid, string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (cs *coldStorage) EnsureRenewals(_ context.Context, _ cid.Cid, inf ffs.FilInfo, _ string, _ ffs.FilConfig) (ffs.FilInfo, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	cs.renews++
+	return inf, nil
+}
+
+func (cs *coldStorage) PlanRenewals(context.Context, ffs.FilInfo, ffs.FilConfig) ([]ffs.MinerProposal, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.renewals, nil
+}
+
+func (cs *coldStorage) renewCalls() int {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.renews
+}
+
+func (cs *coldStorage) IsFilDealActive(_ context.Context, pcid cid.Cid) (bool, error) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	_, inactive := cs.inactive[cs.deals[pcid]]
+	return !inactive, nil
+}
+
+// fundsKeeper has a fixed amount of available funds for any address.
+type fundsKeeper struct {
+	lock      sync.Mutex
+	available uint64
+	reserved  uint64
+	requests  []uint64
+}
+
+var _ ffs.FundsKeeper = (*fundsKeeper)(nil)
+
+func (fk *fundsKeeper) EnsureFunds(_ context.Context, _ ffs.APIID, _ string, amount uint64) (func(), error) {
+	fk.lock.Lock()
+	defer fk.lock.Unlock()
+	fk.requests = append(fk.requests, amount)
+	if fk.reserved+amount > fk.available {
+		return nil, ffs.ErrInsufficientFunds
+	}
+	fk.reserved += amount
+	return func() {
+		fk.lock.Lock()
+		defer fk.lock.Unlock()
+		fk.reserved -= amount
+	}, nil
+}
+
+func (fk *fundsKeeper) setAvailable(amount uint64) {
+	fk.lock.Lock()
+	defer fk.lock.Unlock()
+	fk.available = amount
+}
+
+func (fk *fundsKeeper) requested() []uint64 {
+	fk.lock.Lock()
+	defer fk.lock.Unlock()
+	res := make([]uint64, len(fk.requests))
+	copy(res, fk.requests)
+	return res
+}
+
+func (fk *fundsKeeper) reservedFunds() uint64 {
+	fk.lock.Lock()
+	defer fk.lock.Unlock()
+	return fk.reserved
+}
@@ -0,0 +1,170 @@
+package sstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/ffs/scheduler"
+)
+
+var (
+	log = logging.Logger("ffs-sched-sstore")
+
+	dsRecord = datastore.NewKey("record")
+	dsTotal  = datastore.NewKey("total")
+)
+
+// Store is a Datastore implementation of SpendStore.
+type Store struct {
+	lock sync.Mutex
+	ds   datastore.Datastore
+}
+
+var _ scheduler.SpendStore = (*Store)(nil)
+
+// totals is the persisted form of ffs.SpendTotals.
+type totals struct {
+	Total uint64
+	ByCid map[string]uint64
+}
+
+// New returns a new SpendStore backed by the Datastore.
+func New(ds datastore.Datastore) *Store {
+	return &Store{
+		ds: ds,
+	}
+}
+
+// Add records the cost of a deal, and updates the running totals of
+// the instance and Cid. Adding a deal that was already recorded is a noop.
+func (s *Store) Add(r ffs.SpendRecord) error {
+	if !r.ProposalCid.Defined() {
+		return fmt.Errorf("proposal cid can't be undefined")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := makeRecordKey(r.APIID, r.ProposalCid)
+	exists, err := s.ds.Has(key)
+	if err != nil {
+		return fmt.Errorf("checking if spend record exists: %s", err)
+	}
+	if exists {
+		return nil
+	}
+	t, err := s.getTotals(r.APIID)
+	if err != nil {
+		return err
+	}
+	t.Total += r.Amount
+	t.ByCid[r.Cid.String()] += r.Amount
+
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("marshaling spend record for datastore: %s", err)
+	}
+	if err := s.ds.Put(key, buf); err != nil {
+		return fmt.Errorf("put spend record in datastore: %s", err)
+	}
+	buf, err = json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("marshaling spend totals for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeTotalKey(r.APIID), buf); err != nil {
+		return fmt.Errorf("put spend totals in datastore: %s", err)
+	}
+	return nil
+}
+
+// List returns records selected by a filter, ordered by time.
+func (s *Store) List(f ffs.SpendFilter) ([]ffs.SpendRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	prefix := dsRecord
+	if f.APIID != ffs.EmptyInstanceID {
+		prefix = prefix.ChildString(f.APIID.String())
+	}
+	res, err := s.ds.Query(query.Query{Prefix: prefix.String()})
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var rs []ffs.SpendRecord
+	for e := range res.Next() {
+		if e.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", e.Error)
+		}
+		var r ffs.SpendRecord
+		if err := json.Unmarshal(e.Value, &r); err != nil {
+			return nil, fmt.Errorf("unmarshaling spend record from datastore: %s", err)
+		}
+		if f.Match(r) {
+			rs = append(rs, r)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Time.Before(rs[j].Time)
+	})
+	return rs, nil
+}
+
+// Totals returns the running spend totals of an instance.
+func (s *Store) Totals(iid ffs.APIID) (ffs.SpendTotals, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	t, err := s.getTotals(iid)
+	if err != nil {
+		return ffs.SpendTotals{}, err
+	}
+	res := ffs.SpendTotals{
+		Total: t.Total,
+		ByCid: make(map[cid.Cid]uint64, len(t.ByCid)),
+	}
+	for k, v := range t.ByCid {
+		c, err := cid.Decode(k)
+		if err != nil {
+			return ffs.SpendTotals{}, fmt.Errorf("decoding cid %s: %s", k, err)
+		}
+		res.ByCid[c] = v
+	}
+	return res, nil
+}
+
+func (s *Store) getTotals(iid ffs.APIID) (totals, error) {
+	t := totals{ByCid: make(map[string]uint64)}
+	buf, err := s.ds.Get(makeTotalKey(iid))
+	if err == datastore.ErrNotFound {
+		return t, nil
+	}
+	if err != nil {
+		return totals{}, fmt.Errorf("getting spend totals from datastore: %s", err)
+	}
+	if err := json.Unmarshal(buf, &t); err != nil {
+		return totals{}, fmt.Errorf("unmarshaling spend totals from datastore: %s", err)
+	}
+	if t.ByCid == nil {
+		t.ByCid = make(map[string]uint64)
+	}
+	return t, nil
+}
+
+func makeRecordKey(iid ffs.APIID, proposalCid cid.Cid) datastore.Key {
+	return dsRecord.ChildString(iid.String()).ChildString(proposalCid.String())
+}
+
+func makeTotalKey(iid ffs.APIID) datastore.Key {
+	return dsTotal.ChildString(iid.String())
+}
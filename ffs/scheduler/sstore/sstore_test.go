@@ -0,0 +1,133 @@
+package sstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/powergate/tests"
+)
+
+func TestAddDedupe(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	c := newCid(t, "data")
+	r := ffs.SpendRecord{
+		APIID:       iid1,
+		Cid:         c,
+		ProposalCid: newCid(t, "proposal"),
+		Miner:       "t01000",
+		Amount:      100,
+		Time:        time.Now(),
+	}
+	require.NoError(t, s.Add(r))
+	require.NoError(t, s.Add(r))
+
+	rs, err := s.List(ffs.SpendFilter{APIID: iid1})
+	require.NoError(t, err)
+	require.Len(t, rs, 1)
+	totals, err := s.Totals(iid1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), totals.Total)
+	require.Equal(t, uint64(100), totals.ByCid[c])
+
+	// The same deal is a separate spend of another instance.
+	r.APIID = iid2
+	require.NoError(t, s.Add(r))
+	rs, err = s.List(ffs.SpendFilter{})
+	require.NoError(t, err)
+	require.Len(t, rs, 2)
+	totals, err = s.Totals(iid2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), totals.Total)
+
+	r.ProposalCid = cid.Undef
+	require.Error(t, s.Add(r))
+}
+
+func TestTotals(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	iid := ffs.NewAPIID()
+	totals, err := s.Totals(iid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), totals.Total)
+	require.Empty(t, totals.ByCid)
+
+	c1, c2 := newCid(t, "data1"), newCid(t, "data2")
+	add(t, s, iid, c1, "t01000", 10, time.Now())
+	add(t, s, iid, c1, "t01001", 20, time.Now())
+	add(t, s, iid, c2, "t01000", 30, time.Now())
+
+	totals, err = s.Totals(iid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), totals.Total)
+	require.Equal(t, map[cid.Cid]uint64{c1: 30, c2: 30}, totals.ByCid)
+}
+
+func TestListFilters(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	c1, c2 := newCid(t, "data1"), newCid(t, "data2")
+	base := time.Now()
+	// Added out of time order to check List sorts by time.
+	r3 := add(t, s, iid1, c2, "t01001", 30, base.Add(2*time.Hour))
+	r1 := add(t, s, iid1, c1, "t01000", 10, base)
+	r2 := add(t, s, iid1, c1, "t01001", 20, base.Add(time.Hour))
+	r4 := add(t, s, iid2, c1, "t01000", 40, base.Add(3*time.Hour))
+
+	cases := []struct {
+		name   string
+		filter ffs.SpendFilter
+		want   []ffs.SpendRecord
+	}{
+		{name: "All", filter: ffs.SpendFilter{}, want: []ffs.SpendRecord{r1, r2, r3, r4}},
+		{name: "Instance", filter: ffs.SpendFilter{APIID: iid1}, want: []ffs.SpendRecord{r1, r2, r3}},
+		{name: "Cid", filter: ffs.SpendFilter{APIID: iid1, Cid: c1}, want: []ffs.SpendRecord{r1, r2}},
+		{name: "Miner", filter: ffs.SpendFilter{Miner: "t01000"}, want: []ffs.SpendRecord{r1, r4}},
+		{name: "From", filter: ffs.SpendFilter{From: base.Add(time.Hour)}, want: []ffs.SpendRecord{r2, r3, r4}},
+		{name: "To", filter: ffs.SpendFilter{To: base.Add(time.Hour)}, want: []ffs.SpendRecord{r1}},
+		{name: "Range", filter: ffs.SpendFilter{From: base.Add(time.Hour), To: base.Add(3 * time.Hour)}, want: []ffs.SpendRecord{r2, r3}},
+		{name: "Empty", filter: ffs.SpendFilter{From: base.Add(4 * time.Hour)}, want: nil},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rs, err := s.List(tt.filter)
+			require.NoError(t, err)
+			require.Len(t, rs, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].ProposalCid, rs[i].ProposalCid)
+				require.Equal(t, tt.want[i].APIID, rs[i].APIID)
+				require.Equal(t, tt.want[i].Amount, rs[i].Amount)
+			}
+		})
+	}
+}
+
+func add(t *testing.T, s *Store, iid ffs.APIID, c cid.Cid, miner string, amount uint64, tm time.Time) ffs.SpendRecord {
+	r := ffs.SpendRecord{
+		APIID:       iid,
+		Cid:         c,
+		ProposalCid: newCid(t, iid.String()+c.String()+miner),
+		Miner:       miner,
+		Amount:      amount,
+		Time:        tm,
+	}
+	require.NoError(t, s.Add(r))
+	return r
+}
+
+func newCid(t *testing.T, data string) cid.Cid {
+	h, err := multihash.Sum([]byte(data), multihash.SHA2_256, -1)
+	require.NoError(t, err)
+	return cid.NewCidV1(cid.Raw, h)
+}
@@ -3,6 +3,8 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/ffs"
@@ -21,8 +23,19 @@ type JobStore interface {
 	Get(ffs.JobID) (ffs.Job, error)
 	// GetByStatus returns jobs with a particular status.
 	GetByStatus(ffs.JobStatus) ([]ffs.Job, error)
+	// List returns jobs selected by a filter, ordered by creation time.
+	List(ffs.JobFilter) ([]ffs.Job, error)
+	// RemoveFinished removes jobs that finished before a time, and
+	// returns how many were removed.
+	RemoveFinished(time.Time) (int, error)
 	// Watch subscribes to all job state changes within an instance.
 	Watch(context.Context, chan<- ffs.Job, ffs.APIID) error
+	// PutStartedDeals saves in-flight deal proposals made by a Job.
+	PutStartedDeals(ffs.JobID, []ffs.FilProposal) error
+	// GetStartedDeals returns in-flight deal proposals made by a Job.
+	GetStartedDeals(ffs.JobID) ([]ffs.FilProposal, error)
+	// RemoveStartedDeals removes in-flight deal proposals made by a Job.
+	RemoveStartedDeals(ffs.JobID) error
 }
 
 // Action represents an action to be executed by the Scheduler.
@@ -31,6 +44,7 @@ type Action struct {
 	Waddr       string
 	Cfg         ffs.CidConfig
 	ReplacedCid cid.Cid
+	Created     time.Time
 }
 
 // ActionStore persist actions for Cids.
@@ -39,11 +53,22 @@ type ActionStore interface {
 	Put(ffs.JobID, Action) error
 	// Get returns the current state of a Job.
 	Get(ffs.JobID) (Action, error)
-	// Remove removes the action associated with a Cid.
-	Remove(cid.Cid) error
-	// GetRenewable returns the known pushed configs that have enabled
+	// GetLatest returns the latest pushed Action of a Cid by an instance.
+	GetLatest(ffs.APIID, cid.Cid) (Action, error)
+	// ListLatest returns the latest pushed Action of every Cid by
+	// every instance.
+	ListLatest() ([]Action, error)
+	// ListUndated returns the Actions saved without a creation time,
+	// by the JobID they were saved with.
+	ListUndated() (map[ffs.JobID]Action, error)
+	// Remove removes the actions of a Cid pushed by an instance.
+	Remove(ffs.APIID, cid.Cid) error
+	// GetRenewable returns the latest pushed configs that have enabled
 	// renew Filecoin flag for their deals.
 	GetRenewable() ([]Action, error)
+	// GetRepairable returns the latest pushed configs that have enabled
+	// repair Filecoin flag for their deals.
+	GetRepairable() ([]Action, error)
 }
 
 // CidInfoStore persists CidInfo which represent the current storage
@@ -51,4 +76,60 @@ type ActionStore interface {
 type CidInfoStore interface {
 	Put(ffs.CidInfo) error
 	Get(cid.Cid) (ffs.CidInfo, error)
+	// AddFailedMiners records miners that had deals of a Cid which
+	// aren't active anymore.
+	AddFailedMiners(cid.Cid, []string) error
+	// GetFailedMiners returns the miners that had deals of a Cid which
+	// aren't active anymore.
+	GetFailedMiners(cid.Cid) ([]string, error)
+}
+
+// SpendStore persists the costs of active deals, and keeps running
+// spend totals for each instance and Cid.
+type SpendStore interface {
+	// Add records the cost of a deal. Adding a deal that was
+	// already recorded is a noop.
+	Add(ffs.SpendRecord) error
+	// List returns records selected by a filter, ordered by time.
+	List(ffs.SpendFilter) ([]ffs.SpendRecord, error)
+	// Totals returns the running spend totals of an instance.
+	Totals(ffs.APIID) (ffs.SpendTotals, error)
+}
+
+// Config contains configuration for the Scheduler.
+type Config struct {
+	// MaxParallel is the maximum number of Jobs that can be executed
+	// concurrently.
+	MaxParallel int
+	// JobRetention is how long finished Jobs are kept before being
+	// removed. Zero means they're kept forever.
+	JobRetention time.Duration
+}
+
+// Option sets values on a Config.
+type Option func(*Config) error
+
+// WithMaxParallel indicates the maximum number of Jobs that the
+// Scheduler will execute concurrently. Jobs for the same Cid are
+// always executed sequentially.
+func WithMaxParallel(maxParallel int) Option {
+	return func(c *Config) error {
+		if maxParallel <= 0 {
+			return fmt.Errorf("max parallel should be greater than zero, got %d", maxParallel)
+		}
+		c.MaxParallel = maxParallel
+		return nil
+	}
+}
+
+// WithJobRetention indicates how long finished Jobs are kept in the
+// JobStore before being removed. Zero means they're kept forever.
+func WithJobRetention(retention time.Duration) Option {
+	return func(c *Config) error {
+		if retention < 0 {
+			return fmt.Errorf("job retention can't be negative, got %s", retention)
+		}
+		c.JobRetention = retention
+		return nil
+	}
 }
@@ -3,6 +3,7 @@ package ffs
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -75,14 +76,107 @@ const (
 type Job struct {
 	ID       JobID
 	APIID    APIID
+	Cid      cid.Cid
 	Status   JobStatus
 	ErrCause string
+	Created  time.Time
+	// Started is the time when the last attempt of the Job
+	// started executing. It's zero if the Job never started.
+	Started time.Time
+	// Finished is the time when the Job reached a final
+	// status. It's zero if the Job isn't finished.
+	Finished time.Time
+	// Attempts is the number of times the Job started
+	// executing.
+	Attempts int
+	// NextRetry is the time when a failed Job will be
+	// retried. It's zero if the Job isn't waiting for a retry.
+	NextRetry time.Time
+	// Priority orders the execution of queued Jobs of the same
+	// instance. Jobs with higher priority are executed first.
+	Priority int
+}
+
+// IsFinal returns true if the JobStatus is final, so the
+// Job won't be executed anymore.
+func (s JobStatus) IsFinal() bool {
+	return s == Failed || s == Canceled || s == Success
+}
+
+// JobFilter selects Jobs when listing them.
+type JobFilter struct {
+	// APIID selects Jobs of an instance. If empty, Jobs of
+	// all instances are selected.
+	APIID APIID
+	// Cid selects Jobs of a Cid. If undefined, Jobs of any Cid
+	// are selected.
+	Cid cid.Cid
+	// Statuses selects Jobs with any of the statuses. If empty,
+	// Jobs with any status are selected.
+	Statuses []JobStatus
+	// CreatedFrom selects Jobs created at or after this time,
+	// if isn't zero.
+	CreatedFrom time.Time
+	// CreatedTo selects Jobs created before this time, if isn't
+	// zero.
+	CreatedTo time.Time
+	// Offset is the number of selected Jobs to skip, ordered by
+	// creation time.
+	Offset int
+	// Limit is the maximum number of Jobs to return. Zero means
+	// there's no limit.
+	Limit int
+}
+
+// Match returns true if the Job is selected by the filter,
+// without considering pagination.
+func (f JobFilter) Match(j Job) bool {
+	if f.APIID != EmptyInstanceID && f.APIID != j.APIID {
+		return false
+	}
+	if f.Cid.Defined() && f.Cid != j.Cid {
+		return false
+	}
+	if !f.CreatedFrom.IsZero() && j.Created.Before(f.CreatedFrom) {
+		return false
+	}
+	if !f.CreatedTo.IsZero() && !j.Created.Before(f.CreatedTo) {
+		return false
+	}
+	if len(f.Statuses) == 0 {
+		return true
+	}
+	for _, s := range f.Statuses {
+		if s == j.Status {
+			return true
+		}
+	}
+	return false
 }
 
+// FailureClass classifies the cause of a Job failure.
+type FailureClass int
+
+const (
+	// FailureInternal indicates the Job failed due to an internal
+	// error, e.g: reading or saving state.
+	FailureInternal FailureClass = iota
+	// FailureHotStorage indicates the Job failed ensuring the Hot Storage
+	// configuration, e.g: data couldn't be fetched from the IPFS network.
+	FailureHotStorage
+	// FailureUnfreeze indicates the Job failed retrieving data from the Cold
+	// Storage to feed the Hot Storage, e.g: no providers were available.
+	FailureUnfreeze
+	// FailureColdStorage indicates the Job failed ensuring the Cold Storage
+	// configuration, e.g: miners rejected deal proposals.
+	FailureColdStorage
+)
+
 // DefaultCidConfig contains a default Cid configuration for an Api.
 type DefaultCidConfig struct {
-	Hot  HotConfig
-	Cold ColdConfig
+	Hot   HotConfig
+	Cold  ColdConfig
+	Retry RetryConfig
 }
 
 // Validate validates a default Cid configuration.
@@ -93,6 +187,9 @@ func (dc DefaultCidConfig) Validate() error {
 	if err := dc.Cold.Validate(); err != nil {
 		return err
 	}
+	if err := dc.Retry.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -105,6 +202,8 @@ type CidConfig struct {
 	Hot HotConfig
 	// Cold has desired storing configuration in the Cold Storage.
 	Cold ColdConfig
+	// Retry is the retry policy for failed Jobs of the Cid.
+	Retry RetryConfig
 }
 
 // WithColdEnabled allows to enable/disable Cold storage usage.
@@ -129,6 +228,26 @@ func (c CidConfig) WithColdFilExcludedMiners(miners []string) CidConfig {
 	return c
 }
 
+// WithColdFilTrustedMiners defines a list of miner addresses which are the only ones
+// that can be selected for making deals.
+func (c CidConfig) WithColdFilTrustedMiners(miners []string) CidConfig {
+	c.Cold.Filecoin.TrustedMiners = make([]string, len(miners))
+	copy(c.Cold.Filecoin.TrustedMiners, miners)
+	return c
+}
+
+// WithColdFilMaxPrice defines the maximum price per epoch of selected miners.
+func (c CidConfig) WithColdFilMaxPrice(maxPrice uint64) CidConfig {
+	c.Cold.Filecoin.MaxPrice = maxPrice
+	return c
+}
+
+// WithColdFilMinScore defines the minimum reputation score of selected miners.
+func (c CidConfig) WithColdFilMinScore(minScore int) CidConfig {
+	c.Cold.Filecoin.MinScore = minScore
+	return c
+}
+
 // WithColdFilRepFactor defines the replication factor for Filecoin storage.
 func (c CidConfig) WithColdFilRepFactor(repFactor int) CidConfig {
 	c.Cold.Filecoin.RepFactor = repFactor
@@ -141,6 +260,13 @@ func (c CidConfig) WithColdFilDealDuration(duration int64) CidConfig {
 	return c
 }
 
+// WithColdAddr specifies the wallet address that pays for deals and
+// retrievals. It should be an address of the instance.
+func (c CidConfig) WithColdAddr(addr string) CidConfig {
+	c.Cold.Filecoin.Addr = addr
+	return c
+}
+
 // WithColdFilRenew specifies if deals should be renewed before they expire with a particular
 // threshold chain epochs.
 func (c CidConfig) WithColdFilRenew(enabled bool, threshold int) CidConfig {
@@ -149,6 +275,39 @@ func (c CidConfig) WithColdFilRenew(enabled bool, threshold int) CidConfig {
 	return c
 }
 
+// WithColdFilRenewStrategy specifies how the miner of renewed deals
+// is selected.
+func (c CidConfig) WithColdFilRenewStrategy(s RenewStrategy) CidConfig {
+	c.Cold.Filecoin.Renew.Strategy = s
+	return c
+}
+
+// WithColdFilRenewMaxPrice specifies the maximum epoch price of renewed deals,
+// and the maximum percentage increase of the epoch price compared to the
+// renewed deal. Zero values mean there's no limit.
+func (c CidConfig) WithColdFilRenewMaxPrice(maxPrice uint64, maxPriceIncrease int) CidConfig {
+	c.Cold.Filecoin.Renew.MaxPrice = maxPrice
+	c.Cold.Filecoin.Renew.MaxPriceIncrease = maxPriceIncrease
+	return c
+}
+
+// WithColdFilRepair specifies if the Scheduler should make new deals when the number
+// of active deals falls below the desired replication factor.
+func (c CidConfig) WithColdFilRepair(enabled bool) CidConfig {
+	c.Cold.Filecoin.Repair.Enabled = enabled
+	return c
+}
+
+// WithRetry specifies the retry policy for failed Jobs.
+func (c CidConfig) WithRetry(maxAttempts, backoff, maxBackoff int, classes ...FailureClass) CidConfig {
+	c.Retry.MaxAttempts = maxAttempts
+	c.Retry.Backoff = backoff
+	c.Retry.MaxBackoff = maxBackoff
+	c.Retry.FailureClasses = make([]FailureClass, len(classes))
+	copy(c.Retry.FailureClasses, classes)
+	return c
+}
+
 // WithHotEnabled allows to enable/disable Hot storage usage.
 func (c CidConfig) WithHotEnabled(enabled bool) CidConfig {
 	c.Hot.Enabled = enabled
@@ -179,9 +338,82 @@ func (c CidConfig) Validate() error {
 	if err := c.Cold.Validate(); err != nil {
 		return fmt.Errorf("cold-filecoin config is invalid: %s", err)
 	}
+	if err := c.Retry.Validate(); err != nil {
+		return fmt.Errorf("retry config is invalid: %s", err)
+	}
 	return nil
 }
 
+// RetryConfig is the retry policy for failed Jobs of a Cid.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times a Job is executed,
+	// including the first one. Zero or one means failed Jobs aren't
+	// retried.
+	MaxAttempts int
+	// Backoff is the number of seconds to wait before the first retry,
+	// which is doubled for every further retry.
+	Backoff int
+	// MaxBackoff is the maximum number of seconds to wait between
+	// retries. Zero means there's no limit.
+	MaxBackoff int
+	// FailureClasses are the failure classes which are retried. If empty,
+	// failures of any class are retried.
+	FailureClasses []FailureClass
+}
+
+// Validate validates a RetryConfig.
+func (rc RetryConfig) Validate() error {
+	if rc.MaxAttempts < 0 {
+		return fmt.Errorf("max attempts can't be negative, got %d", rc.MaxAttempts)
+	}
+	if rc.Backoff < 0 {
+		return fmt.Errorf("backoff can't be negative, got %d", rc.Backoff)
+	}
+	if rc.MaxBackoff < 0 {
+		return fmt.Errorf("max backoff can't be negative, got %d", rc.MaxBackoff)
+	}
+	for _, fc := range rc.FailureClasses {
+		if fc < FailureInternal || fc > FailureColdStorage {
+			return fmt.Errorf("unknown failure class %d", fc)
+		}
+	}
+	return nil
+}
+
+// ShouldRetry returns true if a Job that failed with a failure class
+// after a number of attempts should be retried.
+func (rc RetryConfig) ShouldRetry(attempts int, class FailureClass) bool {
+	if attempts >= rc.MaxAttempts {
+		return false
+	}
+	if len(rc.FailureClasses) == 0 {
+		return true
+	}
+	for _, fc := range rc.FailureClasses {
+		if fc == class {
+			return true
+		}
+	}
+	return false
+}
+
+// NextBackoff returns the time to wait before retrying a Job
+// that failed after a number of attempts.
+func (rc RetryConfig) NextBackoff(attempts int) time.Duration {
+	b := time.Duration(rc.Backoff) * time.Second
+	maxb := time.Duration(rc.MaxBackoff) * time.Second
+	for i := 1; i < attempts; i++ {
+		if (rc.MaxBackoff > 0 && b >= maxb) || b > math.MaxInt64/2 {
+			break
+		}
+		b *= 2
+	}
+	if rc.MaxBackoff > 0 && b > maxb {
+		b = maxb
+	}
+	return b
+}
+
 // HotConfig is the desired storage of a Cid in a Hot Storage.
 type HotConfig struct {
 	// Enable indicates if Cid data is stored. If true, it will consider
@@ -250,8 +482,22 @@ type FilConfig struct {
 	// CountryCodes indicates that new deals should select miners on specific
 	// countries.
 	CountryCodes []string
+	// TrustedMiners indicates that new deals should only select miners from
+	// this list. An empty list means any miner can be selected.
+	TrustedMiners []string
+	// MaxPrice is the maximum price in attoFIL per epoch that selected miners
+	// can ask. Zero means there's no maximum price.
+	MaxPrice uint64
+	// MinScore is the minimum reputation score that selected miners
+	// should have. Zero means there's no minimum score.
+	MinScore int
+	// Addr is the wallet address of the instance that pays for deals
+	// and retrievals. Empty means the instance default address.
+	Addr string
 	// FilRenew indicates deal-renewal configuration.
 	Renew FilRenew
+	// Repair indicates deal-repair configuration.
+	Repair FilRepair
 }
 
 // FilRenew contains renew configuration for a Cid Cold Storage deals.
@@ -261,6 +507,53 @@ type FilRenew struct {
 	// Threshold indicates how many epochs before expiring should trigger
 	// deal renewal. e.g: 100 epoch before expiring.
 	Threshold int
+	// Strategy indicates how the miner of a renewed deal is selected.
+	Strategy RenewStrategy
+	// MaxPrice is the maximum epoch price of a renewed deal. Zero
+	// means there's no maximum price.
+	MaxPrice uint64
+	// MaxPriceIncrease is the maximum percentage increase of the epoch
+	// price of a renewed deal compared to the expiring one, e.g: 10 for
+	// at most 10% more expensive. Zero means there's no limit.
+	MaxPriceIncrease int
+}
+
+// RenewStrategy indicates how the miner of a renewed deal is selected.
+// The FilConfig miner filters are always respected.
+type RenewStrategy int
+
+const (
+	// RenewNewMiner runs the full miner selection, excluding miners
+	// with active deals.
+	RenewNewMiner RenewStrategy = iota
+	// RenewSameMiner prefers renewing the deal with the same miner,
+	// falling back to the full selection if it isn't possible.
+	RenewSameMiner
+	// RenewCheapest prefers the cheapest miner, which can be the
+	// miner of the expiring deal.
+	RenewCheapest
+)
+
+var renewStrategyNames = map[RenewStrategy]string{
+	RenewNewMiner:  "new-miner",
+	RenewSameMiner: "same-miner",
+	RenewCheapest:  "cheapest",
+}
+
+// String returns a human readable name of the strategy.
+func (s RenewStrategy) String() string {
+	if name, ok := renewStrategyNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// FilRepair contains repair configuration for a Cid Cold Storage deals.
+type FilRepair struct {
+	// Enabled indicates that the Scheduler should make new deals
+	// if active deals of the Cid fall below the desired RepFactor,
+	// e.g: a miner was slashed or a deal expired without renewal.
+	Enabled bool
 }
 
 // Validate returns a non-nil error if the configuration is invalid.
@@ -274,6 +567,18 @@ func (fc *FilConfig) Validate() error {
 	if fc.Renew.Enabled && fc.Renew.Threshold <= 0 {
 		return fmt.Errorf("renew threshold should be positive: %d", fc.Renew.Threshold)
 	}
+	if _, ok := renewStrategyNames[fc.Renew.Strategy]; !ok {
+		return fmt.Errorf("unknown renew strategy %d", fc.Renew.Strategy)
+	}
+	if fc.Renew.MaxPriceIncrease < 0 {
+		return fmt.Errorf("renew max price increase can't be negative, got %d", fc.Renew.MaxPriceIncrease)
+	}
+	if fc.MinScore < 0 {
+		return fmt.Errorf("min score can't be negative, got %d", fc.MinScore)
+	}
+	if len(fc.TrustedMiners) > 0 && len(fc.TrustedMiners) < fc.RepFactor {
+		return fmt.Errorf("there are less trusted miners than the replication factor")
+	}
 	return nil
 }
 
@@ -285,14 +590,78 @@ type CidInfo struct {
 	Created time.Time
 	Hot     HotInfo
 	Cold    ColdInfo
+	Refs    CidRefs
+}
+
+// CidRefs contains the instances that need a Cid stored in
+// each storage layer.
+type CidRefs struct {
+	Hot  []APIID
+	Cold []APIID
+}
+
+// Update returns the references after an instance enabled or
+// disabled the Hot and Cold storages for the Cid.
+func (r CidRefs) Update(iid APIID, hot, cold bool) CidRefs {
+	return CidRefs{
+		Hot:  updateRefs(r.Hot, iid, hot),
+		Cold: updateRefs(r.Cold, iid, cold),
+	}
+}
+
+// HasHot returns true if the instance needs the Cid stored
+// in the Hot Storage.
+func (r CidRefs) HasHot(iid APIID) bool {
+	for _, ref := range r.Hot {
+		if ref == iid {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCold returns true if the instance needs the Cid stored
+// in the Cold Storage.
+func (r CidRefs) HasCold(iid APIID) bool {
+	for _, ref := range r.Cold {
+		if ref == iid {
+			return true
+		}
+	}
+	return false
+}
+
+func updateRefs(refs []APIID, iid APIID, enabled bool) []APIID {
+	res := make([]APIID, 0, len(refs)+1)
+	for _, ref := range refs {
+		if ref != iid {
+			res = append(res, ref)
+		}
+	}
+	if enabled {
+		res = append(res, iid)
+	}
+	return res
 }
 
 // HotInfo contains information about the current storage state
 // of a Cid in the hot layer.
 type HotInfo struct {
 	Enabled bool
-	Size    int
-	Ipfs    IpfsHotInfo
+	// Size is the total size in bytes of the stored DAG.
+	Size int
+	// NumBlocks is the number of blocks of the stored DAG.
+	NumBlocks int
+	Ipfs      IpfsHotInfo
+}
+
+// DagStat contains size information of a DAG.
+type DagStat struct {
+	// Size is the total size in bytes of the unique
+	// blocks of the DAG.
+	Size int
+	// NumBlocks is the number of unique blocks of the DAG.
+	NumBlocks int
 }
 
 // IpfsHotInfo contains information about the current storage state
@@ -310,7 +679,10 @@ type ColdInfo struct {
 // FilInfo contains information about the current storage state
 // of a Cid in the Filecoin network.
 type FilInfo struct {
-	DataCid   cid.Cid
+	DataCid cid.Cid
+	// Size is the size in bytes of the DAG stored in
+	// Filecoin deals.
+	Size      int
 	Proposals []FilStorage
 }
 
@@ -321,6 +693,145 @@ type FilStorage struct {
 	Duration        int64
 	ActivationEpoch int64
 	Miner           string
+	// EpochPrice is the price in attoFIL per epoch of the deal.
+	EpochPrice uint64
+	// PieceSize is the size in bytes of the piece stored in the deal.
+	PieceSize uint64
+	// TotalCost is the attoFIL committed to the deal for its
+	// whole duration.
+	TotalCost uint64
+}
+
+// SpendRecord is the cost of an active deal made for a Cid on
+// behalf of an instance.
+type SpendRecord struct {
+	APIID       APIID
+	Cid         cid.Cid
+	ProposalCid cid.Cid
+	Miner       string
+	Amount      uint64
+	Time        time.Time
+}
+
+// SpendTotals are running spend totals of an instance.
+type SpendTotals struct {
+	Total uint64
+	ByCid map[cid.Cid]uint64
+}
+
+// SpendFilter selects SpendRecords when listing them.
+type SpendFilter struct {
+	// APIID selects spends of an instance. If empty, spends of
+	// all instances are selected.
+	APIID APIID
+	// Cid selects spends of a Cid. If undefined, spends of any
+	// Cid are selected.
+	Cid cid.Cid
+	// Miner selects spends in deals with a miner. If empty, spends
+	// with any miner are selected.
+	Miner string
+	// From selects spends recorded at or after this time, if
+	// isn't zero.
+	From time.Time
+	// To selects spends recorded before this time, if isn't zero.
+	To time.Time
+}
+
+// Match returns true if the SpendRecord is selected by the filter.
+func (f SpendFilter) Match(r SpendRecord) bool {
+	if f.APIID != EmptyInstanceID && f.APIID != r.APIID {
+		return false
+	}
+	if f.Cid.Defined() && f.Cid != r.Cid {
+		return false
+	}
+	if f.Miner != "" && f.Miner != r.Miner {
+		return false
+	}
+	if !f.From.IsZero() && r.Time.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !r.Time.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+// FilProposal contains information about a deal proposal accepted
+// by a miner, which isn't known to be active on-chain yet.
+type FilProposal struct {
+	ProposalCid cid.Cid
+	Miner       string
+	EpochPrice  uint64
+	// Round is the deal round the proposal was made in, shared by the
+	// proposals made together. It's nil if the proposal can't be replaced
+	// in case it fails.
+	Round *FilDealRound
+}
+
+// FilDealRound is the state of making deals for a Cid, used to replace
+// failed proposals. It travels with the proposals, so waiting for them
+// doesn't depend on state kept by the Cold Storage.
+type FilDealRound struct {
+	// Wanted is the number of deals that should become active.
+	Wanted int
+	// Waddr is the wallet address paying for the deals.
+	Waddr string
+	// Config is the configuration used to make the deals.
+	Config FilConfig
+	// Filter selects replacement miners, excluding miners
+	// already proposed.
+	Filter MinerSelectorFilter
+	// Remaining is the remaining replacement budget.
+	Remaining int
+}
+
+// HotAction is an action that would be executed in the Hot Storage
+// to enforce a CidConfig.
+type HotAction int
+
+const (
+	// HotActionNone means the Hot Storage already satisfies the configuration.
+	HotActionNone HotAction = iota
+	// HotActionPin means the Cid would be pinned, fetching its data from
+	// the network if isn't available locally.
+	HotActionPin
+	// HotActionRemove means the Cid would be removed from the Hot Storage.
+	HotActionRemove
+	// HotActionUnref means the instance would stop needing the Cid in the
+	// Hot Storage, but it would be kept since other instances still need it.
+	HotActionUnref
+)
+
+// StoragePlan describes what pushing a CidConfig would do, without
+// executing it.
+type StoragePlan struct {
+	Cid  cid.Cid
+	Hot  HotPlan
+	Cold ColdPlan
+}
+
+// HotPlan describes what pushing a CidConfig would do in the Hot Storage.
+type HotPlan struct {
+	Action HotAction
+	// CanUnfreeze is true if the Cid would be unfrozen from the
+	// Cold Storage in case fetching its data for pinning fails.
+	CanUnfreeze bool
+}
+
+// ColdPlan describes what pushing a CidConfig would do in the Cold Storage.
+type ColdPlan struct {
+	// NewDeals is the number of new deals needed to reach
+	// the desired replication factor.
+	NewDeals int
+	// Miners are the miners that would be proposed the new deals,
+	// with their asked price per epoch.
+	Miners []MinerProposal
+	// DealDuration is the duration in epochs of the new deals.
+	DealDuration int64
+	// EstimatedCost is the estimated total cost of the new deals
+	// over their duration.
+	EstimatedCost uint64
 }
 
 // CidLoggerCtxKey is a type to use in ctx values for CidLogger.
@@ -329,6 +840,20 @@ type CidLoggerCtxKey int
 const (
 	// CtxKeyJid is the key to store Jid metadata.
 	CtxKeyJid CidLoggerCtxKey = iota
+	// CtxKeyEvent is the key to store LogEvent metadata.
+	CtxKeyEvent
+)
+
+// LogEvent identifies log entries that signal a particular event, so
+// clients can react to them without parsing messages.
+type LogEvent string
+
+const (
+	// EventNone is the event of log entries that don't signal any event.
+	EventNone LogEvent = ""
+	// EventRenewalBlocked signals that a deal wasn't renewed since every
+	// candidate miner is above the configured renewal price limits.
+	EventRenewalBlocked LogEvent = "renewal-blocked"
 )
 
 // CidLogger saves log information about a Cid executions.
@@ -343,4 +868,5 @@ type LogEntry struct {
 	Timestamp time.Time
 	Jid       JobID
 	Msg       string
+	Event     LogEvent
 }
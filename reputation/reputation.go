@@ -2,6 +2,7 @@ package reputation
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"sort"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
 	logging "github.com/ipfs/go-log/v2"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/textileio/powergate/index/ask"
@@ -20,6 +22,8 @@ import (
 var (
 	updateSourcesInterval = time.Second * 90
 	log                   = logging.Logger("reputation")
+
+	dsRetrievalFailures = datastore.NewKey("/reputation/retrievalfailures")
 )
 
 // Module consolidates different sources of information to create a
@@ -34,10 +38,11 @@ type Module struct {
 
 	ai *ask.Index
 
-	lockIndex sync.Mutex
-	mIndex    miner.IndexSnapshot
-	sIndex    slashing.IndexSnapshot
-	aIndex    ask.IndexSnapshot
+	lockIndex         sync.Mutex
+	mIndex            miner.IndexSnapshot
+	sIndex            slashing.IndexSnapshot
+	aIndex            ask.IndexSnapshot
+	retrievalFailures map[string]int
 
 	lockScores sync.Mutex
 	rebuild    chan struct{}
@@ -55,6 +60,11 @@ type MinerScore struct {
 
 // New returns a new reputation Module
 func New(ds datastore.TxnDatastore, mi *miner.Index, si *slashing.Index, ai *ask.Index) *Module {
+	retrievalFailures, err := loadRetrievalFailures(ds)
+	if err != nil {
+		log.Errorf("loading retrieval failures: %s", err)
+		retrievalFailures = make(map[string]int)
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	rm := &Module{
 		ds: ds,
@@ -62,6 +72,8 @@ func New(ds datastore.TxnDatastore, mi *miner.Index, si *slashing.Index, ai *ask
 		si: si,
 		ai: ai,
 
+		retrievalFailures: retrievalFailures,
+
 		rebuild: make(chan struct{}, 1),
 		ctx:     ctx,
 		cancel:  cancel,
@@ -146,6 +158,88 @@ func (rm *Module) GetTopMiners(n int) ([]MinerScore, error) {
 	return mr, nil
 }
 
+// GetScore returns the score of a miner, and false if the miner
+// isn't scored.
+func (rm *Module) GetScore(addr string) (int, bool) {
+	rm.lockScores.Lock()
+	defer rm.lockScores.Unlock()
+	for _, m := range rm.scores {
+		if m.Addr == addr {
+			return m.Score, true
+		}
+	}
+	return 0, false
+}
+
+// AddRetrievalResult reports the outcome of a retrieval with a miner.
+// Consecutive failed retrievals lower the score of the miner, and a
+// successful one restores it. Failures are persisted, so they're
+// considered after a restart.
+func (rm *Module) AddRetrievalResult(addr string, success bool) {
+	rm.lockIndex.Lock()
+	prev := rm.retrievalFailures[addr]
+	if success {
+		delete(rm.retrievalFailures, addr)
+	} else {
+		rm.retrievalFailures[addr]++
+	}
+	curr := rm.retrievalFailures[addr]
+	changed := prev != curr
+	if changed {
+		if err := saveRetrievalFailures(rm.ds, addr, curr); err != nil {
+			log.Errorf("saving retrieval failures of %s: %s", addr, err)
+		}
+	}
+	rm.lockIndex.Unlock()
+	if !changed {
+		return
+	}
+	select {
+	case rm.rebuild <- struct{}{}:
+	default:
+	}
+}
+
+// loadRetrievalFailures returns the persisted consecutive retrieval
+// failures of miners.
+func loadRetrievalFailures(ds datastore.Datastore) (map[string]int, error) {
+	res, err := ds.Query(query.Query{Prefix: dsRetrievalFailures.String()})
+	if err != nil {
+		return nil, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	failures := make(map[string]int)
+	for e := range res.Next() {
+		if e.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", e.Error)
+		}
+		var f int
+		if err := json.Unmarshal(e.Value, &f); err != nil {
+			return nil, fmt.Errorf("unmarshaling retrieval failures: %s", err)
+		}
+		failures[datastore.NewKey(e.Key).BaseNamespace()] = f
+	}
+	return failures, nil
+}
+
+// saveRetrievalFailures persists the consecutive retrieval failures
+// of a miner. Zero failures removes them.
+func saveRetrievalFailures(ds datastore.Datastore, addr string, failures int) error {
+	key := dsRetrievalFailures.ChildString(addr)
+	if failures == 0 {
+		return ds.Delete(key)
+	}
+	buf, err := json.Marshal(failures)
+	if err != nil {
+		return fmt.Errorf("marshaling retrieval failures: %s", err)
+	}
+	return ds.Put(key, buf)
+}
+
 // Close closes the reputation Module
 func (rm *Module) Close() error {
 	rm.cancel()
@@ -195,11 +289,15 @@ func (rm *Module) indexBuilder() {
 		minerIndex := rm.mIndex
 		slashIndex := rm.sIndex
 		askIndex := rm.aIndex
+		retrievalFailures := make(map[string]int, len(rm.retrievalFailures))
+		for addr, f := range rm.retrievalFailures {
+			retrievalFailures[addr] = f
+		}
 		rm.lockIndex.Unlock()
 
 		scores := make([]MinerScore, 0, len(minerIndex.Chain.Power))
 		for addr := range minerIndex.Chain.Power {
-			score := calculateScore(addr, minerIndex, slashIndex, askIndex, sources)
+			score := calculateScore(addr, minerIndex, slashIndex, askIndex, sources, retrievalFailures[addr])
 			scores = append(scores, score)
 		}
 		sort.Slice(scores, func(i, j int) bool {
@@ -214,8 +312,9 @@ func (rm *Module) indexBuilder() {
 	}
 }
 
-// calculateScore calculates the score for a miner
-func calculateScore(addr string, mi miner.IndexSnapshot, si slashing.IndexSnapshot, ai ask.IndexSnapshot, ss []source.Source) MinerScore {
+// calculateScore calculates the score for a miner. Each consecutive
+// failed retrieval halves the score.
+func calculateScore(addr string, mi miner.IndexSnapshot, si slashing.IndexSnapshot, ai ask.IndexSnapshot, ss []source.Source, retrievalFailures int) MinerScore {
 	power := mi.Chain.Power[addr]
 	powerScore := power.Relative
 
@@ -239,6 +338,7 @@ func calculateScore(addr string, mi miner.IndexSnapshot, si slashing.IndexSnapsh
 	}
 
 	score := 50*slashScore + 20*powerScore + 20*externalScore + 10*askScore
+	score /= math.Pow(2, float64(retrievalFailures))
 	return MinerScore{
 		Addr:  addr,
 		Score: int(score),
@@ -27,6 +27,20 @@ func (d *TxMapDatastore) Get(key datastore.Key) ([]byte, error) {
 	return d.MapDatastore.Get(key)
 }
 
+// Has returns true if the key exists.
+func (d *TxMapDatastore) Has(key datastore.Key) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.MapDatastore.Has(key)
+}
+
+// GetSize returns the size of the value of a key.
+func (d *TxMapDatastore) GetSize(key datastore.Key) (int, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.MapDatastore.GetSize(key)
+}
+
 // Put sets the value of a key.
 func (d *TxMapDatastore) Put(key datastore.Key, data []byte) error {
 	d.lock.Lock()
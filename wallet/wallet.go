@@ -2,15 +2,23 @@ package wallet
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/lotus/chain/types"
 	"github.com/filecoin-project/specs-actors/actors/crypto"
+	"github.com/ipfs/go-cid"
 	"github.com/textileio/lotus-client/api/apistruct"
 )
 
+var (
+	// ErrNoMasterAddr is returned when funding an address without
+	// a configured master address.
+	ErrNoMasterAddr = errors.New("no master address configured")
+)
+
 // Module exposes the filecoin wallet api.
 type Module struct {
 	api        *apistruct.FullNodeStruct
@@ -44,17 +52,10 @@ func (m *Module) NewAddress(ctx context.Context, typ string) (string, error) {
 		return "", err
 	}
 
-	if m.masterAddr != nil {
-		msg := &types.Message{
-			From:     *m.masterAddr,
-			To:       addr,
-			Value:    types.BigInt{Int: m.iAmount},
-			GasLimit: 1000,
-			GasPrice: types.NewInt(0),
-		}
-
-		_, err = m.api.MpoolPushMessage(ctx, msg)
-		if err != nil {
+	// New addresses are only funded if there's a master address
+	// and a positive initial amount configured.
+	if m.masterAddr != nil && isPositive(m.iAmount) {
+		if _, err := m.sendFil(ctx, *m.masterAddr, addr, m.iAmount); err != nil {
 			return "", fmt.Errorf("transferring funds to new address: %s", err)
 		}
 	}
@@ -62,6 +63,69 @@ func (m *Module) NewAddress(ctx context.Context, typ string) (string, error) {
 	return addr.String(), nil
 }
 
+// SendFil sends amount attoFIL from an address of the Lotus node wallet to
+// another address. It returns the Cid of the message, which can be tracked
+// with WaitMessage until it lands on chain. It doesn't check who owns from,
+// so callers should only allow sending from addresses the requester owns.
+func (m *Module) SendFil(ctx context.Context, from string, to string, amount *big.Int) (cid.Cid, error) {
+	if !isPositive(amount) {
+		return cid.Undef, fmt.Errorf("amount should be positive")
+	}
+	f, err := address.NewFromString(from)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing from address: %s", err)
+	}
+	t, err := address.NewFromString(to)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing to address: %s", err)
+	}
+	return m.sendFil(ctx, f, t, amount)
+}
+
+// Fund sends amount attoFIL from the master address to an address. It
+// returns ErrNoMasterAddr if there isn't a configured master address.
+func (m *Module) Fund(ctx context.Context, to string, amount *big.Int) (cid.Cid, error) {
+	if m.masterAddr == nil {
+		return cid.Undef, ErrNoMasterAddr
+	}
+	if !isPositive(amount) {
+		return cid.Undef, fmt.Errorf("amount should be positive")
+	}
+	t, err := address.NewFromString(to)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing to address: %s", err)
+	}
+	return m.sendFil(ctx, *m.masterAddr, t, amount)
+}
+
+// WaitMessage waits for a message to land on chain. It returns an error if
+// the message execution failed.
+func (m *Module) WaitMessage(ctx context.Context, mcid cid.Cid) error {
+	res, err := m.api.StateWaitMsg(ctx, mcid)
+	if err != nil {
+		return fmt.Errorf("waiting for message %s: %s", mcid, err)
+	}
+	if res.Receipt.ExitCode != 0 {
+		return fmt.Errorf("message %s failed with exit code %d", mcid, res.Receipt.ExitCode)
+	}
+	return nil
+}
+
+func (m *Module) sendFil(ctx context.Context, from address.Address, to address.Address, amount *big.Int) (cid.Cid, error) {
+	msg := &types.Message{
+		From:     from,
+		To:       to,
+		Value:    types.BigInt{Int: amount},
+		GasLimit: 1000,
+		GasPrice: types.NewInt(0),
+	}
+	smsg, err := m.api.MpoolPushMessage(ctx, msg)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("pushing message to mpool: %s", err)
+	}
+	return smsg.Cid(), nil
+}
+
 // Balance returns the balance of the specified address.
 func (m *Module) Balance(ctx context.Context, addr string) (uint64, error) {
 	a, err := address.NewFromString(addr)
@@ -74,3 +138,7 @@ func (m *Module) Balance(ctx context.Context, addr string) (uint64, error) {
 	}
 	return b.Uint64(), nil
 }
+
+func isPositive(amount *big.Int) bool {
+	return amount != nil && amount.Sign() > 0
+}
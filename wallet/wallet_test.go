@@ -0,0 +1,42 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/tests"
+)
+
+var (
+	ctx = context.Background()
+)
+
+func TestNewAddress(t *testing.T) {
+	client, addr, _ := tests.CreateLocalDevnet(t, 1)
+	m, err := New(client, &addr, *big.NewInt(4000000000))
+	require.NoError(t, err)
+
+	naddr, err := m.NewAddress(ctx, "bls")
+	require.NoError(t, err)
+	require.NotEmpty(t, naddr)
+}
+
+func TestNewAddressZeroInitialFund(t *testing.T) {
+	client, addr, _ := tests.CreateLocalDevnet(t, 1)
+	m, err := New(client, &addr, *big.NewInt(0))
+	require.NoError(t, err)
+
+	naddr, err := m.NewAddress(ctx, "bls")
+	require.NoError(t, err)
+	bal, err := m.Balance(ctx, naddr)
+	require.NoError(t, err)
+	require.Zero(t, bal)
+
+	// Explicit transfers still require a positive amount.
+	_, err = m.Fund(ctx, naddr, big.NewInt(0))
+	require.Error(t, err)
+	_, err = m.SendFil(ctx, addr.String(), naddr, nil)
+	require.Error(t, err)
+}